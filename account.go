@@ -13,9 +13,14 @@ type PaginatedAccounts struct {
 	Items   []Account `json:"items"`
 }
 
-// ListAccounts lists all accounts
-func (c *Client) ListAccounts() (*PaginatedAccounts, error) {
-	resp, err := c.SendGetRequest("/v2/accounts")
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedAccounts) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
+// ListAccounts lists all accounts, a page at a time, see ListOptions
+func (c *Client) ListAccounts(opts ...ListOptions) (*PaginatedAccounts, error) {
+	resp, err := c.sendListRequest("/v2/accounts", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -28,6 +33,16 @@ func (c *Client) ListAccounts() (*PaginatedAccounts, error) {
 	return accounts, nil
 }
 
+// AccountsPaginator returns a Paginator over all accounts, perPage at a time
+func (c *Client) AccountsPaginator(perPage int) *Paginator[Account] {
+	return newPathPaginator[Account](c, "/v2/accounts", perPage)
+}
+
+// ListAllAccounts returns every account, walking all the pages
+func (c *Client) ListAllAccounts() ([]Account, error) {
+	return c.AccountsPaginator(defaultPerPage).All()
+}
+
 // GetAccountID returns the account ID
 func (c *Client) GetAccountID() string {
 	accounts, err := c.ListAccounts()
@@ -0,0 +1,132 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// AccountSet holds a client per Civo account, keyed by an ID of the caller's choosing such as the
+// account ID, so resources can be listed across accounts in one call. It is safe for concurrent use
+type AccountSet struct {
+	mu      sync.RWMutex
+	clients map[string]*Client
+}
+
+// AccountInstance is an Instance along with the ID of the account of the AccountSet it belongs to
+type AccountInstance struct {
+	AccountID string `json:"account_id"`
+	Instance
+}
+
+// NewAccountSet returns an empty AccountSet
+func NewAccountSet() *AccountSet {
+	return &AccountSet{clients: make(map[string]*Client)}
+}
+
+// Add adds client to the set as accountID, replacing any client already added with that ID
+func (s *AccountSet) Add(accountID string, client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients[accountID] = client
+}
+
+// AddAPIKey creates a client for apiKey in region, customised by opts, and adds it to the set as accountID
+func (s *AccountSet) AddAPIKey(accountID, apiKey, region string, opts ...ClientOption) error {
+	client, err := NewClient(apiKey, region, opts...)
+	if err != nil {
+		return fmt.Errorf("account %s: %w", accountID, err)
+	}
+
+	s.Add(accountID, client)
+	return nil
+}
+
+// Remove removes the client of accountID from the set
+func (s *AccountSet) Remove(accountID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.clients, accountID)
+}
+
+// Client returns the client of accountID, if it is in the set
+func (s *AccountSet) Client(accountID string) (*Client, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	client, ok := s.clients[accountID]
+	return client, ok
+}
+
+// AccountIDs returns the IDs of the accounts of the set, sorted
+func (s *AccountSet) AccountIDs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.clients))
+	for id := range s.clients {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// ForEachAccount calls fn concurrently for every account of the set with its client. Every account is
+// attempted even if some fail, the returned error joins the errors of the failed accounts
+func (s *AccountSet) ForEachAccount(fn func(accountID string, client *Client) error) error {
+	ids := s.AccountIDs()
+
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		client, ok := s.Client(id)
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, id string, client *Client) {
+			defer wg.Done()
+			if err := fn(id, client); err != nil {
+				errs[i] = fmt.Errorf("account %s: %w", id, err)
+			}
+		}(i, id, client)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// ListInstances returns the instances of every account, in the region of its client, ordered by
+// account ID. When some accounts fail, the instances of the others are still returned along with the error
+func (s *AccountSet) ListInstances() ([]AccountInstance, error) {
+	var mu sync.Mutex
+	byAccount := map[string][]Instance{}
+	err := s.ForEachAccount(func(accountID string, client *Client) error {
+		instances, err := client.ListAllInstances()
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		byAccount[accountID] = instances
+		return nil
+	})
+
+	accounts := make([]string, 0, len(byAccount))
+	for accountID := range byAccount {
+		accounts = append(accounts, accountID)
+	}
+	sort.Strings(accounts)
+
+	all := []AccountInstance{}
+	for _, accountID := range accounts {
+		for _, instance := range byAccount[accountID] {
+			all = append(all, AccountInstance{AccountID: accountID, Instance: instance})
+		}
+	}
+
+	return all, err
+}
@@ -0,0 +1,44 @@
+package civogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAccountSetListInstances(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch key := strings.TrimPrefix(req.Header.Get("Authorization"), "bearer "); key {
+		case "KEY-C":
+			rw.WriteHeader(http.StatusUnauthorized)
+			rw.Write([]byte(`{"code": "authentication_failed", "reason": "invalid key"}`))
+		default:
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "` + key + `-1", "hostname": "web"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	set := NewAccountSet()
+	for id, key := range map[string]string{"b": "KEY-B", "a": "KEY-A", "c": "KEY-C"} {
+		g.Expect(set.AddAPIKey(id, key, "LON1", WithBaseURL(server.URL))).To(Succeed())
+	}
+	g.Expect(set.AccountIDs()).To(Equal([]string{"a", "b", "c"}))
+
+	instances, err := set.ListInstances()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("account c"))
+	g.Expect(instances).To(HaveLen(2))
+	g.Expect(instances[0].AccountID).To(Equal("a"))
+	g.Expect(instances[0].ID).To(Equal("KEY-A-1"))
+	g.Expect(instances[1].AccountID).To(Equal("b"))
+
+	set.Remove("c")
+	instances, err = set.ListInstances()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(instances).To(HaveLen(2))
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -19,16 +18,16 @@ type PaginateActionList struct {
 
 // Action is a struct for an individual action within the database and when serialized
 type Action struct {
-	ID          int       `json:"id" gorm:"autoIncrement"`
-	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
-	AccountID   string    `json:"account_id"`
-	UserID      string    `json:"user_id"`
-	Type        string    `json:"type"`
-	Details     string    `json:"details,omitempty"`
-	RelatedID   string    `json:"related_id,omitempty"`
-	RelatedType string    `json:"related_type,omitempty"`
-	Debug       bool      `json:"debug"`
+	ID          int    `json:"id" gorm:"autoIncrement"`
+	CreatedAt   Time   `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   Time   `json:"updated_at" gorm:"autoUpdateTime"`
+	AccountID   string `json:"account_id"`
+	UserID      string `json:"user_id"`
+	Type        string `json:"type"`
+	Details     string `json:"details,omitempty"`
+	RelatedID   string `json:"related_id,omitempty"`
+	RelatedType string `json:"related_type,omitempty"`
+	Debug       bool   `json:"debug"`
 }
 
 // ActionListRequest is a struct for the request to list actions
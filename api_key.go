@@ -0,0 +1,74 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// APIKey is an API key of the account, its secret Key is only returned by CreateAPIKey
+type APIKey struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Key        string `json:"key,omitempty"`
+	Default    bool   `json:"default,omitempty"`
+	CreatedAt  Time   `json:"created_at,omitempty"`
+	LastUsedAt Time   `json:"last_used_at,omitempty"`
+}
+
+// GetAccount returns the account the API key of the client belongs to
+func (c *Client) GetAccount() (*Account, error) {
+	resp, err := c.SendGetRequest("/v2/account")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	account := &Account{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(account); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return account, nil
+}
+
+// ListAPIKeys returns the API keys of the account, without their secrets
+func (c *Client) ListAPIKeys() ([]APIKey, error) {
+	resp, err := c.SendGetRequest("/v2/api_keys")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	keys := make([]APIKey, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&keys); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey creates an API key named name, the returned Key is the only time its secret is revealed
+func (c *Client) CreateAPIKey(name string) (*APIKey, error) {
+	resp, err := c.SendPostRequest("/v2/api_keys", map[string]string{
+		"name": name,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	key := &APIKey{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(key); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey revokes an API key, requests sent with it fail from then on
+func (c *Client) RevokeAPIKey(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/api_keys/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
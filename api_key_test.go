@@ -0,0 +1,114 @@
+package civogo
+
+import (
+	"bytes"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetAccount(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/account": `{"id": "12345", "email_address": "ops@example.com", "flags": "beta", "created_at": "2024-01-02T03:04:05Z"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetAccount()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &Account{ID: "12345", EmailAddress: "ops@example.com", Flags: "beta", CreatedAt: NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListAPIKeys(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys": `[{"id": "1", "name": "ci", "default": true}, {"id": "2", "name": "backup"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListAPIKeys()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []APIKey{{ID: "1", Name: "ci", Default: true}, {ID: "2", Name: "backup"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"ci"}`,
+					URL:          "/v2/api_keys",
+					ResponseBody: `{"id": "1", "name": "ci", "key": "s3cret"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateAPIKey("ci")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &APIKey{ID: "1", Name: "ci", Key: "s3cret"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys/1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.RevokeAPIKey("1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SimpleResponse{Result: "success"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestCreateAPIKeyRedactedFromDebugLog(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/api_keys": `{"id": "3", "name": "deploy", "key": "s3cr3t-api-key"}`,
+	})
+	defer server.Close()
+
+	var logged bytes.Buffer
+	WithLogger(log.New(&logged, "", 0))(client)
+	WithDebug(true)(client)
+
+	got, err := client.CreateAPIKey("deploy")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Key != "s3cr3t-api-key" {
+		t.Errorf("Expected the key to be returned, got %q", got.Key)
+	}
+	if strings.Contains(logged.String(), "s3cr3t-api-key") || !strings.Contains(logged.String(), `"key": "[REDACTED]"`) {
+		t.Errorf("Expected the key to be redacted, got %s", logged.String())
+	}
+}
@@ -0,0 +1,111 @@
+package civogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeader is the header the API uses to identify a request in its logs
+const requestIDHeader = "X-Request-Id"
+
+// Broad classes of API failure, usable with errors.Is regardless of which endpoint failed
+var (
+	ErrQuotaExceeded        = constError("QuotaExceeded")
+	ErrAuthenticationFailed = constError("AuthenticationFailed")
+	ErrResourceNotFound     = constError("ResourceNotFound")
+)
+
+// APIError is the error returned when the Civo API answers with an error response,
+// it keeps the details of the response so callers can inspect them with errors.As
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Status is the HTTP status line of the response, e.g. "404 Not Found"
+	Status string
+	// Code is the Civo error code, e.g. "database_instance_not_found"
+	Code string
+	// Reason is the human readable reason given by the API
+	Reason string
+	// Details holds any extra information the API gave about the failure
+	Details string
+	// RequestID identifies the request, quote it when contacting support
+	RequestID string
+	// ValidationErrors are the fields the API rejected, for 422 responses listing them
+	ValidationErrors ValidationErrors
+
+	err error
+}
+
+// newAPIError builds an APIError from an HTTP error response, wrapping the
+// sentinel error that the response code was decoded to
+func newAPIError(httpErr HTTPError, decoded error) *APIError {
+	apiErr := &APIError{
+		StatusCode: httpErr.Code,
+		Status:     httpErr.Status,
+		RequestID:  httpErr.RequestID,
+		err:        decoded,
+	}
+
+	var response struct {
+		Code    string          `json:"code"`
+		Reason  string          `json:"reason"`
+		Details string          `json:"details"`
+		Result  string          `json:"result"`
+		Errors  json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(httpErr.Reason), &response); err != nil {
+		apiErr.Reason = httpErr.Reason
+		return apiErr
+	}
+
+	apiErr.Code = response.Code
+	apiErr.Reason = response.Reason
+	apiErr.Details = response.Details
+	if httpErr.Code == http.StatusUnprocessableEntity {
+		apiErr.ValidationErrors = parseValidationErrors(response.Errors)
+	}
+	if apiErr.Code == "" && response.Result == "requires_authentication" {
+		apiErr.Code = response.Result
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("%d: %s, %s", e.StatusCode, e.Code, e.Reason)
+}
+
+// Unwrap returns the sentinel error the response was decoded to
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// As sets target to the ValidationErrors of the response when it is a *ValidationErrors and the API rejected some fields,
+// so errors.As finds them whether they come from the API or from a Validate method
+func (e *APIError) As(target interface{}) bool {
+	if errs, ok := target.(*ValidationErrors); ok && len(e.ValidationErrors) > 0 {
+		*errs = e.ValidationErrors
+		return true
+	}
+	return false
+}
+
+// Is reports whether the error belongs to one of the broad error classes
+// ErrQuotaExceeded, ErrAuthenticationFailed or ErrResourceNotFound
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrQuotaExceeded:
+		return e.Code == "quota_limit_reached" || strings.HasSuffix(e.Code, "_quota_exceeded")
+	case ErrAuthenticationFailed:
+		return e.StatusCode == http.StatusUnauthorized || e.Code == "requires_authentication" ||
+			e.Code == "authentication_failed" || e.Code == "authentication_invalid_key"
+	case ErrResourceNotFound:
+		return e.StatusCode == http.StatusNotFound || strings.HasSuffix(e.Code, "_not_found")
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAPIErrorNotFound(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-Request-Id", "req-123")
+		rw.WriteHeader(http.StatusNotFound)
+		rw.Write([]byte(`{"code": "database_instance_find", "reason": "The instance could not be found"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	_, err := client.GetInstance("12345")
+
+	var apiErr *APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+	g.Expect(apiErr.Code).To(Equal("database_instance_find"))
+	g.Expect(apiErr.Reason).To(Equal("The instance could not be found"))
+	g.Expect(apiErr.RequestID).To(Equal("req-123"))
+
+	g.Expect(errors.Is(err, ErrResourceNotFound)).To(BeTrue())
+	g.Expect(errors.Is(err, DatabaseInstanceNotFoundError)).To(BeTrue())
+	g.Expect(errors.Is(err, ErrQuotaExceeded)).To(BeFalse())
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	cases := []struct {
+		status int
+		body   string
+		target error
+	}{
+		{http.StatusForbidden, `{"code": "quota_limit_reached", "reason": "quota reached"}`, ErrQuotaExceeded},
+		{http.StatusUnauthorized, `{"result": "requires_authentication"}`, ErrAuthenticationFailed},
+		{http.StatusForbidden, `{"code": "authentication_invalid_key", "reason": "bad key"}`, ErrAuthenticationFailed},
+		{http.StatusBadRequest, `{"code": "database_network_not_found", "reason": "no network"}`, ErrResourceNotFound},
+	}
+
+	for _, tc := range cases {
+		err := decodeError(HTTPError{Code: tc.status, Reason: tc.body})
+		if !errors.Is(err, tc.target) {
+			t.Errorf("expected %s to match %v", tc.body, tc.target)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/civo/civogo/utils"
@@ -28,6 +29,20 @@ type Application struct {
 	Status string `json:"status"`
 }
 
+// Clone returns a deep copy of the application, sharing none of its slices and maps
+func (a *Application) Clone() *Application {
+	if a == nil {
+		return nil
+	}
+
+	c := *a
+	c.ProcessInfo = cloneSlice(a.ProcessInfo)
+	c.Domains = cloneSlice(a.Domains)
+	c.SSHKeyIDs = cloneSlice(a.SSHKeyIDs)
+	c.Config = cloneSlice(a.Config)
+	return &c
+}
+
 // ApplicationConfig describes the parameters for a new CivoApp
 type ApplicationConfig struct {
 	Name        string   `json:"name" validate:"required"`
@@ -58,6 +73,11 @@ type PaginatedApplications struct {
 	Items   []Application `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedApplications) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // EnvVar holds key-value pairs for an application
 type EnvVar struct {
 	Name  string `json:"name"`
@@ -73,9 +93,9 @@ type ProcessInfo struct {
 // ErrAppDomainNotFound is returned when the domain is not found
 var ErrAppDomainNotFound = fmt.Errorf("domain not found")
 
-// ListApplications returns all applications in that specific region
-func (c *Client) ListApplications() (*PaginatedApplications, error) {
-	resp, err := c.SendGetRequest("/v2/applications")
+// ListApplications returns all applications in that specific region, a page at a time, see ListOptions
+func (c *Client) ListApplications(opts ...ListOptions) (*PaginatedApplications, error) {
+	resp, err := c.sendListRequest("/v2/applications", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -88,6 +108,16 @@ func (c *Client) ListApplications() (*PaginatedApplications, error) {
 	return application, nil
 }
 
+// ApplicationsPaginator returns a Paginator over all applications, perPage at a time
+func (c *Client) ApplicationsPaginator(perPage int) *Paginator[Application] {
+	return newPathPaginator[Application](c, "/v2/applications", perPage)
+}
+
+// ListAllApplications returns every application, walking all the pages
+func (c *Client) ListAllApplications() ([]Application, error) {
+	return c.ApplicationsPaginator(defaultPerPage).All()
+}
+
 // GetApplication returns an application by ID
 func (c *Client) GetApplication(id string) (*Application, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/applications/%s", id))
@@ -202,3 +232,147 @@ func (c *Client) GetApplicationLogAuth(id string) (string, error) {
 
 	return string(resp), nil
 }
+
+// ScaleApplicationProcess sets how many instances of one process type of the Procfile an application runs
+func (c *Client) ScaleApplicationProcess(appID, processType string, count int) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if err := scaleProcess(req, processType, count); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// RestartApplication restarts every process of an application, e.g. to pick up external changes
+func (c *Client) RestartApplication(appID string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/applications/%s/restart", appID), map[string]string{"region": c.Region})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// SetApplicationConfigVars sets environment variables of an application, replacing the values of
+// existing ones and keeping the others
+func (c *Client) SetApplicationConfigVars(appID string, vars map[string]string) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	req.Config = setEnvVars(req.Config, vars)
+
+	return c.UpdateApplication(appID, req)
+}
+
+// UnsetApplicationConfigVars removes environment variables from an application, names it doesn't have are ignored
+func (c *Client) UnsetApplicationConfigVars(appID string, names ...string) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	req.Config = unsetEnvVars(req.Config, names)
+
+	return c.UpdateApplication(appID, req)
+}
+
+// GetApplicationLogsURL returns the URL the logs of an application can be streamed from
+func (c *Client) GetApplicationLogsURL(appID string) (string, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/applications/%s/logs", appID))
+	if err != nil {
+		return "", decodeError(err)
+	}
+
+	logs := struct {
+		URL string `json:"url"`
+	}{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&logs); err != nil {
+		return "", err
+	}
+
+	return logs.URL, nil
+}
+
+// updateRequest returns an update request keeping every setting of the application as it is
+func (a *Application) updateRequest() *UpdateApplicationRequest {
+	return &UpdateApplicationRequest{
+		Name:        a.Name,
+		Image:       a.Image,
+		Description: a.Description,
+		ProcessInfo: append([]ProcessInfo{}, a.ProcessInfo...),
+		Size:        a.Size,
+		SSHKeyIDs:   a.SSHKeyIDs,
+		Config:      append([]EnvVar{}, a.Config...),
+		Domains:     a.Domains,
+	}
+}
+
+// scaleProcess sets the count of processType in req
+func scaleProcess(req *UpdateApplicationRequest, processType string, count int) error {
+	if count < 0 {
+		err := fmt.Errorf("a process can't be scaled to %d", count)
+		return ApplicationInvalidError.wrap(err)
+	}
+
+	for i := range req.ProcessInfo {
+		if req.ProcessInfo[i].ProcessType == processType {
+			req.ProcessInfo[i].ProcessCount = count
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("application %s has no %s process", req.Name, processType)
+	return ApplicationProcessNotFoundError.wrap(err)
+}
+
+// setEnvVars returns config with vars set, new variables are appended sorted by name
+func setEnvVars(config []EnvVar, vars map[string]string) []EnvVar {
+	remaining := map[string]string{}
+	for name, value := range vars {
+		remaining[name] = value
+	}
+
+	for i := range config {
+		if value, ok := remaining[config[i].Name]; ok {
+			config[i].Value = value
+			delete(remaining, config[i].Name)
+		}
+	}
+
+	names := make([]string, 0, len(remaining))
+	for name := range remaining {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		config = append(config, EnvVar{Name: name, Value: remaining[name]})
+	}
+
+	return config
+}
+
+// unsetEnvVars returns config without the variables called names
+func unsetEnvVars(config []EnvVar, names []string) []EnvVar {
+	unset := map[string]bool{}
+	for _, name := range names {
+		unset[name] = true
+	}
+
+	kept := make([]EnvVar, 0, len(config))
+	for _, v := range config {
+		if !unset[v.Name] {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept
+}
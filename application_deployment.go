@@ -0,0 +1,119 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Application deployment statuses reported by the API
+const (
+	ApplicationDeploymentStatusPending  = "pending"
+	ApplicationDeploymentStatusBuilding = "building"
+	ApplicationDeploymentStatusDeployed = "deployed"
+	ApplicationDeploymentStatusFailed   = "failed"
+)
+
+// DeploymentSource is what an application deployment is built from, either a git repository
+// at a ref or a container image
+type DeploymentSource struct {
+	GitURL string `json:"git_url,omitempty"`
+	// GitRef is a branch, tag or commit, the default branch is used when empty
+	GitRef string `json:"git_ref,omitempty"`
+	Image  string `json:"image,omitempty"`
+}
+
+// ApplicationDeployment is one release of an application
+type ApplicationDeployment struct {
+	ID            string           `json:"id"`
+	ApplicationID string           `json:"application_id"`
+	Source        DeploymentSource `json:"source"`
+	Status        string           `json:"status"`
+	// RollbackOf is the deployment this one rolled back to, if any
+	RollbackOf string `json:"rollback_of,omitempty"`
+	CreatedAt  Time   `json:"created_at,omitempty"`
+}
+
+// Validate checks the source is either a git repository or a container image,
+// the rejected fields are listed in the ValidationErrors it wraps
+func (s *DeploymentSource) Validate() error {
+	var errs ValidationErrors
+	switch {
+	case s.GitURL != "" && s.Image != "":
+		errs.add("image", s.Image, "a deployment is built from a git repository or an image, not both")
+	case s.GitURL == "" && s.Image == "":
+		errs.add("git_url", s.GitURL, "a deployment needs a git repository or an image")
+	case s.Image != "" && s.GitRef != "":
+		errs.add("git_ref", s.GitRef, "a git ref can only be given with a git repository")
+	}
+
+	return errs.wrap(ApplicationDeploymentInvalidError)
+}
+
+// CreateApplicationDeployment deploys a new release of an application from source
+func (c *Client) CreateApplicationDeployment(appID string, source DeploymentSource) (*ApplicationDeployment, error) {
+	if err := source.Validate(); err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		DeploymentSource
+		Region string `json:"region"`
+	}{source, c.Region}
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/applications/%s/deployments", appID), data)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	deployment := &ApplicationDeployment{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(deployment); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+// ListApplicationDeployments returns the deployments of an application, most recent first
+func (c *Client) ListApplicationDeployments(appID string) ([]ApplicationDeployment, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/applications/%s/deployments", appID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	deployments := make([]ApplicationDeployment, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&deployments); err != nil {
+		return nil, err
+	}
+
+	return deployments, nil
+}
+
+// GetApplicationDeployment returns one deployment of an application
+func (c *Client) GetApplicationDeployment(appID, deploymentID string) (*ApplicationDeployment, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/applications/%s/deployments/%s", appID, deploymentID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	deployment := &ApplicationDeployment{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(deployment); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+// RollbackApplication redeploys the source of an earlier deployment, returning the new deployment
+func (c *Client) RollbackApplication(appID, deploymentID string) (*ApplicationDeployment, error) {
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/applications/%s/deployments/%s/rollback", appID, deploymentID), map[string]string{"region": c.Region})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	deployment := &ApplicationDeployment{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(deployment); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
@@ -0,0 +1,93 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCreateApplicationDeployment(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"git_url":"https://github.com/example/web","git_ref":"v1.2.0","region":"TEST"}`,
+					URL:          "/v2/applications/12345/deployments",
+					ResponseBody: `{"id": "dep-1", "application_id": "12345", "source": {"git_url": "https://github.com/example/web", "git_ref": "v1.2.0"}, "status": "pending"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateApplicationDeployment("12345", DeploymentSource{GitURL: "https://github.com/example/web", GitRef: "v1.2.0"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &ApplicationDeployment{
+		ID:            "dep-1",
+		ApplicationID: "12345",
+		Source:        DeploymentSource{GitURL: "https://github.com/example/web", GitRef: "v1.2.0"},
+		Status:        ApplicationDeploymentStatusPending,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestDeploymentSourceValidate(t *testing.T) {
+	invalid := []DeploymentSource{
+		{},
+		{GitURL: "https://github.com/example/web", Image: "nginx:1.25"},
+		{Image: "nginx:1.25", GitRef: "main"},
+	}
+	for _, source := range invalid {
+		if err := source.Validate(); !errors.Is(err, ApplicationDeploymentInvalidError) {
+			t.Errorf("Expected ApplicationDeploymentInvalidError for %+v, got %v", source, err)
+		}
+	}
+
+	if err := (&DeploymentSource{Image: "nginx:1.25"}).Validate(); err != nil {
+		t.Errorf("Expected an image source to be valid, got %s", err)
+	}
+}
+
+func TestRollbackApplication(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/applications/12345/deployments/dep-1/rollback": `{"id": "dep-3", "application_id": "12345", "source": {"image": "nginx:1.24"}, "status": "pending", "rollback_of": "dep-1"}`,
+	})
+	defer server.Close()
+
+	got, err := client.RollbackApplication("12345", "dep-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "dep-3" || got.RollbackOf != "dep-1" || got.Source.Image != "nginx:1.24" {
+		t.Errorf("Unexpected deployment %+v", got)
+	}
+}
+
+func TestFakeRollbackApplication(t *testing.T) {
+	client, _ := NewFakeClient()
+	app, _ := client.CreateApplication(&ApplicationConfig{Name: "web"})
+
+	first, _ := client.CreateApplicationDeployment(app.ID, DeploymentSource{Image: "nginx:1.24"})
+	_, _ = client.CreateApplicationDeployment(app.ID, DeploymentSource{Image: "nginx:1.25"})
+
+	rollback, err := client.RollbackApplication(app.ID, first.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if rollback.Source.Image != "nginx:1.24" || rollback.RollbackOf != first.ID {
+		t.Errorf("Unexpected rollback %+v", rollback)
+	}
+
+	deployments, _ := client.ListApplicationDeployments(app.ID)
+	if len(deployments) != 3 || deployments[0].ID != rollback.ID {
+		t.Errorf("Expected the rollback to be the latest of 3 deployments, got %+v", deployments)
+	}
+}
@@ -0,0 +1,196 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Certificate statuses of an application domain
+const (
+	ApplicationCertificateStatusPending = "pending"
+	ApplicationCertificateStatusIssued  = "issued"
+	ApplicationCertificateStatusFailed  = "failed"
+)
+
+// ApplicationDomainStatus tells whether a custom domain of an application is ready to serve traffic
+type ApplicationDomainStatus struct {
+	Domain string `json:"domain"`
+	// CNAMETarget is the hostname the domain must be a CNAME of
+	CNAMETarget string `json:"cname_target"`
+	// DNSConfigured is set once the CNAME has been seen
+	DNSConfigured        bool   `json:"dns_configured"`
+	CertificateStatus    string `json:"certificate_status"`
+	CertificateExpiresAt Time   `json:"certificate_expires_at,omitempty"`
+}
+
+// applicationDNSClient is the part of the DNS API used to point a domain at an application
+type applicationDNSClient interface {
+	ListDNSDomains() ([]DNSDomain, error)
+	FindDNSRecordByTypeAndName(domainID string, t DNSRecordType, name string) (*DNSRecord, error)
+	CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecord, error)
+	UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error)
+}
+
+// AddApplicationDomain serves an application on a custom domain too, a TLS certificate is issued
+// for it once its CNAME points to the application (see GetApplicationDomainStatus)
+func (c *Client) AddApplicationDomain(appID, domain string) (*Application, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if !addDomain(req, domain) {
+		return app, nil
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// AddApplicationDomainWithDNS adds a custom domain to an application and creates, or updates, the CNAME
+// pointing it to the application in the DNS domain of the account it belongs to
+func (c *Client) AddApplicationDomainWithDNS(appID, domain string) (*ApplicationDomainStatus, error) {
+	if _, err := c.AddApplicationDomain(appID, domain); err != nil {
+		return nil, err
+	}
+
+	status, err := c.GetApplicationDomainStatus(appID, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pointDomainAt(c, status.Domain, status.CNAMETarget); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// RemoveApplicationDomain stops serving an application on a custom domain, returning ErrAppDomainNotFound
+// if the application doesn't use it. DNS records are left as they are
+func (c *Client) RemoveApplicationDomain(appID, domain string) (*Application, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if !removeDomain(req, domain) {
+		return nil, ErrAppDomainNotFound
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// GetApplicationDomainStatus returns the DNS and certificate status of a custom domain of an application
+func (c *Client) GetApplicationDomainStatus(appID, domain string) (*ApplicationDomainStatus, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/applications/%s/domains/%s", appID, domain))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	status := &ApplicationDomainStatus{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// normaliseApplicationDomain lowercases domain and drops any trailing dot, checking it is a hostname
+func normaliseApplicationDomain(domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	if !strings.Contains(domain, ".") || strings.ContainsAny(domain, " /:@") {
+		err := fmt.Errorf("%q is not a valid domain name", domain)
+		return "", ApplicationInvalidError.wrap(err)
+	}
+	return domain, nil
+}
+
+// addDomain adds domain to req, returning false if it was already there
+func addDomain(req *UpdateApplicationRequest, domain string) bool {
+	for _, d := range req.Domains {
+		if strings.EqualFold(d, domain) {
+			return false
+		}
+	}
+	req.Domains = append(append([]string{}, req.Domains...), domain)
+	return true
+}
+
+// removeDomain removes domain from req, returning false if it wasn't there
+func removeDomain(req *UpdateApplicationRequest, domain string) bool {
+	kept := make([]string, 0, len(req.Domains))
+	for _, d := range req.Domains {
+		if !strings.EqualFold(d, domain) {
+			kept = append(kept, d)
+		}
+	}
+	if len(kept) == len(req.Domains) {
+		return false
+	}
+	req.Domains = kept
+	return true
+}
+
+// pointDomainAt makes domain a CNAME of target in the DNS domain of the account that domain is part of
+func pointDomainAt(c applicationDNSClient, domain, target string) error {
+	if target == "" {
+		return fmt.Errorf("the API did not return a CNAME target for %s", domain)
+	}
+
+	zones, err := c.ListDNSDomains()
+	if err != nil {
+		return err
+	}
+
+	var zone *DNSDomain
+	for i := range zones {
+		name := strings.ToLower(strings.TrimSuffix(zones[i].Name, "."))
+		if strings.HasSuffix(domain, "."+name) && (zone == nil || len(name) > len(zone.Name)) {
+			zone = &zones[i]
+		}
+	}
+	if zone == nil {
+		return fmt.Errorf("none of the DNS domains of the account contain %s: %w", domain, ErrDNSDomainNotFound)
+	}
+
+	config := &DNSRecordConfig{
+		Type:  DNSRecordTypeCName,
+		Name:  strings.TrimSuffix(domain, "."+strings.ToLower(strings.TrimSuffix(zone.Name, "."))),
+		Value: target,
+		TTL:   600,
+	}
+
+	record, err := c.FindDNSRecordByTypeAndName(zone.ID, DNSRecordTypeCName, config.Name)
+	switch {
+	case errors.Is(err, ErrDNSRecordNotFound):
+		_, err = c.CreateDNSRecord(zone.ID, config)
+		return err
+	case err != nil:
+		return err
+	case strings.TrimSuffix(record.Value, ".") == strings.TrimSuffix(target, "."):
+		return nil
+	default:
+		_, err = c.UpdateDNSRecord(record, config)
+		return err
+	}
+}
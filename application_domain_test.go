@@ -0,0 +1,83 @@
+package civogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAddApplicationDomainWithDNS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var update UpdateApplicationRequest
+	var record DNSRecordConfig
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v2/applications/12345":
+			rw.Write([]byte(`{"id": "12345", "name": "web", "domains": ["web.example.org"]}`))
+		case "PUT /v2/applications/12345":
+			json.NewDecoder(req.Body).Decode(&update)
+			rw.Write([]byte(`{"id": "12345", "name": "web"}`))
+		case "GET /v2/applications/12345/domains/www.shop.example.com":
+			rw.Write([]byte(`{"domain": "www.shop.example.com", "cname_target": "12345.apps.civo.app", "certificate_status": "pending"}`))
+		case "GET /v2/dns":
+			rw.Write([]byte(`[{"id": "zone-1", "name": "example.com"}, {"id": "zone-2", "name": "shop.example.com"}]`))
+		case "GET /v2/dns/zone-2/records":
+			rw.Write([]byte(`[]`))
+		case "POST /v2/dns/zone-2/records":
+			json.NewDecoder(req.Body).Decode(&record)
+			rw.Write([]byte(`{"id": "rec-1", "domain_id": "zone-2", "name": "www", "type": "CNAME", "value": "12345.apps.civo.app"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	status, err := client.AddApplicationDomainWithDNS("12345", "WWW.shop.example.com.")
+	g.Expect(err).To(BeNil())
+	g.Expect(status.CertificateStatus).To(Equal(ApplicationCertificateStatusPending))
+	g.Expect(update.Domains).To(Equal([]string{"web.example.org", "www.shop.example.com"}))
+	g.Expect(record.Type).To(Equal(DNSRecordType(DNSRecordTypeCName)))
+	g.Expect(record.Name).To(Equal("www"))
+	g.Expect(record.Value).To(Equal("12345.apps.civo.app"))
+}
+
+func TestFakeApplicationDomains(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, _ := NewFakeClient()
+	app, _ := client.CreateApplication(&ApplicationConfig{Name: "web"})
+	zone, _ := client.CreateDNSDomain("example.com")
+
+	status, err := client.AddApplicationDomainWithDNS(app.ID, "www.example.com")
+	g.Expect(err).To(BeNil())
+	g.Expect(status.DNSConfigured).To(BeTrue())
+
+	record, err := client.FindDNSRecordByTypeAndName(zone.ID, DNSRecordTypeCName, "www")
+	g.Expect(err).To(BeNil())
+	g.Expect(record.Value).To(Equal(status.CNAMETarget))
+
+	// adding it again changes nothing
+	app, err = client.AddApplicationDomain(app.ID, "www.example.com")
+	g.Expect(err).To(BeNil())
+	g.Expect(app.Domains).To(Equal([]string{"www.example.com"}))
+
+	app, err = client.RemoveApplicationDomain(app.ID, "www.example.com")
+	g.Expect(err).To(BeNil())
+	g.Expect(app.Domains).To(BeEmpty())
+
+	_, err = client.RemoveApplicationDomain(app.ID, "www.example.com")
+	g.Expect(errors.Is(err, ErrAppDomainNotFound)).To(BeTrue())
+
+	_, err = client.AddApplicationDomain(app.ID, "localhost")
+	g.Expect(errors.Is(err, ApplicationInvalidError)).To(BeTrue())
+
+	_, err = client.AddApplicationDomainWithDNS(app.ID, "www.example.net")
+	g.Expect(errors.Is(err, ErrDNSDomainNotFound)).To(BeTrue())
+}
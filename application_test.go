@@ -1,8 +1,14 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+
+	. "github.com/onsi/gomega"
 )
 
 func TestListApplications(t *testing.T) {
@@ -112,3 +118,77 @@ func TestDeleteApplication(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+func TestApplicationProcessAndConfigVars(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var updates []UpdateApplicationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			rw.Write([]byte(`{"id": "12345", "name": "web", "image": "nginx", "size": "small",
+				"process_info": [{"processType": "web", "processCount": 1}, {"processType": "worker", "processCount": 1}],
+				"config": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}]}`))
+		case "PUT":
+			update := UpdateApplicationRequest{}
+			json.NewDecoder(req.Body).Decode(&update)
+			updates = append(updates, update)
+			rw.Write([]byte(`{"id": "12345", "name": "web"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.ScaleApplicationProcess("12345", "worker", 3)
+	g.Expect(err).To(BeNil())
+	g.Expect(updates[0].Name).To(Equal("web"))
+	g.Expect(updates[0].Image).To(Equal("nginx"))
+	g.Expect(updates[0].ProcessInfo).To(Equal([]ProcessInfo{{ProcessType: "web", ProcessCount: 1}, {ProcessType: "worker", ProcessCount: 3}}))
+
+	_, err = client.ScaleApplicationProcess("12345", "cron", 1)
+	g.Expect(errors.Is(err, ApplicationProcessNotFoundError)).To(BeTrue())
+	_, err = client.ScaleApplicationProcess("12345", "web", -1)
+	g.Expect(errors.Is(err, ApplicationInvalidError)).To(BeTrue())
+
+	_, err = client.SetApplicationConfigVars("12345", map[string]string{"B": "two", "D": "4", "C": "3"})
+	g.Expect(err).To(BeNil())
+	g.Expect(updates[1].Config).To(Equal([]EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "two"}, {Name: "C", Value: "3"}, {Name: "D", Value: "4"}}))
+
+	_, err = client.UnsetApplicationConfigVars("12345", "A", "Z")
+	g.Expect(err).To(BeNil())
+	g.Expect(updates[2].Config).To(Equal([]EnvVar{{Name: "B", Value: "2"}}))
+	g.Expect(updates).To(HaveLen(3))
+}
+
+func TestRestartApplication(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/applications/12345/restart": `{"result":"success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.RestartApplication("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Result != "success" {
+		t.Errorf("Expected %s, got %s", "success", got.Result)
+	}
+}
+
+func TestGetApplicationLogsURL(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/applications/12345/logs": `{"url":"wss://logs.example.com/12345?token=abc"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetApplicationLogsURL("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got != "wss://logs.example.com/12345?token=abc" {
+		t.Errorf("Expected %s, got %s", "wss://logs.example.com/12345?token=abc", got)
+	}
+}
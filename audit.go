@@ -0,0 +1,111 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// AuditEvent records who did what to which resource of the account, and when
+type AuditEvent struct {
+	ID           string          `json:"id"`
+	AccountID    string          `json:"account_id"`
+	ActorID      string          `json:"actor_id"`
+	ActorEmail   string          `json:"actor_email,omitempty"`
+	ActorType    string          `json:"actor_type,omitempty"`
+	Action       string          `json:"action"`
+	ResourceType string          `json:"resource_type"`
+	ResourceID   string          `json:"resource_id,omitempty"`
+	ResourceName string          `json:"resource_name,omitempty"`
+	Region       string          `json:"region,omitempty"`
+	IPAddress    string          `json:"ip_address,omitempty"`
+	UserAgent    string          `json:"user_agent,omitempty"`
+	Details      json.RawMessage `json:"details,omitempty"`
+	CreatedAt    Time            `json:"created_at"`
+}
+
+// PaginatedAuditEvents is a page of audit events
+type PaginatedAuditEvents struct {
+	Page    int          `json:"page"`
+	PerPage int          `json:"per_page"`
+	Pages   int          `json:"pages"`
+	Items   []AuditEvent `json:"items"`
+}
+
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedAuditEvents) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
+// AuditFilter narrows down the audit events listed, zero fields don't filter
+type AuditFilter struct {
+	ActorID      string    `url:"actor_id,omitempty"`
+	ResourceType string    `url:"resource_type,omitempty"`
+	ResourceID   string    `url:"resource_id,omitempty"`
+	Action       string    `url:"action,omitempty"`
+	Since        time.Time `url:"since,omitempty"`
+	Until        time.Time `url:"until,omitempty"`
+	Page         int       `url:"page,omitempty"`
+	PerPage      int       `url:"per_page,omitempty"`
+}
+
+// auditEventsPath returns the path listing the audit events matching opts
+func auditEventsPath(opts AuditFilter) (string, error) {
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		err := errors.New("the end of the time range of the audit filter is before its start")
+		return "", AuditFilterInvalidError.wrap(err)
+	}
+
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+	if len(vals) == 0 {
+		return "/v2/audit_events", nil
+	}
+
+	return fmt.Sprintf("/v2/audit_events?%s", vals.Encode()), nil
+}
+
+// ListAuditEvents returns a page, chosen by the Page and PerPage of opts, of the audit events matching opts, newest first
+func (c *Client) ListAuditEvents(opts AuditFilter) (*PaginatedAuditEvents, error) {
+	path, err := auditEventsPath(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendGetRequest(path)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	events := &PaginatedAuditEvents{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(events); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return events, nil
+}
+
+// AuditEventsPaginator returns a Paginator over the audit events matching opts, perPage at a time,
+// the Page and PerPage of opts are ignored
+func (c *Client) AuditEventsPaginator(opts AuditFilter, perPage int) *Paginator[AuditEvent] {
+	return NewPaginator(perPage, func(page, perPage int) (*Page[AuditEvent], error) {
+		opts.Page, opts.PerPage = page, perPage
+		events, err := c.ListAuditEvents(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page[AuditEvent]{Page: events.Page, PerPage: events.PerPage, Pages: events.Pages, Items: events.Items}, nil
+	})
+}
+
+// ListAllAuditEvents returns every audit event matching opts, walking all the pages
+func (c *Client) ListAllAuditEvents(opts AuditFilter) ([]AuditEvent, error) {
+	return c.AuditEventsPaginator(opts, defaultPerPage).All()
+}
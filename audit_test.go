@@ -0,0 +1,62 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/audit_events": `{"page": 1, "per_page": 20, "pages": 1, "items": [{
+			"id": "1",
+			"actor_id": "user-1",
+			"actor_email": "ops@example.com",
+			"action": "instance.delete",
+			"resource_type": "instance",
+			"resource_id": "12345",
+			"details": {"hostname": "web"},
+			"created_at": "2024-01-02T03:04:05Z"
+		}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.ListAuditEvents(AuditFilter{ActorID: "user-1"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Items).To(HaveLen(1))
+	g.Expect(got.Items[0].Action).To(Equal("instance.delete"))
+	g.Expect(got.Items[0].CreatedAt.Time).To(Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	g.Expect(string(got.Items[0].Details)).To(Equal(`{"hostname": "web"}`))
+
+	_, err = client.ListAuditEvents(AuditFilter{Since: time.Now(), Until: time.Now().Add(-time.Hour)})
+	g.Expect(errors.Is(err, AuditFilterInvalidError)).To(BeTrue())
+}
+
+func TestListAllAuditEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	queries := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Encode())
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"page": 1, "pages": 2, "items": [{"id": "1"}]}`))
+			return
+		}
+		w.Write([]byte(`{"page": 2, "pages": 2, "items": [{"id": "2"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events, err := client.ListAllAuditEvents(AuditFilter{ResourceType: "instance", Since: since})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(events).To(HaveLen(2))
+	g.Expect(queries[0]).To(Equal("page=1&per_page=100&region=TEST&resource_type=instance&since=2024-01-01T00%3A00%3A00Z"))
+}
@@ -0,0 +1,75 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Actions of a KubernetesScalingEvent
+const (
+	KubernetesScalingActionScaleUp   = "scale_up"
+	KubernetesScalingActionScaleDown = "scale_down"
+)
+
+// KubernetesScalingEvent is a change of the node count of a pool of a Kubernetes cluster, made by the
+// cluster autoscaler or by hand
+type KubernetesScalingEvent struct {
+	ID        string `json:"id"`
+	ClusterID string `json:"cluster_id"`
+	PoolID    string `json:"pool_id"`
+	Action    string `json:"action"`
+	FromCount int    `json:"from_count"`
+	ToCount   int    `json:"to_count"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+// AutoscalingEnabled reports whether the cluster autoscaler manages the node count of the pool
+func (p *KubernetesPool) AutoscalingEnabled() bool {
+	return p.MaxCount > 0
+}
+
+// EnablePoolAutoscaling lets the cluster autoscaler scale the pool of a kubernetes cluster between
+// min and max nodes
+func (c *Client) EnablePoolAutoscaling(clusterID, poolID string, min, max int) (*KubernetesPool, error) {
+	if max < 1 {
+		err := fmt.Errorf("max count must be at least 1, got %d", max)
+		return nil, KubernetesPoolInvalidError.wrap(err)
+	}
+	if err := validatePoolCounts(0, min, max); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateKubernetesClusterPool(clusterID, poolID, &KubernetesClusterPoolUpdateConfig{
+		MinCount: &min,
+		MaxCount: &max,
+		Region:   c.Region,
+	})
+}
+
+// DisablePoolAutoscaling stops the cluster autoscaler from scaling the pool of a kubernetes cluster,
+// leaving its current node count
+func (c *Client) DisablePoolAutoscaling(clusterID, poolID string) (*KubernetesPool, error) {
+	zero := 0
+	return c.UpdateKubernetesClusterPool(clusterID, poolID, &KubernetesClusterPoolUpdateConfig{
+		MinCount: &zero,
+		MaxCount: &zero,
+		Region:   c.Region,
+	})
+}
+
+// GetClusterScalingEvents returns the scaling events of the pools of a kubernetes cluster, newest first
+func (c *Client) GetClusterScalingEvents(clusterID string) ([]KubernetesScalingEvent, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/scaling_events", clusterID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	events := make([]KubernetesScalingEvent, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&events); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return events, nil
+}
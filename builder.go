@@ -0,0 +1,373 @@
+package civogo
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/civo/civogo/utils"
+)
+
+// InstanceConfigBuilder assembles an InstanceConfig, e.g.
+//
+//	config, err := civogo.NewInstanceConfigBuilder().
+//		Size("g4s.medium").
+//		Network(networkID).
+//		DiskImage(diskImageID).
+//		SSHKey(sshKeyID).
+//		Tags("web", "prod").
+//		Build()
+type InstanceConfigBuilder struct {
+	config InstanceConfig
+}
+
+// NewInstanceConfigBuilder returns a builder for a single instance with a random hostname, a public IP
+// and the civo initial user
+func NewInstanceConfigBuilder() *InstanceConfigBuilder {
+	return &InstanceConfigBuilder{config: InstanceConfig{
+		Count:            1,
+		Hostname:         utils.RandomName(),
+		PublicIPRequired: "true",
+		InitialUser:      "civo",
+	}}
+}
+
+// Hostname sets the hostname of the instance
+func (b *InstanceConfigBuilder) Hostname(hostname string) *InstanceConfigBuilder {
+	b.config.Hostname = hostname
+	return b
+}
+
+// Count sets the number of instances to create
+func (b *InstanceConfigBuilder) Count(count int) *InstanceConfigBuilder {
+	b.config.Count = count
+	return b
+}
+
+// Size sets the size of the instance, e.g. g4s.medium
+func (b *InstanceConfigBuilder) Size(size string) *InstanceConfigBuilder {
+	b.config.Size = size
+	return b
+}
+
+// Region sets the region of the instance, the one of the client by default
+func (b *InstanceConfigBuilder) Region(region string) *InstanceConfigBuilder {
+	b.config.Region = region
+	return b
+}
+
+// Network sets the ID of the network of the instance
+func (b *InstanceConfigBuilder) Network(networkID string) *InstanceConfigBuilder {
+	b.config.NetworkID = networkID
+	return b
+}
+
+// DiskImage sets the ID of the disk image the instance boots from
+func (b *InstanceConfigBuilder) DiskImage(diskImageID string) *InstanceConfigBuilder {
+	b.config.TemplateID = diskImageID
+	return b
+}
+
+// SSHKey sets the ID of the SSH key of the initial user
+func (b *InstanceConfigBuilder) SSHKey(sshKeyID string) *InstanceConfigBuilder {
+	b.config.SSHKeyID = sshKeyID
+	return b
+}
+
+// InitialUser sets the name of the initial user
+func (b *InstanceConfigBuilder) InitialUser(user string) *InstanceConfigBuilder {
+	b.config.InitialUser = user
+	return b
+}
+
+// Firewall sets the ID of the firewall of the instance
+func (b *InstanceConfigBuilder) Firewall(firewallID string) *InstanceConfigBuilder {
+	b.config.FirewallID = firewallID
+	return b
+}
+
+// PublicIP sets whether the instance gets a public IP
+func (b *InstanceConfigBuilder) PublicIP(required bool) *InstanceConfigBuilder {
+	b.config.PublicIPRequired = strconv.FormatBool(required)
+	return b
+}
+
+// ReservedIP makes the instance use a reserved IP
+func (b *InstanceConfigBuilder) ReservedIP(ip string) *InstanceConfigBuilder {
+	b.config.ReservedIPv4 = ip
+	return b
+}
+
+// Script sets the cloud-init user data or script run on the first boot, plaintext or base64 encoded
+func (b *InstanceConfigBuilder) Script(script string) *InstanceConfigBuilder {
+	b.config.Script = script
+	return b
+}
+
+// Tags adds tags to the instance
+func (b *InstanceConfigBuilder) Tags(tags ...string) *InstanceConfigBuilder {
+	b.config.Tags = append(b.config.Tags, tags...)
+	return b
+}
+
+// Label adds a label to the instance
+func (b *InstanceConfigBuilder) Label(key, value string) *InstanceConfigBuilder {
+	if b.config.Labels == nil {
+		b.config.Labels = map[string]string{}
+	}
+	b.config.Labels[key] = value
+	return b
+}
+
+// VolumeType sets the type of the root volume
+func (b *InstanceConfigBuilder) VolumeType(volumeType string) *InstanceConfigBuilder {
+	b.config.VolumeType = volumeType
+	return b
+}
+
+// AttachVolumes attaches existing volumes to the instance
+func (b *InstanceConfigBuilder) AttachVolumes(volumeIDs ...string) *InstanceConfigBuilder {
+	for _, id := range volumeIDs {
+		b.config.AttachedVolumes = append(b.config.AttachedVolumes, AttachedVolume{ID: id})
+	}
+	return b
+}
+
+// PlacementGroup creates the instance in the placement group, see CreatePlacementGroup
+func (b *InstanceConfigBuilder) PlacementGroup(id string) *InstanceConfigBuilder {
+	b.config.PlacementGroupID = id
+	return b
+}
+
+// GPU asks for count GPUs of gpuType, the size must be a GPU size offering them, see GPUSizes
+func (b *InstanceConfigBuilder) GPU(gpuType string, count int) *InstanceConfigBuilder {
+	b.config.GPUType = gpuType
+	b.config.GPUCount = count
+	return b
+}
+
+// Build returns the config, which needs a hostname, a size, a network and a disk image.
+// The rejected fields are listed in the ValidationErrors it wraps
+func (b *InstanceConfigBuilder) Build() (*InstanceConfig, error) {
+	var errs ValidationErrors
+	if b.config.Hostname == "" {
+		errs.add("hostname", b.config.Hostname, "the hostname is empty")
+	}
+	if b.config.Count < 1 {
+		errs.add("count", b.config.Count, "the count must be at least 1, got %d", b.config.Count)
+	}
+	if b.config.Size == "" {
+		errs.add("size", b.config.Size, "the size is empty")
+	}
+	if b.config.NetworkID == "" {
+		errs.add("network_id", b.config.NetworkID, "the network is empty")
+	}
+	if b.config.TemplateID == "" {
+		errs.add("template_id", b.config.TemplateID, "the disk image is empty")
+	}
+	if b.config.GPUCount < 0 {
+		errs.add("gpu_count", b.config.GPUCount, "the GPU count must not be negative, got %d", b.config.GPUCount)
+	}
+	if b.config.GPUCount > 0 && b.config.GPUType == "" {
+		errs.add("gpu_type", b.config.GPUType, "the GPU type is empty")
+	}
+	if err := errs.wrap(InstanceConfigInvalidError); err != nil {
+		return nil, err
+	}
+
+	config := b.config
+	config.Tags = cloneSlice(b.config.Tags)
+	config.Labels = cloneMap(b.config.Labels)
+	config.AttachedVolumes = cloneSlice(b.config.AttachedVolumes)
+	return &config, nil
+}
+
+// KubernetesClusterConfigBuilder assembles a KubernetesClusterConfig, e.g.
+//
+//	config, err := civogo.NewKubernetesClusterConfigBuilder("prod").
+//		Network(networkID).
+//		Pool("g4s.kube.medium", 3).
+//		Applications("metrics-server").
+//		Build()
+type KubernetesClusterConfigBuilder struct {
+	config KubernetesClusterConfig
+}
+
+// NewKubernetesClusterConfigBuilder returns a builder for a k3s cluster named name using flannel
+func NewKubernetesClusterConfigBuilder(name string) *KubernetesClusterConfigBuilder {
+	return &KubernetesClusterConfigBuilder{config: KubernetesClusterConfig{
+		Name:        name,
+		ClusterType: KubernetesClusterTypeK3s,
+		CNIPlugin:   KubernetesCNIPluginFlannel,
+	}}
+}
+
+// ClusterType sets the type of the cluster, e.g. KubernetesClusterTypeTalos
+func (b *KubernetesClusterConfigBuilder) ClusterType(clusterType string) *KubernetesClusterConfigBuilder {
+	b.config.ClusterType = clusterType
+	return b
+}
+
+// Version sets the Kubernetes version of the cluster, the default one of the API otherwise
+func (b *KubernetesClusterConfigBuilder) Version(version string) *KubernetesClusterConfigBuilder {
+	b.config.KubernetesVersion = version
+	return b
+}
+
+// CNIPlugin sets the network plugin of the cluster, e.g. KubernetesCNIPluginCilium
+func (b *KubernetesClusterConfigBuilder) CNIPlugin(plugin string) *KubernetesClusterConfigBuilder {
+	b.config.CNIPlugin = plugin
+	return b
+}
+
+// Network sets the ID of the network of the cluster
+func (b *KubernetesClusterConfigBuilder) Network(networkID string) *KubernetesClusterConfigBuilder {
+	b.config.NetworkID = networkID
+	return b
+}
+
+// Firewall makes the nodes use an existing firewall
+func (b *KubernetesClusterConfigBuilder) Firewall(firewallID string) *KubernetesClusterConfigBuilder {
+	b.config.InstanceFirewall = firewallID
+	return b
+}
+
+// FirewallRule sets the rule of the firewall created for the nodes, e.g. "80,443,6443"
+func (b *KubernetesClusterConfigBuilder) FirewallRule(rule string) *KubernetesClusterConfigBuilder {
+	b.config.FirewallRule = rule
+	return b
+}
+
+// Pool adds a node pool of count nodes of the given size
+func (b *KubernetesClusterConfigBuilder) Pool(size string, count int) *KubernetesClusterConfigBuilder {
+	return b.AddPool(KubernetesClusterPoolConfig{Size: size, Count: count})
+}
+
+// AddPool adds a node pool
+func (b *KubernetesClusterConfigBuilder) AddPool(pool KubernetesClusterPoolConfig) *KubernetesClusterConfigBuilder {
+	b.config.Pools = append(b.config.Pools, pool)
+	return b
+}
+
+// Applications adds marketplace applications, each a name or a name:plan
+func (b *KubernetesClusterConfigBuilder) Applications(apps ...string) *KubernetesClusterConfigBuilder {
+	b.config.AddApplications(apps...)
+	return b
+}
+
+// Tags sets the tags of the cluster, space separated
+func (b *KubernetesClusterConfigBuilder) Tags(tags string) *KubernetesClusterConfigBuilder {
+	b.config.Tags = tags
+	return b
+}
+
+// Label adds a label to the cluster
+func (b *KubernetesClusterConfigBuilder) Label(key, value string) *KubernetesClusterConfigBuilder {
+	if b.config.Labels == nil {
+		b.config.Labels = map[string]string{}
+	}
+	b.config.Labels[key] = value
+	return b
+}
+
+// Build returns the config, which needs at least one pool and must pass KubernetesClusterConfig.Validate
+func (b *KubernetesClusterConfigBuilder) Build() (*KubernetesClusterConfig, error) {
+	if len(b.config.Pools) == 0 {
+		return nil, KubernetesClusterConfigInvalidError.wrap(errors.New("the cluster has no pool"))
+	}
+	if err := b.config.Validate(); err != nil {
+		return nil, err
+	}
+
+	config := b.config
+	config.Pools = cloneSlice(b.config.Pools)
+	config.Labels = cloneMap(b.config.Labels)
+	return &config, nil
+}
+
+// FirewallRuleConfigBuilder assembles a FirewallRuleConfig, e.g.
+//
+//	rule, err := civogo.NewFirewallRuleConfigBuilder().Port(443).Cidr("10.0.0.0/8").Label("https").Build()
+type FirewallRuleConfigBuilder struct {
+	config FirewallRuleConfig
+}
+
+// NewFirewallRuleConfigBuilder returns a builder for a TCP ingress rule allowing 0.0.0.0/0
+func NewFirewallRuleConfigBuilder() *FirewallRuleConfigBuilder {
+	return &FirewallRuleConfigBuilder{config: FirewallRuleConfig{
+		Protocol:  FirewallRuleProtocolTCP,
+		Direction: FirewallRuleDirectionIngress,
+		Action:    FirewallRuleActionAllow,
+	}}
+}
+
+// Firewall sets the ID of the firewall of the rule
+func (b *FirewallRuleConfigBuilder) Firewall(firewallID string) *FirewallRuleConfigBuilder {
+	b.config.FirewallID = firewallID
+	return b
+}
+
+// Protocol sets the protocol of the rule, e.g. FirewallRuleProtocolUDP
+func (b *FirewallRuleConfigBuilder) Protocol(protocol string) *FirewallRuleConfigBuilder {
+	b.config.Protocol = protocol
+	return b
+}
+
+// Egress makes the rule apply to outgoing traffic
+func (b *FirewallRuleConfigBuilder) Egress() *FirewallRuleConfigBuilder {
+	b.config.Direction = FirewallRuleDirectionEgress
+	return b
+}
+
+// Deny makes the rule deny the traffic it matches
+func (b *FirewallRuleConfigBuilder) Deny() *FirewallRuleConfigBuilder {
+	b.config.Action = FirewallRuleActionDeny
+	return b
+}
+
+// Port makes the rule match a single port
+func (b *FirewallRuleConfigBuilder) Port(port int) *FirewallRuleConfigBuilder {
+	return b.PortRange(port, port)
+}
+
+// PortRange makes the rule match the ports from start to end
+func (b *FirewallRuleConfigBuilder) PortRange(start, end int) *FirewallRuleConfigBuilder {
+	b.config.StartPort = strconv.Itoa(start)
+	b.config.EndPort = strconv.Itoa(end)
+	return b
+}
+
+// Ports makes the rule match a list of ports and ranges, e.g. "80,443,8000-8100"
+func (b *FirewallRuleConfigBuilder) Ports(ports string) *FirewallRuleConfigBuilder {
+	b.config.Ports = ports
+	return b
+}
+
+// Cidr adds source, or destination for egress rules, CIDRs to the rule, 0.0.0.0/0 when none is added
+func (b *FirewallRuleConfigBuilder) Cidr(cidrs ...string) *FirewallRuleConfigBuilder {
+	b.config.Cidr = append(b.config.Cidr, cidrs...)
+	return b
+}
+
+// Label sets the label of the rule
+func (b *FirewallRuleConfigBuilder) Label(label string) *FirewallRuleConfigBuilder {
+	b.config.Label = label
+	return b
+}
+
+// Build returns the rule, which needs ports unless it is an ICMP one and must pass FirewallRuleConfig.Validate
+func (b *FirewallRuleConfigBuilder) Build() (*FirewallRuleConfig, error) {
+	config := b.config
+	config.Cidr = cloneSlice(b.config.Cidr)
+	if len(config.Cidr) == 0 {
+		config.Cidr = []string{"0.0.0.0/0"}
+	}
+
+	if config.StartPort == "" && config.Ports == "" && config.Protocol != FirewallRuleProtocolICMP {
+		return nil, FirewallRuleInvalidError.wrap(errors.New("the rule has no port"))
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
@@ -0,0 +1,88 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestInstanceConfigBuilder(t *testing.T) {
+	g := NewWithT(t)
+
+	config, err := NewInstanceConfigBuilder().
+		Hostname("web-1").
+		Size("g4s.medium").
+		Network("net-1").
+		DiskImage("ubuntu").
+		SSHKey("key-1").
+		PublicIP(false).
+		Tags("web", "prod").
+		Label("team", "ops").
+		AttachVolumes("vol-1").
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.Count).To(Equal(1))
+	g.Expect(config.Hostname).To(Equal("web-1"))
+	g.Expect(config.InitialUser).To(Equal("civo"))
+	g.Expect(config.PublicIPRequired).To(Equal("false"))
+	g.Expect(config.TemplateID).To(Equal("ubuntu"))
+	g.Expect(config.Tags).To(Equal([]string{"web", "prod"}))
+	g.Expect(config.Labels).To(Equal(map[string]string{"team": "ops"}))
+	g.Expect(config.AttachedVolumes).To(Equal([]AttachedVolume{{ID: "vol-1"}}))
+
+	_, err = NewInstanceConfigBuilder().Size("g4s.medium").DiskImage("ubuntu").Build()
+	g.Expect(errors.Is(err, InstanceConfigInvalidError)).To(BeTrue())
+
+	_, err = NewInstanceConfigBuilder().Hostname("").GPU("A100", -1).Build()
+	var errs ValidationErrors
+	g.Expect(errors.As(err, &errs)).To(BeTrue())
+	g.Expect(errs.Fields()).To(Equal([]string{"hostname", "size", "network_id", "template_id", "gpu_count"}))
+
+	builder := NewInstanceConfigBuilder().Hostname("ml-1").Size("an1.a100.x2").Network("net-1").DiskImage("ubuntu")
+	config, err = builder.GPU("A100", 2).Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.GPUType).To(Equal("A100"))
+	g.Expect(config.GPUCount).To(Equal(2))
+
+	_, err = builder.GPU("", 2).Build()
+	g.Expect(errors.Is(err, InstanceConfigInvalidError)).To(BeTrue())
+}
+
+func TestKubernetesClusterConfigBuilder(t *testing.T) {
+	g := NewWithT(t)
+
+	config, err := NewKubernetesClusterConfigBuilder("prod").
+		Network("net-1").
+		Pool("g4s.kube.medium", 3).
+		Applications("metrics-server", "traefik2-nodeport:basic").
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.Name).To(Equal("prod"))
+	g.Expect(config.ClusterType).To(Equal(KubernetesClusterTypeK3s))
+	g.Expect(config.Pools).To(Equal([]KubernetesClusterPoolConfig{{Size: "g4s.kube.medium", Count: 3}}))
+	g.Expect(config.ApplicationList()).To(Equal([]string{"metrics-server", "traefik2-nodeport:basic"}))
+
+	_, err = NewKubernetesClusterConfigBuilder("prod").Build()
+	g.Expect(errors.Is(err, KubernetesClusterConfigInvalidError)).To(BeTrue())
+}
+
+func TestFirewallRuleConfigBuilder(t *testing.T) {
+	g := NewWithT(t)
+
+	rule, err := NewFirewallRuleConfigBuilder().Firewall("fw-1").Port(443).Label("https").Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rule.Protocol).To(Equal(FirewallRuleProtocolTCP))
+	g.Expect(rule.Direction).To(Equal(FirewallRuleDirectionIngress))
+	g.Expect(rule.StartPort).To(Equal("443"))
+	g.Expect(rule.EndPort).To(Equal("443"))
+	g.Expect(rule.Cidr).To(Equal([]string{"0.0.0.0/0"}))
+
+	rule, err = NewFirewallRuleConfigBuilder().Egress().Deny().PortRange(8000, 8100).Cidr("10.0.0.0/8").Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rule.Action).To(Equal(FirewallRuleActionDeny))
+	g.Expect(rule.Cidr).To(Equal([]string{"10.0.0.0/8"}))
+
+	_, err = NewFirewallRuleConfigBuilder().Build()
+	g.Expect(errors.Is(err, FirewallRuleInvalidError)).To(BeTrue())
+}
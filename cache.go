@@ -0,0 +1,119 @@
+package civogo
+
+import (
+	"sync"
+	"time"
+)
+
+// CacheClass is a class of rarely changing catalogue endpoints whose responses can be cached, see WithCache
+type CacheClass string
+
+const (
+	// CacheRegions caches ListRegions
+	CacheRegions CacheClass = "regions"
+	// CacheInstanceSizes caches ListInstanceSizes
+	CacheInstanceSizes CacheClass = "instance_sizes"
+	// CacheDiskImages caches ListDiskImages
+	CacheDiskImages CacheClass = "disk_images"
+)
+
+// responseCache holds the cached catalogue responses, shared by every copy of a Client
+type responseCache struct {
+	mu      sync.Mutex
+	ttls    map[CacheClass]time.Duration
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// WithCache makes the client keep the responses of the catalogue endpoints of each class of ttls in
+// memory for the given time, so processes listing them often don't use up their rate limit, e.g.
+//
+//	civogo.WithCache(map[civogo.CacheClass]time.Duration{
+//		civogo.CacheRegions:       time.Hour,
+//		civogo.CacheInstanceSizes: time.Hour,
+//		civogo.CacheDiskImages:    10 * time.Minute,
+//	})
+//
+// Responses are cached per region, classes left out or given a zero TTL aren't cached
+func WithCache(ttls map[CacheClass]time.Duration) ClientOption {
+	return func(c *Client) {
+		cache := &responseCache{
+			ttls:    make(map[CacheClass]time.Duration, len(ttls)),
+			entries: make(map[string]cacheEntry),
+		}
+		for class, ttl := range ttls {
+			if ttl > 0 {
+				cache.ttls[class] = ttl
+			}
+		}
+		c.cache = cache
+	}
+}
+
+// ClearCache drops every response cached by the client, so the next calls reach the API
+func (c *Client) ClearCache() {
+	if c.cache == nil {
+		return
+	}
+
+	c.cache.mu.Lock()
+	c.cache.entries = make(map[string]cacheEntry)
+	c.cache.mu.Unlock()
+}
+
+// sendCachedGetRequest is SendGetRequest answered from the cache of the client while the response
+// of requestURL in the current region is fresher than the TTL of class
+func (c *Client) sendCachedGetRequest(class CacheClass, requestURL string) ([]byte, error) {
+	if c.cache == nil {
+		return c.SendGetRequest(requestURL)
+	}
+
+	ttl, ok := c.cache.ttls[class]
+	if !ok {
+		return c.SendGetRequest(requestURL)
+	}
+
+	key := c.Region + " " + requestURL
+	if body, ok := c.cache.get(key); ok {
+		c.debugf("cache: %s served from the cache", requestURL)
+		c.LastJSONResponse = string(body)
+		return body, nil
+	}
+
+	body, err := c.SendGetRequest(requestURL)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.put(key, body, ttl)
+
+	return body, nil
+}
+
+// get returns the body cached under key, if it hasn't expired
+func (rc *responseCache) get(key string) ([]byte, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(rc.entries, key)
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+// put caches body under key for ttl
+func (rc *responseCache) put(key string, body []byte, ttl time.Duration) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.entries[key] = cacheEntry{body: body, expires: time.Now().Add(ttl)}
+}
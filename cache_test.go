@@ -0,0 +1,60 @@
+package civogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`[{"code": "lon1", "name": "London 1"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithCache(map[CacheClass]time.Duration{CacheRegions: time.Hour})(client)
+
+	for i := 0; i < 3; i++ {
+		regions, err := client.ListRegions()
+		g.Expect(err).To(BeNil())
+		g.Expect(regions).To(HaveLen(1))
+	}
+	g.Expect(requests).To(Equal(1))
+
+	_, err := client.WithRegion("FRA1").ListRegions()
+	g.Expect(err).To(BeNil())
+	g.Expect(requests).To(Equal(2))
+
+	_, err = client.ListInstanceSizes()
+	g.Expect(err).To(BeNil())
+	_, err = client.ListInstanceSizes()
+	g.Expect(err).To(BeNil())
+	g.Expect(requests).To(Equal(4))
+
+	client.ClearCache()
+	_, err = client.ListRegions()
+	g.Expect(err).To(BeNil())
+	g.Expect(requests).To(Equal(5))
+}
+
+func TestCacheExpiry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cache := &responseCache{entries: make(map[string]cacheEntry)}
+	cache.put("key", []byte("body"), time.Hour)
+	body, ok := cache.get("key")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(string(body)).To(Equal("body"))
+
+	cache.put("key", []byte("body"), -time.Second)
+	_, ok = cache.get("key")
+	g.Expect(ok).To(BeFalse())
+}
@@ -0,0 +1,265 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// CassetteMode says whether a cassette sends requests to the API or replays them from its file
+type CassetteMode int
+
+// Modes of a cassette
+const (
+	// CassetteReplay answers requests with the interactions of the file, without any network access
+	CassetteReplay CassetteMode = iota
+	// CassetteRecord sends requests to the API and records them, Save writes them to the file
+	CassetteRecord
+	// CassetteAuto replays the file if it exists and records it otherwise
+	CassetteAuto
+)
+
+// Interaction is a request to the API and its response, as recorded in a cassette
+type Interaction struct {
+	Method      string `yaml:"method"`
+	Path        string `yaml:"path"`
+	Query       string `yaml:"query,omitempty"`
+	RequestBody string `yaml:"request_body,omitempty"`
+
+	StatusCode   int                 `yaml:"status_code"`
+	Headers      map[string][]string `yaml:"headers,omitempty"`
+	ResponseBody string              `yaml:"response_body,omitempty"`
+}
+
+// Cassette records the interactions of a client with the API to a YAML fixture and replays them, so tests
+// can run against real responses without network access. Requests are matched on their method, path,
+// query and body, identical requests get the responses in the order they were recorded (the last one
+// repeating, for polling). The API key of the client is redacted from what is recorded, along with the
+// secret fields the client knows of, such as passwords, tokens, kubeconfigs, certificate private keys and
+// new API keys; other secrets are recorded as they are, so review a fixture before committing it.
+// A Cassette is an http.RoundTripper, see NewClientForCassette for the usual setup
+type Cassette struct {
+	// Path is the YAML file of the cassette
+	Path string
+	// Mode is CassetteReplay or CassetteRecord, CassetteAuto is resolved by NewCassette
+	Mode CassetteMode
+	// Interactions are the recorded requests and responses
+	Interactions []Interaction
+
+	// Transport sends the requests in record mode, defaults to http.DefaultTransport
+	Transport http.RoundTripper
+
+	sanitise func(path, body string) string
+	mu       sync.Mutex
+	replayed []bool
+}
+
+// NewCassette opens the cassette at path, loading its interactions unless mode records them
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	if mode == CassetteAuto {
+		mode = CassetteRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = CassetteReplay
+		}
+	}
+
+	cassette := &Cassette{Path: path, Mode: mode}
+	if mode == CassetteRecord {
+		return cassette, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &cassette.Interactions); err != nil {
+		return nil, fmt.Errorf("unable to read the cassette %s: %w", path, err)
+	}
+	return cassette, nil
+}
+
+// NewClientForCassette returns a client sending its requests through the cassette at path, e.g.
+//
+//	client, cassette, err := civogo.NewClientForCassette("testdata/instances.yaml", civogo.CassetteAuto, os.Getenv("CIVO_TOKEN"), "LON1")
+//	defer cassette.Save()
+//
+// The API key is only used when recording, replaying works with an empty one
+func NewClientForCassette(path string, mode CassetteMode, apiKey, region string, opts ...ClientOption) (*Client, *Cassette, error) {
+	cassette, err := NewCassette(path, mode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cassette.Mode == CassetteReplay && apiKey == "" {
+		apiKey = "TEST-API-KEY"
+	}
+
+	opts = append(opts, WithHTTPClient(&http.Client{Transport: cassette}))
+	client, err := NewClient(apiKey, region, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	cassette.sanitise = client.sanitise
+
+	return client, cassette, nil
+}
+
+// RoundTrip replays the response recorded for req, or sends it and records it
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+		req.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	request := Interaction{
+		Method:      req.Method,
+		Path:        req.URL.Path,
+		Query:       req.URL.Query().Encode(),
+		RequestBody: c.redact(req.URL.Path, body),
+	}
+
+	if c.Mode == CassetteRecord {
+		return c.record(req, request)
+	}
+	return c.replay(req, request)
+}
+
+// Save writes the recorded interactions to the file of the cassette, it does nothing when replaying
+func (c *Cassette) Save() error {
+	if c.Mode != CassetteRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := yaml.Marshal(c.Interactions)
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, data, 0o644)
+}
+
+// record sends req with the live transport and appends its interaction
+func (c *Cassette) record(req *http.Request, interaction Interaction) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.ResponseBody = c.redact(req.URL.Path, string(data))
+	interaction.Headers = map[string][]string{}
+	for name, values := range resp.Header {
+		if name != "Set-Cookie" {
+			interaction.Headers[name] = cloneSlice(values)
+		}
+	}
+
+	c.mu.Lock()
+	c.Interactions = append(c.Interactions, interaction)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay answers req with the first interaction matching request which wasn't replayed yet,
+// or the last matching one once they all were
+func (c *Cassette) replay(req *http.Request, request Interaction) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.replayed) != len(c.Interactions) {
+		c.replayed = make([]bool, len(c.Interactions))
+	}
+
+	found := -1
+	for i, interaction := range c.Interactions {
+		if !interaction.matches(request) {
+			continue
+		}
+		found = i
+		if !c.replayed[i] {
+			break
+		}
+	}
+	if found < 0 {
+		err := fmt.Errorf("%s %s?%s with body %q was not recorded in %s", request.Method, request.Path, request.Query, request.RequestBody, c.Path)
+		return nil, CassetteNoMatchError.wrap(err)
+	}
+	c.replayed[found] = true
+
+	interaction := c.Interactions[found]
+	header := http.Header{}
+	for name, values := range interaction.Headers {
+		header[name] = cloneSlice(values)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		ContentLength: int64(len(interaction.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// redact removes the API key and secret fields from body, both for recording and for matching
+func (c *Cassette) redact(path, body string) string {
+	if c.sanitise != nil {
+		return c.sanitise(path, body)
+	}
+	return redactSecrets(path, body)
+}
+
+// matches reports whether the recorded interaction is for request, JSON bodies are compared by value
+func (i Interaction) matches(request Interaction) bool {
+	if i.Method != request.Method || i.Path != request.Path || i.Query != request.Query {
+		return false
+	}
+	return sameBody(i.RequestBody, request.RequestBody)
+}
+
+// sameBody reports whether two request bodies are the same, ignoring whitespace and the order of JSON keys
+func sameBody(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == b {
+		return true
+	}
+
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
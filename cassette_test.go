@@ -0,0 +1,138 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/webhooks":
+			rw.Write([]byte(`{"id": "hook-1", "url": "https://example.com/hook", "secret": "s3cret"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			if atomic.AddInt32(&polls, 1) == 1 {
+				rw.Write([]byte(`{"id": "12345", "status": "BUILDING"}`))
+				return
+			}
+			rw.Write([]byte(`{"id": "12345", "status": "ACTIVE"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	path := filepath.Join(t.TempDir(), "cassettes", "webhooks.yaml")
+
+	client, cassette, err := NewClientForCassette(path, CassetteAuto, "live-api-key", "LON1", WithBaseURL(server.URL))
+	if err != nil {
+		t.Errorf("Creating the client returned an error: %s", err)
+		return
+	}
+	if cassette.Mode != CassetteRecord {
+		t.Errorf("Expected a missing cassette to be recorded, got mode %d", cassette.Mode)
+	}
+	if _, err := client.CreateWebhook(&WebhookConfig{URL: "https://example.com/hook", Events: []string{WebhookEventAll}, Secret: "s3cret"}); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetInstance("12345"); err != nil {
+			t.Errorf("Request returned an error: %s", err)
+		}
+	}
+	if err := cassette.Save(); err != nil {
+		t.Errorf("Saving the cassette returned an error: %s", err)
+	}
+	server.Close()
+
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "s3cret") || strings.Contains(string(data), "live-api-key") {
+		t.Errorf("Expected the secrets to be redacted, got %s", data)
+	}
+
+	client, cassette, err = NewClientForCassette(path, CassetteAuto, "", "LON1", WithBaseURL(server.URL))
+	if err != nil {
+		t.Errorf("Creating the client returned an error: %s", err)
+		return
+	}
+	if cassette.Mode != CassetteReplay || len(cassette.Interactions) != 3 {
+		t.Errorf("Expected the 3 recorded interactions to be replayed, got mode %d and %d", cassette.Mode, len(cassette.Interactions))
+	}
+
+	webhook, err := client.CreateWebhook(&WebhookConfig{URL: "https://example.com/hook", Events: []string{WebhookEventAll}, Secret: "another"})
+	if err != nil || webhook.ID != "hook-1" {
+		t.Errorf("Expected the webhook to be replayed, got %+v and %v", webhook, err)
+	}
+
+	statuses := []string{}
+	for i := 0; i < 3; i++ {
+		instance, err := client.GetInstance("12345")
+		if err != nil {
+			t.Errorf("Request returned an error: %s", err)
+			return
+		}
+		statuses = append(statuses, instance.Status)
+	}
+	if strings.Join(statuses, ",") != "BUILDING,ACTIVE,ACTIVE" {
+		t.Errorf("Expected the responses in recorded order, the last repeating, got %v", statuses)
+	}
+
+	if _, err := client.GetInstance("67890"); !errors.Is(err, CassetteNoMatchError) {
+		t.Errorf("Expected an CassetteNoMatchError for a request not recorded, got %v", err)
+	}
+}
+
+func TestCassetteMissingFile(t *testing.T) {
+	_, err := NewCassette(filepath.Join(t.TempDir(), "missing.yaml"), CassetteReplay)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a missing cassette to fail in replay mode, got %v", err)
+	}
+}
+
+func TestSameBody(t *testing.T) {
+	if !sameBody(`{"a": 1, "b": [2]}`, `{"b":[2],"a":1}`) {
+		t.Errorf("Expected JSON bodies to be compared by value")
+	}
+	if sameBody(`{"a": 1}`, `{"a": 2}`) || sameBody("a=1", "a=2") {
+		t.Errorf("Expected different bodies not to match")
+	}
+}
+
+func TestCassetteRedactsKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/api_keys":
+			rw.Write([]byte(`{"id": "3", "name": "deploy", "key": "n3w-api-key"}`))
+		case "/v2/loadbalancers/lb-1/certificates":
+			rw.Write([]byte(`{"id": "cert-1", "name": "www", "private_key": "PEM-PRIVATE-KEY"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+
+	client, cassette, err := NewClientForCassette(path, CassetteRecord, "live-api-key", "LON1", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("Creating the client returned an error: %s", err)
+	}
+	if _, err := client.CreateAPIKey("deploy"); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	if _, err := client.SendPostRequest("/v2/loadbalancers/lb-1/certificates", map[string]string{"name": "www", "private_key": "PEM-PRIVATE-KEY"}); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	if err := cassette.Save(); err != nil {
+		t.Fatalf("Saving the cassette returned an error: %s", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "n3w-api-key") || strings.Contains(string(data), "PEM-PRIVATE-KEY") {
+		t.Errorf("Expected the keys to be redacted, got %s", data)
+	}
+}
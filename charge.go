@@ -2,25 +2,37 @@ package civogo
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
 // Charge represents a Civo resource with number of hours within the specified billing period
 type Charge struct {
-	Code          string    `json:"code"`
-	Label         string    `json:"label"`
-	From          time.Time `json:"from"`
-	To            time.Time `json:"to"`
-	NumHours      int       `json:"num_hours"`
-	SizeGigabytes int       `json:"size_gb"`
+	Code          string  `json:"code"`
+	Label         string  `json:"label"`
+	From          Time    `json:"from"`
+	To            Time    `json:"to"`
+	NumHours      int     `json:"num_hours"`
+	SizeGigabytes int     `json:"size_gb"`
+	Cost          float64 `json:"cost,omitempty"`
 }
 
+// chargesCSVHeader is the first line written by ChargesToCSV
+var chargesCSVHeader = []string{"code", "label", "from", "to", "num_hours", "size_gb", "cost"}
+
 // ListCharges returns all charges for the calling API account
 func (c *Client) ListCharges(from, to time.Time) ([]Charge, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("the end of the billing period (%s) is before its start (%s)", to.Format(time.RFC3339), from.Format(time.RFC3339))
+	}
+
+	// times are sent in UTC, a "+hh:mm" offset would not survive the query string unescaped
 	url := "/v2/charges"
-	url = url + fmt.Sprintf("?from=%s&to=%s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	url = url + fmt.Sprintf("?from=%s&to=%s", from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
 
 	resp, err := c.SendGetRequest(url)
 	if err != nil {
@@ -34,3 +46,29 @@ func (c *Client) ListCharges(from, to time.Time) ([]Charge, error) {
 
 	return charges, nil
 }
+
+// ChargesToCSV writes charges to w as CSV, with a header line, for use in spreadsheets and finance tooling
+func ChargesToCSV(w io.Writer, charges []Charge) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(chargesCSVHeader); err != nil {
+		return err
+	}
+
+	for _, charge := range charges {
+		record := []string{
+			charge.Code,
+			charge.Label,
+			charge.From.Format(time.RFC3339),
+			charge.To.Format(time.RFC3339),
+			strconv.Itoa(charge.NumHours),
+			strconv.Itoa(charge.SizeGigabytes),
+			strconv.FormatFloat(charge.Cost, 'f', 2, 64),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
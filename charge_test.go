@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"bytes"
 	"testing"
 	"time"
 )
@@ -40,12 +41,12 @@ func TestListCharges(t *testing.T) {
 	}
 
 	test, _ := time.Parse(time.RFC3339, "2016-03-18T10:46:06Z")
-	if got[0].From != test {
+	if !got[0].From.Equal(test) {
 		t.Errorf("Expected %v, got %v", test, got[0].From)
 	}
 
 	test, _ = time.Parse(time.RFC3339, "2016-03-25T10:46:06Z")
-	if got[0].To != test {
+	if !got[0].To.Equal(test) {
 		t.Errorf("Expected %v, got %v", test, got[0].To)
 	}
 
@@ -61,3 +62,40 @@ func TestListCharges(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 200, got[0].SizeGigabytes)
 	}
 }
+
+func TestListChargesInvalidPeriod(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/charges": `[]`,
+	})
+	defer server.Close()
+
+	from := time.Date(2016, 3, 31, 0, 0, 0, 0, time.UTC)
+	if _, err := client.ListCharges(from, from.AddDate(0, -1, 0)); err == nil {
+		t.Errorf("Expected an error when the period ends before it starts")
+	}
+}
+
+func TestChargesToCSV(t *testing.T) {
+	charges := []Charge{
+		{
+			Code:          "instance-g1.small",
+			Label:         "furry-apple.example.com, web",
+			From:          NewTime(time.Date(2016, 3, 18, 10, 46, 6, 0, time.UTC)),
+			To:            NewTime(time.Date(2016, 3, 25, 10, 46, 6, 0, time.UTC)),
+			NumHours:      168,
+			SizeGigabytes: 200,
+			Cost:          12.5,
+		},
+	}
+
+	var out bytes.Buffer
+	if err := ChargesToCSV(&out, charges); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := "code,label,from,to,num_hours,size_gb,cost\n" +
+		"instance-g1.small,\"furry-apple.example.com, web\",2016-03-18T10:46:06Z,2016-03-25T10:46:06Z,168,200,12.50\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
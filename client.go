@@ -2,6 +2,7 @@ package civogo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,8 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/civo/civogo/utils"
 )
@@ -24,8 +27,31 @@ type Client struct {
 	LastJSONResponse string
 
 	httpClient *http.Client
+	ctx        context.Context
+
+	maxRetries     int
+	retryBaseDelay time.Duration
+
+	rateLimit *rateLimitState
+	logger    Logger
+	debug     bool
+	dryRun    bool
+
+	requestMiddleware []RequestMiddleware
+	responseHooks     []ResponseHook
+	tracerProvider    TracerProvider
+	metricsCollector  MetricsCollector
+	cache             *responseCache
+	credentials       *credentialsState
+	encodings         *encodingState
+
+	// optionErr is the first error of the options given to the constructor
+	optionErr error
 }
 
+// ClientOption configures optional behaviour of a Client at construction time
+type ClientOption func(*Client)
+
 // Component is a struct to define a User-Agent from a client
 type Component struct {
 	ID, Name, Version string
@@ -33,9 +59,10 @@ type Component struct {
 
 // HTTPError is the error returned when the API fails with an HTTP error
 type HTTPError struct {
-	Code   int
-	Status string
-	Reason string
+	Code      int
+	Status    string
+	Reason    string
+	RequestID string
 }
 
 // Result is the result of a SimpleResponse
@@ -70,12 +97,8 @@ func (e HTTPError) Error() string {
 	return fmt.Sprintf("%d: %s, %s", e.Code, e.Status, e.Reason)
 }
 
-// NewClientWithURL initializes a Client with a specific API URL
-func NewClientWithURL(apiKey, civoAPIURL, region string) (*Client, error) {
-	if apiKey == "" {
-		err := errors.New("no API Key supplied, this is required")
-		return nil, NoAPIKeySuppliedError.wrap(err)
-	}
+// NewClientWithURL initializes a Client with a specific API URL, the API key can be empty if opts include WithCredentialsProvider
+func NewClientWithURL(apiKey, civoAPIURL, region string, opts ...ClientOption) (*Client, error) {
 	parsedURL, err := url.Parse(civoAPIURL)
 	if err != nil {
 		return nil, err
@@ -93,13 +116,77 @@ func NewClientWithURL(apiKey, civoAPIURL, region string) (*Client, error) {
 		httpClient: &http.Client{
 			Transport: httpTransport,
 		},
+		rateLimit:   &rateLimitState{},
+		credentials: &credentialsState{},
+		encodings:   newEncodingState(),
+		debug:       debugFromEnv(),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if client.optionErr != nil {
+		return nil, client.optionErr
+	}
+	if !client.hasCredentials() {
+		err := errors.New("no API Key supplied, this is required")
+		return nil, NoAPIKeySuppliedError.wrap(err)
 	}
 	return client, nil
 }
 
-// NewClient initializes a Client connecting to the production API
-func NewClient(apiKey, region string) (*Client, error) {
-	return NewClientWithURL(apiKey, "https://api.civo.com", region)
+// NewClient initializes a Client connecting to the production API, customised by opts, e.g.
+//
+//	client, err := civogo.NewClient(apiKey, "LON1",
+//		civogo.WithTimeout(30*time.Second),
+//		civogo.WithRetries(3, time.Second),
+//	)
+func NewClient(apiKey, region string, opts ...ClientOption) (*Client, error) {
+	return NewClientWithURL(apiKey, "https://api.civo.com", region, opts...)
+}
+
+// WithHTTPClient makes the client send its requests through httpClient, for a custom transport or proxy
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithUserAgent prefixes the User-Agent of the client with component, like SetUserAgent
+func WithUserAgent(component *Component) ClientOption {
+	return func(c *Client) {
+		c.SetUserAgent(component)
+	}
+}
+
+// WithBaseURL points the client at another API endpoint, e.g. a staging environment
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		parsedURL, err := url.Parse(baseURL)
+		if err != nil {
+			c.setOptionErr(fmt.Errorf("invalid base URL %q: %w", baseURL, err))
+			return
+		}
+		c.BaseURL = parsedURL
+	}
+}
+
+// WithTimeout bounds the time each HTTP request can take, including reading the response
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		// copy the HTTP client so one given to WithHTTPClient is left untouched
+		httpClient := *c.httpClient
+		httpClient.Timeout = timeout
+		c.httpClient = &httpClient
+	}
+}
+
+// setOptionErr records the first error of the constructor options
+func (c *Client) setOptionErr(err error) {
+	if c.optionErr == nil {
+		c.optionErr = err
+	}
 }
 
 // NewAdvancedClientForTesting initializes a Client connecting to a local test server and allows for specifying methods
@@ -152,9 +239,28 @@ func NewAdvancedClientForTesting(responses []ConfigAdvanceClientForTesting) (*Cl
 
 // NewClientForTesting initializes a Client connecting to a local test server
 func NewClientForTesting(responses map[string]string) (*Client, *httptest.Server, error) {
+	server := httptest.NewServer(testingHandler(responses))
+
+	client, err := NewClientForTestingWithServer(server)
+
+	return client, server, err
+}
+
+// NewClientForTestingWithFaults is NewClientForTesting with the faults of rules injected into the responses,
+// e.g. to check retries cope with a burst of 429s
+func NewClientForTestingWithFaults(responses map[string]string, rules ...FaultRule) (*Client, *httptest.Server, error) {
+	server := httptest.NewServer(NewFaultInjector(testingHandler(responses), rules...))
+
+	client, err := NewClientForTestingWithServer(server)
+
+	return client, server, err
+}
+
+// testingHandler answers the requests whose URL contains a key of responses with its value
+func testingHandler(responses map[string]string) http.Handler {
 	var responseSent bool
 
-	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		for url, response := range responses {
 			if strings.Contains(req.URL.String(), url) {
 				responseSent = true
@@ -168,11 +274,7 @@ func NewClientForTesting(responses map[string]string) (*Client, *httptest.Server
 
 			rw.Write([]byte(`{"result": "failed to find a matching request"}`))
 		}
-	}))
-
-	client, err := NewClientForTestingWithServer(server)
-
-	return client, server, err
+	})
 }
 
 // NewClientForTestingWithServer initializes a Client connecting to a passed-in local test server
@@ -185,20 +287,81 @@ func NewClientForTestingWithServer(server *httptest.Server) (*Client, error) {
 	return client, err
 }
 
+// WithContext returns a shallow copy of the client whose requests are bound to ctx,
+// so any method called on the copy can be cancelled or given a deadline, e.g.
+// client.WithContext(ctx).ListDNSDomains()
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// WithRegion returns a shallow copy of the client sending its requests to another region,
+// e.g. client.WithRegion("LON1").ListInstances(), the original client keeps its region
+func (c *Client) WithRegion(region string) *Client {
+	c2 := *c
+	c2.Region = region
+	return &c2
+}
+
+// forEachConcurrently runs op for each of the n items with at most limit in flight, it returns once all are done.
+// Each call gets its own copy of the client so LastJSONResponse is not written concurrently
+func (c *Client) forEachConcurrently(n, limit int, op func(client *Client, i int)) {
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			op(c.WithContext(c.requestContext()), i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// requestContext returns the context requests made by this client are bound to
+func (c *Client) requestContext() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
 func (c *Client) prepareClientURL(requestURL string) *url.URL {
 	u, _ := url.Parse(c.BaseURL.String() + requestURL)
 	return u
 }
 
 func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
+	_, body, err := c.sendRequestResponse(req)
+	return body, err
+}
+
+// sendRequestResponse is sendRequest also returning the last response received, if any, whose body
+// has been read and can be read again
+func (c *Client) sendRequestResponse(req *http.Request) (*http.Response, []byte, error) {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.UserAgent)
-	req.Header.Set("Content-Type", "application/json")
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.Header.Set("Content-Encoding", "gzip")
-	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", c.APIKey))
+	apiKey, err := c.apiKey(req.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", apiKey))
 
-	c.httpClient.Transport = &http.Transport{
-		DisableCompression: false,
+	if err := setIdempotencyKey(req); err != nil {
+		return nil, nil, err
 	}
 
 	if req.Method == "GET" || req.Method == "DELETE" {
@@ -208,26 +371,90 @@ func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 		req.URL.RawQuery = param.Encode()
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	if c.skipDryRun(req) {
+		c.LastJSONResponse = dryRunResponse
+		resp := &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(dryRunResponse)),
+			Request:    req,
+		}
+		return resp, []byte(dryRunResponse), nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	c.LastJSONResponse = string(body)
+	span := c.startSpan(req)
+	if span != nil {
+		req = req.WithContext(span.ctx)
+	}
 
-	if resp.StatusCode >= 300 {
-		return nil, HTTPError{Code: resp.StatusCode, Status: resp.Status, Reason: string(body)}
+	resp, body, retries, err := c.doWithRetries(req)
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
 	}
+	span.end(status, retries, err)
 
-	return body, err
+	return resp, body, err
+}
+
+// doWithRetries sends req, retrying as configured, and returns the last response, with its body
+// restored, and its body along with the number of retries it took
+func (c *Client) doWithRetries(req *http.Request) (*http.Response, []byte, int, error) {
+	var last *http.Response
+	for attempt := 0; ; attempt++ {
+		if err := c.applyRequestMiddleware(req); err != nil {
+			return last, nil, attempt, err
+		}
+		c.debugRequest(req)
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.debugf("request: %s %s failed: %v", req.Method, req.URL.Path, err)
+			c.observeRequest(req.Method, req.URL.Path, 0, start, err)
+			return last, nil, attempt, err
+		}
+
+		last = resp
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		c.observeRequest(req.Method, req.URL.Path, resp.StatusCode, start, err)
+		c.LastJSONResponse = string(body)
+		c.debugResponse(req, resp, body)
+		if err := c.applyResponseHooks(resp, body); err != nil {
+			return last, nil, attempt, err
+		}
+		c.recordRateLimit(resp)
+
+		if attempt < c.maxRetries && isRetryableStatus(resp.StatusCode) {
+			c.logf("%s %s failed with %s, retrying (attempt %d of %d)", req.Method, req.URL.Path, resp.Status, attempt+1, c.maxRetries)
+			if err := waitForRetry(req.Context(), c.retryDelay(attempt, resp)); err != nil {
+				return last, nil, attempt, err
+			}
+			if req, err = rewindRequest(req); err != nil {
+				return last, nil, attempt, err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= 300 {
+			return last, nil, attempt, HTTPError{Code: resp.StatusCode, Status: resp.Status, Reason: string(body), RequestID: resp.Header.Get(requestIDHeader)}
+		}
+
+		return last, body, attempt, err
+	}
 }
 
 // SendGetRequest sends a correctly authenticated get request to the API server
 func (c *Client) SendGetRequest(requestURL string) ([]byte, error) {
+	return c.SendGetRequestContext(c.requestContext(), requestURL)
+}
+
+// SendGetRequestContext sends a correctly authenticated get request to the API server, bound to ctx
+func (c *Client) SendGetRequestContext(ctx context.Context, requestURL string) ([]byte, error) {
 	u := c.prepareClientURL(requestURL)
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -237,36 +464,35 @@ func (c *Client) SendGetRequest(requestURL string) ([]byte, error) {
 
 // SendPostRequest sends a correctly authenticated post request to the API server
 func (c *Client) SendPostRequest(requestURL string, params interface{}) ([]byte, error) {
-	u := c.prepareClientURL(requestURL)
-
-	// we create a new buffer and encode everything to json to send it in the request
-	jsonValue, _ := json.Marshal(params)
+	return c.SendPostRequestContext(c.requestContext(), requestURL, params)
+}
 
-	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonValue))
-	if err != nil {
-		return nil, err
-	}
-	return c.sendRequest(req)
+// SendPostRequestContext sends a correctly authenticated post request to the API server, bound to ctx.
+// params is encoded as JSON unless the endpoint takes forms, see WithRequestEncoding
+func (c *Client) SendPostRequestContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
+	return c.sendBodyRequest(ctx, "POST", requestURL, params)
 }
 
 // SendPutRequest sends a correctly authenticated put request to the API server
 func (c *Client) SendPutRequest(requestURL string, params interface{}) ([]byte, error) {
-	u := c.prepareClientURL(requestURL)
-
-	// we create a new buffer and encode everything to json to send it in the request
-	jsonValue, _ := json.Marshal(params)
+	return c.SendPutRequestContext(c.requestContext(), requestURL, params)
+}
 
-	req, err := http.NewRequest("PUT", u.String(), bytes.NewBuffer(jsonValue))
-	if err != nil {
-		return nil, err
-	}
-	return c.sendRequest(req)
+// SendPutRequestContext sends a correctly authenticated put request to the API server, bound to ctx.
+// params is encoded as JSON unless the endpoint takes forms, see WithRequestEncoding
+func (c *Client) SendPutRequestContext(ctx context.Context, requestURL string, params interface{}) ([]byte, error) {
+	return c.sendBodyRequest(ctx, "PUT", requestURL, params)
 }
 
 // SendDeleteRequest sends a correctly authenticated delete request to the API server
 func (c *Client) SendDeleteRequest(requestURL string) ([]byte, error) {
+	return c.SendDeleteRequestContext(c.requestContext(), requestURL)
+}
+
+// SendDeleteRequestContext sends a correctly authenticated delete request to the API server, bound to ctx
+func (c *Client) SendDeleteRequestContext(ctx context.Context, requestURL string) ([]byte, error) {
 	u := c.prepareClientURL(requestURL)
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,14 @@
 package civogo
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -55,3 +62,104 @@ func Test_AdvancedClientForTesting(t *testing.T) {
 	g.Expect(len(domains)).To(Equal(2))
 
 }
+
+func TestClientWithContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns": `[{"id": "12345", "account_id": "1", "name": "example.com"}]`,
+	})
+	defer server.Close()
+
+	domains, err := client.WithContext(context.Background()).ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	g.Expect(len(domains)).To(Equal(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = client.WithContext(ctx).ListDNSDomains()
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+	_, err = client.SendGetRequestContext(ctx, "/v2/dns")
+	g.Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+	// the original client is not bound to the cancelled context
+	_, err = client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+}
+
+func TestClientWithRegion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	regions := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		regions = append(regions, req.URL.Query().Get("region"))
+		rw.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.WithRegion("LON1").ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	_, err = client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+
+	g.Expect(regions).To(Equal([]string{"LON1", "TEST"}))
+	g.Expect(client.Region).To(Equal("TEST"))
+}
+
+func TestNewClientOptions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	httpClient := server.Client()
+	client, err := NewClient("TEST-API-KEY", "TEST",
+		WithBaseURL(server.URL),
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithUserAgent(&Component{Name: "terraform-provider-civo", Version: "1.0.0"}),
+	)
+	g.Expect(err).To(BeNil())
+	g.Expect(client.BaseURL.String()).To(Equal(server.URL))
+	g.Expect(client.httpClient.Timeout).To(Equal(5 * time.Second))
+	g.Expect(httpClient.Timeout).To(BeZero())
+
+	_, err = client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	g.Expect(userAgent).To(HavePrefix("terraform-provider-civo/1.0.0 civogo/"))
+
+	_, err = NewClient("TEST-API-KEY", "TEST", WithBaseURL("://no-scheme"))
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestWithLogger(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var logged bytes.Buffer
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(1, time.Millisecond)(client)
+	WithLogger(log.New(&logged, "", 0))(client)
+
+	_, err := client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	g.Expect(logged.String()).To(ContainSubstring("GET /v2/dns failed with 503 Service Unavailable, retrying (attempt 1 of 1)"))
+}
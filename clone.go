@@ -0,0 +1,33 @@
+package civogo
+
+// cloneSlice returns a copy of s, nil for a nil s
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// cloneMap returns a copy of m, nil for a nil m
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// cloneEach returns a copy of s with every element copied by clone, nil for a nil s
+func cloneEach[T any](s []T, clone func(*T) *T) []T {
+	if s == nil {
+		return nil
+	}
+	c := make([]T, len(s))
+	for i := range s {
+		c[i] = *clone(&s[i])
+	}
+	return c
+}
@@ -0,0 +1,65 @@
+package civogo
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestClone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	instance := &Instance{
+		ID:     "12345",
+		Tags:   []string{"web"},
+		Labels: map[string]string{"env": "prod"},
+		PlacementRule: PlacementRule{
+			AffinityRules: []AffinityRule{{Type: "affinity", Tags: []string{"db"}}},
+		},
+	}
+	clone := instance.Clone()
+	g.Expect(clone).To(Equal(instance))
+	clone.Tags[0] = "api"
+	clone.Labels["env"] = "dev"
+	clone.PlacementRule.AffinityRules[0].Tags[0] = "cache"
+	g.Expect(instance.Tags[0]).To(Equal("web"))
+	g.Expect(instance.Labels["env"]).To(Equal("prod"))
+	g.Expect(instance.PlacementRule.AffinityRules[0].Tags[0]).To(Equal("db"))
+
+	cluster := &KubernetesCluster{
+		ID: "69a23478",
+		Pools: []KubernetesPool{{
+			ID:     "workers",
+			Labels: map[string]string{"role": "worker"},
+			Taints: []corev1.Taint{{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+		}},
+		InstalledApplications: []KubernetesInstalledApplication{{
+			Name:          "traefik",
+			Configuration: map[string]ApplicationConfiguration{"traefik": {"replicas": "2"}},
+		}},
+	}
+	clusterClone := cluster.Clone()
+	g.Expect(clusterClone).To(Equal(cluster))
+	clusterClone.Pools[0].Labels["role"] = "gpu"
+	clusterClone.Pools[0].Taints[0].Key = "spot"
+	clusterClone.InstalledApplications[0].Configuration["traefik"]["replicas"] = "3"
+	g.Expect(cluster.Pools[0].Labels["role"]).To(Equal("worker"))
+	g.Expect(cluster.Pools[0].Taints[0].Key).To(Equal("gpu"))
+	g.Expect(cluster.InstalledApplications[0].Configuration["traefik"]["replicas"]).To(Equal("2"))
+
+	var nilVolume *Volume
+	g.Expect(nilVolume.Clone()).To(BeNil())
+}
+
+func TestFakeClientReturnsCopies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, _ := NewFakeClient()
+	client.InstanceList = []Instance{{ID: "12345", Hostname: "web", Labels: map[string]string{"env": "prod"}}}
+
+	instance, err := client.GetInstance("12345")
+	g.Expect(err).ToNot(HaveOccurred())
+	instance.Labels["env"] = "dev"
+	g.Expect(client.InstanceList[0].Labels["env"]).To(Equal("prod"))
+}
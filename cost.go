@@ -0,0 +1,163 @@
+package civogo
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// HoursPerMonth is the number of hours in a month of billing, converting between hourly and monthly prices
+const HoursPerMonth = 730
+
+// VolumePricePerGigabyteMonthly is the list price of a gigabyte of volume for a month, the sizes catalogue
+// doesn't include volumes so estimates of volumes use it
+const VolumePricePerGigabyteMonthly = 0.10
+
+// Kinds of resource priced in a CostEstimate
+const (
+	CostItemInstance   = "instance"
+	CostItemKubernetes = "kubernetes"
+	CostItemVolume     = "volume"
+)
+
+// CostItem is the price of one of the configs given to EstimateCost, or of a pool of a cluster
+type CostItem struct {
+	Kind     string  `json:"kind"`
+	Name     string  `json:"name"`
+	Size     string  `json:"size,omitempty"`
+	Quantity int     `json:"quantity"`
+	Hourly   float64 `json:"hourly"`
+	Monthly  float64 `json:"monthly"`
+}
+
+// CostEstimate is the price of a set of configs before they are created
+type CostEstimate struct {
+	Items   []CostItem `json:"items"`
+	Hourly  float64    `json:"hourly"`
+	Monthly float64    `json:"monthly"`
+}
+
+// EstimateCost prices InstanceConfig, KubernetesClusterConfig and VolumeConfig values (or pointers to them)
+// with the sizes catalogue, so the cost of resources is known before they are created
+func (c *Client) EstimateCost(configs ...interface{}) (CostEstimate, error) {
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return CostEstimate{}, err
+	}
+
+	return estimateCost(sizes, configs...)
+}
+
+// estimateCost prices configs with sizes, see EstimateCost
+func estimateCost(sizes []InstanceSize, configs ...interface{}) (CostEstimate, error) {
+	prices := make(map[string]InstanceSize, len(sizes))
+	for _, size := range sizes {
+		prices[size.Name] = size
+	}
+
+	priceSize := func(kind, name, sizeName string, quantity int) (CostItem, error) {
+		size, ok := prices[sizeName]
+		if !ok {
+			err := fmt.Errorf("the size %q of %s %q isn't in the sizes catalogue", sizeName, kind, name)
+			return CostItem{}, CostEstimateInvalidError.wrap(err)
+		}
+		hourly, monthly := size.PriceHourly, size.PriceMonthly
+		if monthly == 0 {
+			monthly = hourly * HoursPerMonth
+		}
+		if hourly == 0 {
+			hourly = monthly / HoursPerMonth
+		}
+		return CostItem{
+			Kind:     kind,
+			Name:     name,
+			Size:     sizeName,
+			Quantity: quantity,
+			Hourly:   roundCost(hourly * float64(quantity)),
+			Monthly:  roundCost(monthly * float64(quantity)),
+		}, nil
+	}
+
+	estimate := CostEstimate{Items: make([]CostItem, 0, len(configs))}
+	add := func(item CostItem, err error) error {
+		if err != nil {
+			return err
+		}
+		estimate.Items = append(estimate.Items, item)
+		estimate.Hourly = roundCost(estimate.Hourly + item.Hourly)
+		estimate.Monthly = roundCost(estimate.Monthly + item.Monthly)
+		return nil
+	}
+
+	for _, config := range configs {
+		switch cfg := config.(type) {
+		case *InstanceConfig, *KubernetesClusterConfig, *VolumeConfig:
+			if reflect.ValueOf(cfg).IsNil() {
+				err := fmt.Errorf("unable to price a nil %T", config)
+				return CostEstimate{}, CostEstimateInvalidError.wrap(err)
+			}
+		case InstanceConfig:
+			config = &cfg
+		case KubernetesClusterConfig:
+			config = &cfg
+		case VolumeConfig:
+			config = &cfg
+		}
+
+		switch cfg := config.(type) {
+		case *InstanceConfig:
+			count := cfg.Count
+			if count < 1 {
+				count = 1
+			}
+			if err := add(priceSize(CostItemInstance, cfg.Hostname, cfg.Size, count)); err != nil {
+				return CostEstimate{}, err
+			}
+		case *KubernetesClusterConfig:
+			pools := cfg.Pools
+			if len(pools) == 0 {
+				pools = []KubernetesClusterPoolConfig{{Count: cfg.NumTargetNodes, Size: cfg.TargetNodesSize}}
+			}
+			for i, pool := range pools {
+				count := pool.Count
+				if count == 0 {
+					count = pool.MinCount
+				}
+				name := fmt.Sprintf("%s pool %d", cfg.Name, i)
+				if pool.ID != "" {
+					name = fmt.Sprintf("%s pool %s", cfg.Name, pool.ID)
+				}
+				if err := add(priceSize(CostItemKubernetes, name, pool.Size, count)); err != nil {
+					return CostEstimate{}, err
+				}
+			}
+		case *VolumeConfig:
+			if cfg.SizeGigabytes < 1 {
+				err := fmt.Errorf("the volume %q has no size", cfg.Name)
+				return CostEstimate{}, CostEstimateInvalidError.wrap(err)
+			}
+			monthly := VolumePricePerGigabyteMonthly * float64(cfg.SizeGigabytes)
+			item := CostItem{
+				Kind:     CostItemVolume,
+				Name:     cfg.Name,
+				Size:     fmt.Sprintf("%dGB", cfg.SizeGigabytes),
+				Quantity: 1,
+				Hourly:   roundCost(monthly / HoursPerMonth),
+				Monthly:  roundCost(monthly),
+			}
+			if err := add(item, nil); err != nil {
+				return CostEstimate{}, err
+			}
+		default:
+			err := fmt.Errorf("unable to price a %T, only instance, Kubernetes cluster and volume configs are supported", config)
+			return CostEstimate{}, CostEstimateInvalidError.wrap(err)
+		}
+	}
+
+	return estimate, nil
+}
+
+// roundCost rounds a price to a hundredth of a cent, hiding the float errors of the sums
+func roundCost(price float64) float64 {
+	return math.Round(price*10000) / 10000
+}
@@ -0,0 +1,64 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sizes": `[
+			{"type": "Instance", "name": "g3.small", "price_hourly": 0.01, "price_monthly": 10},
+			{"type": "Kubernetes", "name": "g4s.kube.medium", "price_monthly": 21.9}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.EstimateCost(
+		InstanceConfig{Hostname: "web", Size: "g3.small", Count: 2},
+		&KubernetesClusterConfig{Name: "production", NumTargetNodes: 3, TargetNodesSize: "g4s.kube.medium"},
+		VolumeConfig{Name: "data", SizeGigabytes: 73},
+	)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := CostEstimate{
+		Items: []CostItem{
+			{Kind: CostItemInstance, Name: "web", Size: "g3.small", Quantity: 2, Hourly: 0.02, Monthly: 20},
+			{Kind: CostItemKubernetes, Name: "production pool 0", Size: "g4s.kube.medium", Quantity: 3, Hourly: 0.09, Monthly: 65.7},
+			{Kind: CostItemVolume, Name: "data", Size: "73GB", Quantity: 1, Hourly: 0.01, Monthly: 7.3},
+		},
+		Hourly:  0.12,
+		Monthly: 93,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestEstimateCostInvalid(t *testing.T) {
+	sizes := []InstanceSize{{Name: "g3.small", PriceHourly: 0.01}}
+
+	tests := []struct {
+		name   string
+		config interface{}
+	}{
+		{"unknown size", InstanceConfig{Hostname: "web", Size: "g3.huge"}},
+		{"unknown pool size", KubernetesClusterConfig{Name: "production", Pools: []KubernetesClusterPoolConfig{{Count: 1, Size: "g3.huge"}}}},
+		{"empty volume", VolumeConfig{Name: "data"}},
+		{"unsupported config", NetworkConfig{Label: "private"}},
+		{"nil instance", (*InstanceConfig)(nil)},
+		{"nil cluster", (*KubernetesClusterConfig)(nil)},
+		{"nil volume", (*VolumeConfig)(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := estimateCost(sizes, tt.config); !errors.Is(err, CostEstimateInvalidError) {
+				t.Errorf("Expected an CostEstimateInvalidError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// CredentialsProvider gives the API key of every request a client configured with WithCredentialsProvider
+// sends, so long-running processes can rotate keys without being restarted. It must be safe for concurrent use
+type CredentialsProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// CredentialsFunc adapts a function to a CredentialsProvider
+type CredentialsFunc func(ctx context.Context) (string, error)
+
+// APIKey calls f
+func (f CredentialsFunc) APIKey(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticCredentials is a CredentialsProvider always giving the same API key
+type StaticCredentials string
+
+// APIKey returns the key
+func (s StaticCredentials) APIKey(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// credentialsState holds the API key set with SetAPIKey and the credentials provider, shared by every copy of a Client
+type credentialsState struct {
+	mu       sync.RWMutex
+	apiKey   string
+	provider CredentialsProvider
+}
+
+// WithCredentialsProvider makes the client ask provider for the API key of each request instead of
+// using the one it was created with, which can then be empty
+func WithCredentialsProvider(provider CredentialsProvider) ClientOption {
+	return func(c *Client) {
+		c.credentials.mu.Lock()
+		c.credentials.provider = provider
+		c.credentials.mu.Unlock()
+	}
+}
+
+// SetAPIKey replaces the API key of the client, and of every copy of it, for the requests sent from now on.
+// It is safe to call while requests are in flight, unlike writing to the APIKey field, and it takes
+// precedence over a credentials provider
+func (c *Client) SetAPIKey(apiKey string) {
+	if c.credentials == nil {
+		c.APIKey = apiKey
+		return
+	}
+
+	c.credentials.mu.Lock()
+	c.credentials.apiKey = apiKey
+	c.credentials.provider = nil
+	c.credentials.mu.Unlock()
+}
+
+// apiKey returns the API key the next request must be sent with
+func (c *Client) apiKey(ctx context.Context) (string, error) {
+	if c.credentials == nil {
+		return c.APIKey, nil
+	}
+
+	c.credentials.mu.RLock()
+	apiKey, provider := c.credentials.apiKey, c.credentials.provider
+	c.credentials.mu.RUnlock()
+
+	if provider != nil {
+		key, err := provider.APIKey(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to get the API key: %w", err)
+		}
+		if key == "" {
+			err := errors.New("the credentials provider returned no API key")
+			return "", NoAPIKeySuppliedError.wrap(err)
+		}
+		return key, nil
+	}
+	if apiKey != "" {
+		return apiKey, nil
+	}
+
+	return c.APIKey, nil
+}
+
+// hasCredentials reports whether the client has an API key or a credentials provider
+func (c *Client) hasCredentials() bool {
+	if c.credentials == nil {
+		return c.APIKey != ""
+	}
+
+	c.credentials.mu.RLock()
+	defer c.credentials.mu.RUnlock()
+	return c.APIKey != "" || c.credentials.apiKey != "" || c.credentials.provider != nil
+}
+
+// knownAPIKey returns the API key set on the client, without asking a credentials provider
+func (c *Client) knownAPIKey() string {
+	if c.credentials == nil {
+		return c.APIKey
+	}
+
+	c.credentials.mu.RLock()
+	defer c.credentials.mu.RUnlock()
+	if c.credentials.apiKey != "" {
+		return c.credentials.apiKey
+	}
+	return c.APIKey
+}
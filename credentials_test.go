@@ -0,0 +1,82 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSetAPIKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var mu sync.Mutex
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.SetAPIKey("ROTATED-KEY")
+			_, _ = client.WithRegion("LON1").ListRegions()
+		}()
+	}
+	wg.Wait()
+
+	_, err := client.WithRegion("LON1").ListRegions()
+	g.Expect(err).To(BeNil())
+	g.Expect(auth[len(auth)-1]).To(Equal("bearer ROTATED-KEY"))
+}
+
+func TestCredentialsProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	key := "FIRST-KEY"
+	client, err := NewClientWithURL("", server.URL, "TEST", WithCredentialsProvider(CredentialsFunc(func(ctx context.Context) (string, error) {
+		return key, nil
+	})))
+	g.Expect(err).To(BeNil())
+
+	_, err = client.ListRegions()
+	g.Expect(err).To(BeNil())
+	g.Expect(auth).To(Equal("bearer FIRST-KEY"))
+
+	key = "SECOND-KEY"
+	_, err = client.ListRegions()
+	g.Expect(err).To(BeNil())
+	g.Expect(auth).To(Equal("bearer SECOND-KEY"))
+
+	client, _ = NewClientWithURL("", server.URL, "TEST", WithCredentialsProvider(CredentialsFunc(func(ctx context.Context) (string, error) {
+		return "", errors.New("vault unavailable")
+	})))
+	_, err = client.ListRegions()
+	g.Expect(err).To(MatchError(ContainSubstring("vault unavailable")))
+}
+
+func TestNoCredentials(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewClientWithURL("", "https://api.civo.com", "TEST")
+	g.Expect(errors.Is(err, NoAPIKeySuppliedError)).To(BeTrue())
+}
@@ -34,6 +34,17 @@ type Database struct {
 	Status           string             `json:"status"`
 }
 
+// Clone returns a deep copy of the database, sharing none of its slices and maps
+func (d *Database) Clone() *Database {
+	if d == nil {
+		return nil
+	}
+
+	c := *d
+	c.DatabaseUserInfo = cloneSlice(d.DatabaseUserInfo)
+	return &c
+}
+
 // PaginatedDatabases is the structure for list response from DB endpoint
 type PaginatedDatabases struct {
 	Page    int        `json:"page"`
@@ -42,6 +53,11 @@ type PaginatedDatabases struct {
 	Items   []Database `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedDatabases) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // CreateDatabaseRequest holds fields required to creates a new database
 type CreateDatabaseRequest struct {
 	Name            string `json:"name" validate:"required"`
@@ -77,11 +93,48 @@ type RestoreDatabaseRequest struct {
 	Backup string `json:"backup"`
 	// Region is the name of the region
 	Region string `json:"region"`
+	// PointInTime restores the database as it was at this RFC 3339 time instead of from Backup
+	PointInTime string `json:"point_in_time,omitempty"`
+}
+
+// Database engines supported by Civo
+const (
+	DatabaseEngineMySQL      = "MySQL"
+	DatabaseEnginePostgreSQL = "PostgreSQL"
+)
+
+// DatabaseStatusReady is the status of a database accepting connections
+const DatabaseStatusReady = "Ready"
+
+// DatabaseConfig describes a database to create with CreateDatabase
+type DatabaseConfig struct {
+	Name string
+	// Engine is one of the DatabaseEngine constants
+	Engine string
+	// Version of the engine, the default version is used when empty
+	Version string
+	// Size is the name of a database size, e.g. g3.db.small
+	Size string
+	// Replicas is the number of nodes besides the primary one
+	Replicas   int
+	NetworkID  string
+	FirewallID string
+	// FirewallRules opens these ports when a new firewall is created, e.g. "3306" or "all"
+	FirewallRules string
+	Region        string
 }
 
-// ListDatabases returns a list of all databases
-func (c *Client) ListDatabases() (*PaginatedDatabases, error) {
-	resp, err := c.SendGetRequest("/v2/databases")
+// DatabaseCredentials are what a client needs to connect to a database
+type DatabaseCredentials struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// ListDatabases returns a list of all databases, a page at a time, see ListOptions
+func (c *Client) ListDatabases(opts ...ListOptions) (*PaginatedDatabases, error) {
+	resp, err := c.sendListRequest("/v2/databases", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -94,6 +147,16 @@ func (c *Client) ListDatabases() (*PaginatedDatabases, error) {
 	return databases, nil
 }
 
+// DatabasesPaginator returns a Paginator over all databases, perPage at a time
+func (c *Client) DatabasesPaginator(perPage int) *Paginator[Database] {
+	return newPathPaginator[Database](c, "/v2/databases", perPage)
+}
+
+// ListAllDatabases returns every database, walking all the pages
+func (c *Client) ListAllDatabases() ([]Database, error) {
+	return c.DatabasesPaginator(defaultPerPage).All()
+}
+
 // GetDatabase finds a database by the database UUID
 func (c *Client) GetDatabase(id string) (*Database, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/databases/%s", id))
@@ -207,3 +270,98 @@ func (c *Client) RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleR
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// CreateDatabase creates a database after checking the engine and version against ListDBVersions
+func (c *Client) CreateDatabase(config *DatabaseConfig) (*Database, error) {
+	versions, err := c.ListDBVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := databaseRequest(config, versions)
+	if err != nil {
+		return nil, err
+	}
+	if request.Region == "" {
+		request.Region = c.Region
+	}
+
+	return c.NewDatabase(request)
+}
+
+// databaseRequest turns config into a CreateDatabaseRequest, picking the default version when none is given
+func databaseRequest(config *DatabaseConfig, versions map[string][]SupportedSoftwareVersion) (*CreateDatabaseRequest, error) {
+	if config.Name == "" || config.Size == "" {
+		err := fmt.Errorf("the database name and size are required")
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+	if config.Replicas < 0 {
+		err := fmt.Errorf("the number of replicas cannot be negative, got %d", config.Replicas)
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	var engine string
+	var supported []SupportedSoftwareVersion
+	for name, v := range versions {
+		if strings.EqualFold(name, config.Engine) {
+			engine, supported = name, v
+		}
+	}
+	if engine == "" {
+		err := fmt.Errorf("engine %q is not supported", config.Engine)
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	version := ""
+	for _, v := range supported {
+		if v.SoftwareVersion == config.Version || (config.Version == "" && v.Default) {
+			version = v.SoftwareVersion
+		}
+	}
+	if version == "" {
+		err := fmt.Errorf("version %q of %s is not supported", config.Version, engine)
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	return &CreateDatabaseRequest{
+		Name:            config.Name,
+		Size:            config.Size,
+		Software:        engine,
+		SoftwareVersion: version,
+		NetworkID:       config.NetworkID,
+		Nodes:           config.Replicas + 1,
+		FirewallID:      config.FirewallID,
+		FirewallRules:   config.FirewallRules,
+		Region:          config.Region,
+	}, nil
+}
+
+// GetDatabaseCredentials returns the host, port and credentials of a database, the host is its
+// DNS name when it has one and its public IP otherwise
+func (c *Client) GetDatabaseCredentials(id string) (*DatabaseCredentials, error) {
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.credentials()
+}
+
+// credentials returns the credentials of the database, or an error while it has none yet
+func (db *Database) credentials() (*DatabaseCredentials, error) {
+	credentials := &DatabaseCredentials{Host: db.DNSEntry, Port: db.Port, Username: db.Username, Password: db.Password}
+	if credentials.Host == "" {
+		credentials.Host = db.PublicIPv4
+	}
+	if credentials.Username == "" && len(db.DatabaseUserInfo) > 0 {
+		info := db.DatabaseUserInfo[0]
+		credentials.Username, credentials.Password, credentials.Port = info.Username, info.Password, info.Port
+	}
+
+	if credentials.Host == "" || credentials.Username == "" {
+		err := fmt.Errorf("the credentials of database %s are not available yet, it is %s", db.Name, db.Status)
+		return nil, DatabaseCredentialsUnavailableError.wrap(err)
+	}
+
+	return credentials, nil
+}
@@ -10,15 +10,15 @@ import (
 
 // DatabaseBackup represents a backup
 type DatabaseBackup struct {
-	ID           string    `json:"id,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	Software     string    `json:"software,omitempty"`
-	Status       string    `json:"status,omitempty"`
-	Schedule     string    `json:"schedule,omitempty"`
-	DatabaseName string    `json:"database_name,omitempty"`
-	DatabaseID   string    `json:"database_id,omitempty"`
-	IsScheduled  bool      `json:"is_scheduled,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Software     string `json:"software,omitempty"`
+	Status       string `json:"status,omitempty"`
+	Schedule     string `json:"schedule,omitempty"`
+	DatabaseName string `json:"database_name,omitempty"`
+	DatabaseID   string `json:"database_id,omitempty"`
+	IsScheduled  bool   `json:"is_scheduled,omitempty"`
+	CreatedAt    Time   `json:"created_at"`
 }
 
 // PaginatedDatabaseBackup is the structure for list response from DB endpoint
@@ -29,6 +29,11 @@ type PaginatedDatabaseBackup struct {
 	Items   []DatabaseBackup `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedDatabaseBackup) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // DatabaseBackupCreateRequest represents a backup create request
 type DatabaseBackupCreateRequest struct {
 	// Name is the name of the database backup to be created
@@ -66,6 +71,16 @@ func (c *Client) ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error
 	return back, nil
 }
 
+// DatabaseBackupsPaginator returns a Paginator over the backups of a database, perPage at a time
+func (c *Client) DatabaseBackupsPaginator(did string, perPage int) *Paginator[DatabaseBackup] {
+	return newPathPaginator[DatabaseBackup](c, fmt.Sprintf("/v2/databases/%s/backups", did), perPage)
+}
+
+// ListAllDatabaseBackups returns every backup of a database, walking all the pages
+func (c *Client) ListAllDatabaseBackups(did string) ([]DatabaseBackup, error) {
+	return c.DatabaseBackupsPaginator(did, defaultPerPage).All()
+}
+
 // UpdateDatabaseBackup update database backup
 func (c *Client) UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error) {
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/databases/%s/backups", did), v)
@@ -154,3 +169,41 @@ func (c *Client) FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error
 		return nil, ZeroMatchesError.wrap(err)
 	}
 }
+
+// UpdateDatabaseBackupSchedule sets the cron schedule of the automatic backups of a database,
+// keeping the name of the existing scheduled backup if there is one
+func (c *Client) UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error) {
+	if err := validateCronExpression(schedule); err != nil {
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	backups, err := c.ListAllDatabaseBackups(did)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "scheduled"
+	for _, backup := range backups {
+		if backup.IsScheduled {
+			name = backup.Name
+			break
+		}
+	}
+
+	return c.UpdateDatabaseBackup(did, &DatabaseBackupUpdateRequest{Name: name, Schedule: schedule, Region: c.Region})
+}
+
+// RestoreDatabaseToPointInTime restores a database to how it was at timestamp, which must be in the past.
+// The database is unavailable while it restores, see WaitForDatabaseReady
+func (c *Client) RestoreDatabaseToPointInTime(did string, timestamp time.Time) (*SimpleResponse, error) {
+	if timestamp.IsZero() || timestamp.After(time.Now()) {
+		err := fmt.Errorf("the restore time must be in the past, got %s", timestamp)
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	return c.RestoreDatabase(did, &RestoreDatabaseRequest{
+		Name:        "restore-" + timestamp.UTC().Format("20060102150405"),
+		PointInTime: timestamp.UTC().Format(time.RFC3339),
+		Region:      c.Region,
+	})
+}
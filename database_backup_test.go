@@ -0,0 +1,67 @@
+package civogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUpdateDatabaseBackupSchedule(t *testing.T) {
+	var sent DatabaseBackupUpdateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case "GET":
+			rw.Write([]byte(`{"page": 1, "pages": 1, "per_page": 20, "items": [{"id": "bk-1", "name": "nightly", "is_scheduled": true, "schedule": "0 1 * * *"}]}`))
+		case "PUT":
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Error(err)
+			}
+			rw.Write([]byte(`{"id": "bk-1", "name": "nightly", "is_scheduled": true, "schedule": "0 3 * * *"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	got, err := client.UpdateDatabaseBackupSchedule("db-1", "0 3 * * *")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Schedule != "0 3 * * *" || sent.Name != "nightly" || sent.Schedule != "0 3 * * *" {
+		t.Errorf("Unexpected backup %+v, sent %+v", got, sent)
+	}
+
+	if _, err := client.UpdateDatabaseBackupSchedule("db-1", "every night"); !errors.Is(err, DatabaseConfigInvalidError) {
+		t.Errorf("Expected DatabaseConfigInvalidError, got %v", err)
+	}
+}
+
+func TestRestoreDatabaseToPointInTime(t *testing.T) {
+	var sent RestoreDatabaseRequest
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		rw.Write([]byte(`{"result": "success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	timestamp := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	if _, err := client.RestoreDatabaseToPointInTime("db-1", timestamp); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if sent.PointInTime != "2024-03-01T12:30:00Z" || sent.Name != "restore-20240301123000" {
+		t.Errorf("Unexpected request %+v", sent)
+	}
+
+	if _, err := client.RestoreDatabaseToPointInTime("db-1", time.Now().Add(time.Hour)); !errors.Is(err, DatabaseConfigInvalidError) {
+		t.Errorf("Expected DatabaseConfigInvalidError, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -108,3 +109,65 @@ func TestDeleteDatabase(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestCreateDatabase(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"app-db","size":"g3.db.small","software":"PostgreSQL","software_version":"14","network_id":"","nodes":3,"firewall_id":"","firewall_rule":"5432","region":"TEST"}`,
+					URL:          "/v2/databases",
+					ResponseBody: `{"id": "db-1", "name": "app-db", "nodes": 3, "software": "PostgreSQL", "software_version": "14", "status": "Pending"}`,
+				},
+			},
+		},
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/databases/versions",
+					ResponseBody: `{"MySQL": [{"software_version": "8.0", "default": true}], "PostgreSQL": [{"software_version": "13"}, {"software_version": "14", "default": true}]}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateDatabase(&DatabaseConfig{Name: "app-db", Engine: "postgresql", Size: "g3.db.small", Replicas: 2, FirewallRules: "5432"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "db-1" || got.Nodes != 3 {
+		t.Errorf("Unexpected database %+v", got)
+	}
+
+	_, err = client.CreateDatabase(&DatabaseConfig{Name: "app-db", Engine: "PostgreSQL", Version: "9.6", Size: "g3.db.small"})
+	if !errors.Is(err, DatabaseConfigInvalidError) {
+		t.Errorf("Expected DatabaseConfigInvalidError, got %v", err)
+	}
+}
+
+func TestGetDatabaseCredentials(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/databases/db-1": `{"id": "db-1", "name": "app-db", "public_ipv4": "74.220.1.1", "dns_entry": "db-1.db.civo.com", "port": 5432, "username": "root", "password": "secret", "status": "Ready"}`,
+		"/v2/databases/db-2": `{"id": "db-2", "name": "new-db", "status": "Pending"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetDatabaseCredentials("db-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &DatabaseCredentials{Host: "db-1.db.civo.com", Port: 5432, Username: "root", Password: "secret"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.GetDatabaseCredentials("db-2"); !errors.Is(err, DatabaseCredentialsUnavailableError) {
+		t.Errorf("Expected DatabaseCredentialsUnavailableError, got %v", err)
+	}
+}
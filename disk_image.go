@@ -2,9 +2,16 @@ package civogo
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
 	"strings"
 
 	"golang.org/x/mod/semver"
@@ -19,11 +26,53 @@ type DiskImage struct {
 	Distribution string `json:"distribution,omitempty"`
 	Description  string `json:"description,omitempty"`
 	Label        string `json:"label,omitempty"`
+	OS           string `json:"os,omitempty"`
 }
 
+// Clone returns a copy of the disk image
+func (d *DiskImage) Clone() *DiskImage {
+	if d == nil {
+		return nil
+	}
+
+	c := *d
+	return &c
+}
+
+// Disk image states reported by the API
+const (
+	DiskImageStateUploading = "uploading"
+	DiskImageStateAvailable = "available"
+	DiskImageStateFailed    = "failed"
+)
+
+// DiskImageConfig describes a custom disk image to upload
+type DiskImageConfig struct {
+	Name           string `json:"name"`
+	Distribution   string `json:"distribution"`
+	Version        string `json:"version"`
+	OS             string `json:"os,omitempty"`
+	ImageSHA256    string `json:"image_sha256"`
+	ImageMD5       string `json:"image_md5"`
+	ImageSizeBytes int64  `json:"image_size_bytes"`
+	Region         string `json:"region"`
+}
+
+// DiskImageUpload is returned when a custom disk image is created, the image
+// itself has to be uploaded to UploadURL before it becomes available
+type DiskImageUpload struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	UploadURL string `json:"disk_image_url"`
+}
+
+// DiskImageUploadProgress is called while a disk image is uploaded with the bytes sent so far
+type DiskImageUploadProgress func(uploaded, total int64)
+
 // ListDiskImages return all disk image in system
 func (c *Client) ListDiskImages() ([]DiskImage, error) {
-	resp, err := c.SendGetRequest("/v2/disk_images")
+	resp, err := c.sendCachedGetRequest(CacheDiskImages, "/v2/disk_images")
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -99,9 +148,9 @@ func (c *Client) GetDiskImageByName(name string) (*DiskImage, error) {
 		return nil, decodeError(err)
 	}
 
-	for _, diskimage := range resp {
-		if diskimage.Name == name {
-			return &diskimage, nil
+	for i := range resp {
+		if resp[i].Name == name {
+			return resp[i].Clone(), nil
 		}
 	}
 
@@ -134,3 +183,115 @@ func (c *Client) GetMostRecentDistro(name string) (*DiskImage, error) {
 
 	return highestVersionDistro, nil
 }
+
+// NewDiskImage registers a custom disk image and returns where to upload it to
+func (c *Client) NewDiskImage(config *DiskImageConfig) (*DiskImageUpload, error) {
+	if config.Name == "" || config.Distribution == "" || config.Version == "" {
+		err := fmt.Errorf("the name, distribution and version of the disk image are required")
+		return nil, DiskImageInvalidError.wrap(err)
+	}
+	if config.ImageSizeBytes <= 0 {
+		err := fmt.Errorf("the size of the disk image must be greater than zero, got %d", config.ImageSizeBytes)
+		return nil, DiskImageInvalidError.wrap(err)
+	}
+	if config.Region == "" {
+		config.Region = c.Region
+	}
+
+	body, err := c.SendPostRequest("/v2/disk_images", config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	upload := &DiskImageUpload{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// UploadDiskImage streams size bytes of image to the upload URL of a custom disk image,
+// calling progress (if not nil) as the upload goes
+func (c *Client) UploadDiskImage(uploadURL string, image io.Reader, size int64, progress DiskImageUploadProgress) error {
+	body := &progressReader{reader: image, total: size, progress: progress}
+	req, err := http.NewRequestWithContext(c.requestContext(), "PUT", uploadURL, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return DiskImageUploadError.wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(resp.Body)
+		err := fmt.Errorf("the upload failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+		return DiskImageUploadError.wrap(err)
+	}
+
+	return nil
+}
+
+// CreateDiskImage uploads the image file at path as a custom disk image and waits until it is available.
+// The checksums and size of config are filled in from the file, progress (if not nil) follows the upload
+// and opts control the wait that follows it
+func (c *Client) CreateDiskImage(ctx context.Context, config *DiskImageConfig, path string, progress DiskImageUploadProgress, opts ...WaitOption) (*DiskImage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	config.ImageSHA256, config.ImageMD5, config.ImageSizeBytes, err = diskImageChecksums(file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	client := c.WithContext(ctx)
+	upload, err := client.NewDiskImage(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.UploadDiskImage(upload.UploadURL, file, config.ImageSizeBytes, progress); err != nil {
+		return nil, err
+	}
+
+	return c.WaitForDiskImageAvailable(ctx, upload.ID, opts...)
+}
+
+// diskImageChecksums reads r to the end, returning the hex encoded SHA256 and MD5 of its content and its size
+func diskImageChecksums(r io.Reader) (string, string, int64, error) {
+	sha, sum := sha256.New(), md5.New()
+	size, err := io.Copy(io.MultiWriter(sha, sum), r)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return hex.EncodeToString(sha.Sum(nil)), hex.EncodeToString(sum.Sum(nil)), size, nil
+}
+
+// progressReader reports how much of reader has been read to progress
+type progressReader struct {
+	reader   io.Reader
+	total    int64
+	read     int64
+	progress DiskImageUploadProgress
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	p.read += int64(n)
+	if p.progress != nil && n > 0 {
+		p.progress(p.read, p.total)
+	}
+	return n, err
+}
@@ -1,8 +1,20 @@
 package civogo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
 )
 
 func TestClienterDiskImage(t *testing.T) {
@@ -151,3 +163,76 @@ func TestGetMostRecentDistro(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "ubuntu-focal", got.Name)
 	}
 }
+
+func TestCreateDiskImage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "image.qcow2")
+	g.Expect(os.WriteFile(path, []byte("disk image content"), 0600)).To(Succeed())
+
+	var config DiskImageConfig
+	var uploaded string
+	polls := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "POST" && req.URL.Path == "/v2/disk_images":
+			json.NewDecoder(req.Body).Decode(&config)
+			rw.Write([]byte(`{"id": "12345", "name": "custom", "state": "uploading", "disk_image_url": "` + server.URL + `/upload/12345"}`))
+		case req.Method == "PUT" && req.URL.Path == "/upload/12345":
+			body, _ := io.ReadAll(req.Body)
+			uploaded = string(body)
+		case req.Method == "GET" && req.URL.Path == "/v2/disk_images/12345":
+			polls++
+			if polls < 2 {
+				rw.Write([]byte(`{"id": "12345", "name": "custom", "state": "uploading"}`))
+				return
+			}
+			rw.Write([]byte(`{"id": "12345", "name": "custom", "state": "available"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	var sent, total int64
+	image, err := client.CreateDiskImage(context.Background(), &DiskImageConfig{Name: "custom", Distribution: "ubuntu", Version: "22.04"}, path,
+		func(uploaded, size int64) { sent, total = uploaded, size },
+		WithWaitInterval(time.Millisecond),
+	)
+	g.Expect(err).To(BeNil())
+	g.Expect(image.State).To(Equal(DiskImageStateAvailable))
+	g.Expect(uploaded).To(Equal("disk image content"))
+	g.Expect(sent).To(Equal(int64(18)))
+	g.Expect(total).To(Equal(int64(18)))
+	g.Expect(config.ImageSizeBytes).To(Equal(int64(18)))
+	g.Expect(config.ImageMD5).To(HaveLen(32))
+	g.Expect(config.ImageSHA256).To(HaveLen(64))
+	g.Expect(config.Region).To(Equal("TEST"))
+}
+
+func TestUploadDiskImageFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		rw.Write([]byte("expired"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	err := client.UploadDiskImage(server.URL+"/upload", strings.NewReader("disk image"), 10, nil)
+	g.Expect(errors.Is(err, DiskImageUploadError)).To(BeTrue())
+}
+
+func TestNewDiskImageInvalid(t *testing.T) {
+	client, _ := NewClientWithURL("TEST-API-KEY", "http://localhost", "TEST")
+
+	_, err := client.NewDiskImage(&DiskImageConfig{Name: "custom", ImageSizeBytes: 10})
+	if !errors.Is(err, DiskImageInvalidError) {
+		t.Errorf("Expected DiskImageInvalidError, got %v", err)
+	}
+}
@@ -3,9 +3,12 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"strings"
-	"time"
 )
 
 // DNSDomain represents a domain registered within Civo's infrastructure
@@ -18,13 +21,26 @@ type DNSDomain struct {
 
 	// The Name of the domain
 	Name string `json:"name"`
+
+	// The TTL given to records created without one, 0 when the API default is used
+	DefaultTTL int `json:"default_ttl,omitempty"`
+}
+
+// Clone returns a copy of the domain
+func (d *DNSDomain) Clone() *DNSDomain {
+	if d == nil {
+		return nil
+	}
+
+	c := *d
+	return &c
 }
 
 type dnsDomainConfig struct {
 	Name string `json:"name"`
 }
 
-// DNSRecordType represents the allowed record types: a, cname, mx or txt
+// DNSRecordType represents the allowed record types: a, aaaa, alias, caa, cname, mx, ns, srv or txt
 type DNSRecordType string
 
 // DNSRecord represents a DNS record registered within Civo's infrastructure
@@ -36,9 +52,21 @@ type DNSRecord struct {
 	Value       string        `json:"value,omitempty"`
 	Type        DNSRecordType `json:"type,omitempty"`
 	Priority    int           `json:"priority,omitempty"`
+	Weight      int           `json:"weight,omitempty"`
+	Port        int           `json:"port,omitempty"`
 	TTL         int           `json:"ttl,omitempty"`
-	CreatedAt   time.Time     `json:"created_at,omitempty"`
-	UpdatedAt   time.Time     `json:"updated_at,omitempty"`
+	CreatedAt   Time          `json:"created_at,omitempty"`
+	UpdatedAt   Time          `json:"updated_at,omitempty"`
+}
+
+// Clone returns a copy of the record
+func (r *DNSRecord) Clone() *DNSRecord {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	return &c
 }
 
 // DNSRecordConfig describes the parameters for a new DNS record
@@ -50,18 +78,33 @@ type DNSRecordConfig struct {
 	Value    string        `json:"value"`
 	Priority int           `json:"priority"`
 	TTL      int           `json:"ttl"`
+	// Weight and Port are only used by SRV records
+	Weight int `json:"weight,omitempty"`
+	Port   int `json:"port,omitempty"`
 }
 
 const (
 	// DNSRecordTypeA represents an A record
 	DNSRecordTypeA = "A"
 
+	// DNSRecordTypeAAAA represents an AAAA record
+	DNSRecordTypeAAAA = "AAAA"
+
+	// DNSRecordTypeALIAS represents an ALIAS record
+	DNSRecordTypeALIAS = "ALIAS"
+
+	// DNSRecordTypeCAA represents a CAA record
+	DNSRecordTypeCAA = "CAA"
+
 	// DNSRecordTypeCName represents an CNAME record
 	DNSRecordTypeCName = "CNAME"
 
 	// DNSRecordTypeMX represents an MX record
 	DNSRecordTypeMX = "MX"
 
+	// DNSRecordTypeNS represents an NS record
+	DNSRecordTypeNS = "NS"
+
 	// DNSRecordTypeSRV represents an SRV record
 	DNSRecordTypeSRV = "SRV"
 
@@ -69,6 +112,19 @@ const (
 	DNSRecordTypeTXT = "TXT"
 )
 
+// dnsRecordTypes lists every record type the Civo DNS service accepts
+var dnsRecordTypes = []DNSRecordType{
+	DNSRecordTypeA,
+	DNSRecordTypeAAAA,
+	DNSRecordTypeALIAS,
+	DNSRecordTypeCAA,
+	DNSRecordTypeCName,
+	DNSRecordTypeMX,
+	DNSRecordTypeNS,
+	DNSRecordTypeSRV,
+	DNSRecordTypeTXT,
+}
+
 var (
 	// ErrDNSDomainNotFound is returned when the domain is not found
 	ErrDNSDomainNotFound = fmt.Errorf("domain not found")
@@ -77,6 +133,132 @@ var (
 	ErrDNSRecordNotFound = fmt.Errorf("record not found")
 )
 
+// Valid reports whether t is one of the record types supported by Civo, regardless of case
+func (t DNSRecordType) Valid() bool {
+	for _, v := range dnsRecordTypes {
+		if strings.EqualFold(string(t), string(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bounds for the TTL of a record, a TTL of 0 leaves it to the API default
+const (
+	DNSRecordMinTTL = 60
+	DNSRecordMaxTTL = 604800
+)
+
+// Validate checks the record before it is sent to the API, so mistakes get a descriptive
+// error instead of a generic 400. Fields left empty are not checked as the API fills them in,
+// the value is checked against the type: an IPv4 address for A, IPv6 for AAAA and a hostname
+// for CNAME, NS, ALIAS, MX and SRV. MX and SRV priorities are between 0 and 65535 and SRV records need a port.
+// The rejected fields are listed in the ValidationErrors it wraps
+func (r *DNSRecordConfig) Validate() error {
+	var errs ValidationErrors
+	if r.Type != "" && !r.Type.Valid() {
+		errs.add("type", r.Type, "unsupported record type %q", r.Type)
+	}
+
+	if r.Name != "" && !validDNSRecordName(r.Name) {
+		errs.add("name", r.Name, "%q is not a valid record name", r.Name)
+	}
+
+	if r.TTL != 0 {
+		errs.addErr("ttl", r.TTL, validateDNSTTL(r.TTL))
+	}
+
+	r.validateValue(&errs)
+
+	return errs.wrap(DNSRecordInvalidError)
+}
+
+// validateDNSTTL checks ttl is within DNSRecordMinTTL and DNSRecordMaxTTL
+func validateDNSTTL(ttl int) error {
+	if ttl < DNSRecordMinTTL || ttl > DNSRecordMaxTTL {
+		return fmt.Errorf("TTL must be between %d and %d seconds, got %d", DNSRecordMinTTL, DNSRecordMaxTTL, ttl)
+	}
+	return nil
+}
+
+// validateValue checks the value and the fields specific to the record type
+func (r *DNSRecordConfig) validateValue(errs *ValidationErrors) {
+	recordType := strings.ToUpper(string(r.Type))
+
+	switch recordType {
+	case DNSRecordTypeMX:
+		if r.Priority < 0 || r.Priority > 65535 {
+			errs.add("priority", r.Priority, "MX record needs a priority between 0 and 65535, got %d", r.Priority)
+		}
+	case DNSRecordTypeSRV:
+		if r.Priority < 0 || r.Priority > 65535 {
+			errs.add("priority", r.Priority, "SRV record needs a priority between 0 and 65535, got %d", r.Priority)
+		}
+		if r.Port < 1 || r.Port > 65535 {
+			errs.add("port", r.Port, "SRV record needs a port between 1 and 65535, got %d", r.Port)
+		}
+		if r.Weight < 0 || r.Weight > 65535 {
+			errs.add("weight", r.Weight, "SRV record needs a weight between 0 and 65535, got %d", r.Weight)
+		}
+	}
+
+	if r.Value == "" {
+		return
+	}
+
+	switch recordType {
+	case DNSRecordTypeA:
+		if ip := net.ParseIP(r.Value); ip == nil || ip.To4() == nil {
+			errs.add("value", r.Value, "A record needs an IPv4 address, got %q", r.Value)
+		}
+	case DNSRecordTypeAAAA:
+		if ip := net.ParseIP(r.Value); ip == nil || ip.To4() != nil {
+			errs.add("value", r.Value, "AAAA record needs an IPv6 address, got %q", r.Value)
+		}
+	case DNSRecordTypeCName, DNSRecordTypeNS, DNSRecordTypeALIAS, DNSRecordTypeMX, DNSRecordTypeSRV:
+		if !validDNSHostname(r.Value) {
+			errs.add("value", r.Value, "%s record needs a hostname, got %q", recordType, r.Value)
+		}
+	}
+}
+
+// validDNSRecordName reports whether name can be used as the name of a record:
+// "@" for the root of the domain, or labels that may start with a wildcard or an underscore
+func validDNSRecordName(name string) bool {
+	if name == "@" {
+		return true
+	}
+
+	name = strings.TrimPrefix(name, "*.")
+	if name == "*" {
+		return true
+	}
+
+	return validDNSHostname(name)
+}
+
+// validDNSHostname reports whether name is made of labels of letters, digits, hyphens
+// and underscores, up to 63 characters each and 253 in total, with an optional trailing dot
+func validDNSHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, ch := range label {
+			if !(ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-' || ch == '_') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // ListDNSDomains returns all Domains owned by the calling API account
 func (c *Client) ListDNSDomains() ([]DNSDomain, error) {
 	url := "/v2/dns"
@@ -95,38 +277,46 @@ func (c *Client) ListDNSDomains() ([]DNSDomain, error) {
 	return domains, nil
 }
 
-// FindDNSDomain finds a domain name by either part of the ID or part of the name
+// FindDNSDomain finds a domain by its ID or name. An exact name is looked up by the API, anything else
+// is matched against the whole list like FindInstance does
 func (c *Client) FindDNSDomain(search string) (*DNSDomain, error) {
-	domains, err := c.ListDNSDomains()
+	domains, filtered, err := c.lookupDNSDomains(search)
 	if err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := DNSDomain{}
-
-	for _, value := range domains {
-		if value.Name == search || value.ID == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
-			}
+	if filtered {
+		if len(domains) == 1 {
+			return &domains[0], nil
+		}
+		if domains, err = c.ListDNSDomains(); err != nil {
+			return nil, err
 		}
 	}
 
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
+	return findMatch(domains, search, false, func(d DNSDomain) (string, []string) { return d.ID, []string{d.Name} })
+}
+
+// lookupDNSDomains asks the API for the domains called name, reporting whether it filtered them.
+// The API versions without the filter return every domain instead
+func (c *Client) lookupDNSDomains(name string) ([]DNSDomain, bool, error) {
+	resp, err := c.SendGetRequest("/v2/dns?name=" + url.QueryEscape(name))
+	if err != nil {
+		return nil, false, decodeError(err)
 	}
+
+	var domains = make([]DNSDomain, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&domains); err != nil {
+		return nil, false, err
+	}
+
+	for _, d := range domains {
+		if d.Name != name {
+			return domains, false, nil
+		}
+	}
+
+	return domains, true, nil
 }
 
 // CreateDNSDomain registers a new Domain
@@ -146,16 +336,17 @@ func (c *Client) CreateDNSDomain(name string) (*DNSDomain, error) {
 	return n, nil
 }
 
-// GetDNSDomain returns the DNS Domain that matches the name
+// GetDNSDomain returns the DNS Domain that matches the name, which the API looks up rather
+// than returning every domain of the account
 func (c *Client) GetDNSDomain(name string) (*DNSDomain, error) {
-	ds, err := c.ListDNSDomains()
+	ds, _, err := c.lookupDNSDomains(name)
 	if err != nil {
-		return nil, decodeError(err)
+		return nil, err
 	}
 
-	for _, d := range ds {
-		if d.Name == name {
-			return &d, nil
+	for i := range ds {
+		if ds[i].Name == name {
+			return ds[i].Clone(), nil
 		}
 	}
 
@@ -179,6 +370,27 @@ func (c *Client) UpdateDNSDomain(d *DNSDomain, name string) (*DNSDomain, error)
 	return r, nil
 }
 
+// SetDomainDefaultTTL sets the TTL given to records of the domain created without one,
+// existing records keep their TTL, see UpdateAllRecordsTTL
+func (c *Client) SetDomainDefaultTTL(domainID string, ttl int) (*DNSDomain, error) {
+	if err := validateDNSTTL(ttl); err != nil {
+		return nil, DNSRecordInvalidError.wrap(err)
+	}
+
+	url := fmt.Sprintf("/v2/dns/%s", domainID)
+	body, err := c.SendPutRequest(url, map[string]int{"default_ttl": ttl})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var r = &DNSDomain{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(r); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
 // DeleteDNSDomain deletes the Domain that matches the name
 func (c *Client) DeleteDNSDomain(d *DNSDomain) (*SimpleResponse, error) {
 	url := fmt.Sprintf("/v2/dns/%s", d.ID)
@@ -196,6 +408,10 @@ func (c *Client) CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecor
 		return nil, fmt.Errorf("r.DomainID is empty")
 	}
 
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("/v2/dns/%s/records", domainID)
 	body, err := c.SendPostRequest(url, r)
 	if err != nil {
@@ -229,22 +445,114 @@ func (c *Client) ListDNSRecords(dnsDomainID string) ([]DNSRecord, error) {
 
 // GetDNSRecord returns the Record that matches the domain ID and domain record ID
 func (c *Client) GetDNSRecord(domainID, domainRecordID string) (*DNSRecord, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/dns/%s/records/%s", domainID, domainRecordID))
+	switch {
+	case err == nil:
+		record := &DNSRecord{}
+		if json.Unmarshal(resp, record) == nil && record.ID == domainRecordID {
+			return record, nil
+		}
+	case errors.Is(decodeError(err), DatabaseDNSRecordNotFoundError):
+		return nil, ErrDNSRecordNotFound
+	case !isEndpointMissing(err):
+		return nil, decodeError(err)
+	}
+
+	// the API versions without an endpoint for a single record only list them
 	rs, err := c.ListDNSRecords(domainID)
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	for _, r := range rs {
-		if r.ID == domainRecordID {
-			return &r, nil
+	for i := range rs {
+		if rs[i].ID == domainRecordID {
+			return rs[i].Clone(), nil
 		}
 	}
 
 	return nil, ErrDNSRecordNotFound
 }
 
+// isEndpointMissing reports whether err is the API saying it has no such endpoint
+func isEndpointMissing(err error) bool {
+	httpErr, ok := err.(HTTPError)
+	return ok && (httpErr.Code == http.StatusNotFound || httpErr.Code == http.StatusMethodNotAllowed)
+}
+
+// ListDNSRecordsByType returns the records of domainID that have the type t, ignoring case
+func (c *Client) ListDNSRecordsByType(domainID string, t DNSRecordType) ([]DNSRecord, error) {
+	rs, err := c.ListDNSRecords(domainID)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return filterDNSRecordsByType(rs, t), nil
+}
+
+// FindDNSRecordByTypeAndName returns the record of domainID with the type t and the given name,
+// so an A and a TXT record sharing a name can be told apart. Names are compared ignoring case
+// and "@" matches the root of the domain
+func (c *Client) FindDNSRecordByTypeAndName(domainID string, t DNSRecordType, name string) (*DNSRecord, error) {
+	rs, err := c.ListDNSRecords(domainID)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return findDNSRecordByName(filterDNSRecordsByType(rs, t), t, name)
+}
+
+// filterDNSRecordsByType returns the records in rs that have the type t
+func filterDNSRecordsByType(rs []DNSRecord, t DNSRecordType) []DNSRecord {
+	result := make([]DNSRecord, 0)
+	for _, r := range rs {
+		if strings.EqualFold(string(r.Type), string(t)) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// findDNSRecordByName returns the only record in rs with the given name
+func findDNSRecordByName(rs []DNSRecord, t DNSRecordType, name string) (*DNSRecord, error) {
+	matches := filterDNSRecordsByName(rs, name)
+
+	switch len(matches) {
+	case 0:
+		return nil, ErrDNSRecordNotFound
+	case 1:
+		return &matches[0], nil
+	default:
+		err := fmt.Errorf("unable to find %s record %s because there were multiple matches", t, name)
+		return nil, MultipleMatchesError.wrap(err)
+	}
+}
+
+// filterDNSRecordsByName returns the records in rs with the given name, ignoring case
+// and with "@" matching the root of the domain
+func filterDNSRecordsByName(rs []DNSRecord, name string) []DNSRecord {
+	matches := []DNSRecord{}
+	for _, r := range rs {
+		if normaliseDNSName(r.Name) == normaliseDNSName(name) {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+// normaliseDNSName returns name lower cased without its trailing dot, or "@" when empty
+func normaliseDNSName(name string) string {
+	if name == "" {
+		return "@"
+	}
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
 // UpdateDNSRecord updates the DNS record
 func (c *Client) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error) {
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("/v2/dns/%s/records/%s", r.DNSDomainID, r.ID)
 	body, err := c.SendPutRequest(url, rc)
 	if err != nil {
@@ -279,3 +587,104 @@ func (c *Client) DeleteDNSRecord(r *DNSRecord) (*SimpleResponse, error) {
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// dnsBulkConcurrency is how many requests the bulk record operations keep in flight
+const dnsBulkConcurrency = 5
+
+// DNSRecordResult is the outcome for a single record of a bulk DNS operation,
+// results are returned in the same order as the input
+type DNSRecordResult struct {
+	// ID is the ID of the record, for creates it is only set on success
+	ID string
+	// Record is the created record, it is nil for deletes and failures
+	Record *DNSRecord
+	// Error is the reason this record failed, nil on success
+	Error error
+}
+
+// CreateDNSRecords creates several DNS records in the domain, a few at a time.
+// Every record is attempted even if some fail; the returned error reports how many failed
+// and each DNSRecordResult says which
+func (c *Client) CreateDNSRecords(domainID string, records []DNSRecordConfig) ([]DNSRecordResult, error) {
+	return c.bulkDNSRecords(len(records), func(client *Client, i int) DNSRecordResult {
+		record, err := client.CreateDNSRecord(domainID, &records[i])
+		if err != nil {
+			return DNSRecordResult{Error: err}
+		}
+		return DNSRecordResult{ID: record.ID, Record: record}
+	})
+}
+
+// DeleteDNSRecords deletes several DNS records from the domain, a few at a time.
+// Every record is attempted even if some fail; the returned error reports how many failed
+// and each DNSRecordResult says which
+func (c *Client) DeleteDNSRecords(domainID string, ids []string) ([]DNSRecordResult, error) {
+	return c.bulkDNSRecords(len(ids), func(client *Client, i int) DNSRecordResult {
+		_, err := client.DeleteDNSRecord(&DNSRecord{ID: ids[i], DNSDomainID: domainID})
+		return DNSRecordResult{ID: ids[i], Error: err}
+	})
+}
+
+// UpdateAllRecordsTTL sets the TTL of every record of the domain, a few at a time, e.g. to lower it
+// before a migration. Records already at ttl are left alone and not part of the results.
+// Every record is attempted even if some fail; the returned error reports how many failed
+// and each DNSRecordResult says which
+func (c *Client) UpdateAllRecordsTTL(domainID string, ttl int) ([]DNSRecordResult, error) {
+	if err := validateDNSTTL(ttl); err != nil {
+		return nil, DNSRecordInvalidError.wrap(err)
+	}
+
+	records, err := c.ListDNSRecords(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := []DNSRecord{}
+	for _, record := range records {
+		if record.TTL != ttl {
+			stale = append(stale, record)
+		}
+	}
+
+	return c.bulkDNSRecords(len(stale), func(client *Client, i int) DNSRecordResult {
+		record, err := client.UpdateDNSRecord(&stale[i], stale[i].configWithTTL(ttl))
+		if err != nil {
+			return DNSRecordResult{ID: stale[i].ID, Error: err}
+		}
+		return DNSRecordResult{ID: record.ID, Record: record}
+	})
+}
+
+// configWithTTL returns the config recreating the record with ttl
+func (r *DNSRecord) configWithTTL(ttl int) *DNSRecordConfig {
+	return &DNSRecordConfig{
+		Type:     r.Type,
+		Name:     r.Name,
+		Value:    r.Value,
+		Priority: r.Priority,
+		TTL:      ttl,
+		Weight:   r.Weight,
+		Port:     r.Port,
+	}
+}
+
+// bulkDNSRecords runs op for each of the n items with at most dnsBulkConcurrency in flight
+func (c *Client) bulkDNSRecords(n int, op func(client *Client, i int) DNSRecordResult) ([]DNSRecordResult, error) {
+	results := make([]DNSRecordResult, n)
+	c.forEachConcurrently(n, dnsBulkConcurrency, func(client *Client, i int) {
+		results[i] = op(client, i)
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d records failed", failed, n)
+		return results, DNSBulkOperationFailedError.wrap(err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,93 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DNSRecordSyncResult reports what SetDNSRecordValues changed
+type DNSRecordSyncResult struct {
+	Created   []DNSRecord
+	Deleted   []DNSRecord
+	Unchanged []DNSRecord
+}
+
+// SetDNSRecordValues makes the records of type t named name in the domain have exactly values,
+// e.g. the IPs of the servers behind a round-robin A record. Missing values are created first,
+// with the TTL of the records already there, and only then are the other records deleted so the
+// name keeps resolving throughout. An empty values deletes every record of the name. On error the
+// result lists the changes made so far
+func (c *Client) SetDNSRecordValues(domainID, name string, t DNSRecordType, values []string) (*DNSRecordSyncResult, error) {
+	return setDNSRecordValues(c, domainID, name, t, values)
+}
+
+// setDNSRecordValues implements SetDNSRecordValues on top of the other DNS methods of c
+func setDNSRecordValues(c DNSClienter, domainID, name string, t DNSRecordType, values []string) (*DNSRecordSyncResult, error) {
+	existing, err := c.ListDNSRecordsByType(domainID, t)
+	if err != nil {
+		return nil, err
+	}
+	existing = filterDNSRecordsByName(existing, name)
+
+	ttl := 0
+	if len(existing) > 0 {
+		ttl = existing[0].TTL
+	}
+
+	result := &DNSRecordSyncResult{}
+	wanted := map[string]bool{}
+	toCreate := []DNSRecordConfig{}
+	for _, value := range values {
+		key := normaliseDNSValue(value)
+		if wanted[key] {
+			continue
+		}
+		wanted[key] = true
+
+		found := false
+		for _, r := range existing {
+			if normaliseDNSValue(r.Value) == key {
+				found = true
+				result.Unchanged = append(result.Unchanged, r)
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		config := DNSRecordConfig{Type: t, Name: name, Value: value, TTL: ttl}
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("value %q: %w", value, err)
+		}
+		toCreate = append(toCreate, config)
+	}
+
+	for i := range toCreate {
+		record, err := c.CreateDNSRecord(domainID, &toCreate[i])
+		if err != nil {
+			return result, err
+		}
+		result.Created = append(result.Created, *record)
+	}
+
+	kept := map[string]bool{}
+	for _, r := range existing {
+		key := normaliseDNSValue(r.Value)
+		if wanted[key] && !kept[key] {
+			kept[key] = true
+			continue
+		}
+		if _, err := c.DeleteDNSRecord(&r); err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, r)
+	}
+
+	return result, nil
+}
+
+// normaliseDNSValue returns value in a comparable form, so "Host.example.com." and "host.example.com" match
+func normaliseDNSValue(value string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(value), "."))
+}
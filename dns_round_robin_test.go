@@ -0,0 +1,104 @@
+package civogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestSetDNSRecordValues(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/v2/dns/12346/records":
+			rw.Write([]byte(`[
+				{"id": "1", "domain_id": "12346", "name": "www", "value": "10.0.0.1", "type": "A", "ttl": 300},
+				{"id": "2", "domain_id": "12346", "name": "www", "value": "10.0.0.2", "type": "A", "ttl": 300},
+				{"id": "3", "domain_id": "12346", "name": "WWW", "value": "10.0.0.1", "type": "A", "ttl": 300},
+				{"id": "4", "domain_id": "12346", "name": "mail", "value": "10.0.0.2", "type": "A", "ttl": 300},
+				{"id": "5", "domain_id": "12346", "name": "www", "value": "v=spf1 -all", "type": "TXT", "ttl": 300}
+			]`))
+		case req.Method == "POST" && req.URL.Path == "/v2/dns/12346/records":
+			var config DNSRecordConfig
+			if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+				t.Error(err)
+			}
+			if config.TTL != 300 || config.Name != "www" || config.Type != DNSRecordTypeA {
+				t.Errorf("Expected an A record for www with a TTL of 300, got %+v", config)
+			}
+			rw.Write([]byte(`{"id": "6", "domain_id": "12346", "name": "www", "value": "` + config.Value + `", "type": "A", "ttl": 300}`))
+		case req.Method == "DELETE":
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.SetDNSRecordValues("12346", "www", DNSRecordTypeA, []string{"10.0.0.1", "10.0.0.3", "10.0.0.3"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	ids := func(rs []DNSRecord) []string {
+		result := []string{}
+		for _, r := range rs {
+			result = append(result, r.ID)
+		}
+		return result
+	}
+	if !reflect.DeepEqual(ids(got.Unchanged), []string{"1"}) {
+		t.Errorf("Expected record 1 to be unchanged, got %+v", got.Unchanged)
+	}
+	if len(got.Created) != 1 || got.Created[0].Value != "10.0.0.3" {
+		t.Errorf("Expected a record for 10.0.0.3 to be created, got %+v", got.Created)
+	}
+	if !reflect.DeepEqual(ids(got.Deleted), []string{"2", "3"}) {
+		t.Errorf("Expected records 2 and 3 to be deleted, got %+v", got.Deleted)
+	}
+
+	expected := []string{
+		"GET /v2/dns/12346/records",
+		"POST /v2/dns/12346/records",
+		"DELETE /v2/dns/12346/records/2",
+		"DELETE /v2/dns/12346/records/3",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestSetDNSRecordValuesInvalid(t *testing.T) {
+	client, err := NewFakeClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	domain, _ := client.CreateDNSDomain("example.com")
+	if _, err := client.SetDNSRecordValues(domain.ID, "www", DNSRecordTypeA, []string{"10.0.0.1", "not-an-ip"}); !errors.Is(err, DNSRecordInvalidError) {
+		t.Errorf("Expected an DNSRecordInvalidError, got %v", err)
+	}
+	if len(client.DomainRecords) != 0 {
+		t.Errorf("Expected no record to be created, got %+v", client.DomainRecords)
+	}
+
+	got, err := client.SetDNSRecordValues(domain.ID, "www", DNSRecordTypeA, []string{"10.0.0.1", "10.0.0.2"})
+	if err != nil || len(got.Created) != 2 {
+		t.Errorf("Expected two records to be created, got %+v and %v", got, err)
+	}
+
+	got, err = client.SetDNSRecordValues(domain.ID, "www", DNSRecordTypeA, nil)
+	if err != nil || len(got.Deleted) != 2 || len(client.DomainRecords) != 0 {
+		t.Errorf("Expected every record to be deleted, got %+v and %v", got, err)
+	}
+}
@@ -1,7 +1,13 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -99,6 +105,40 @@ func TestGetDNSDomain(t *testing.T) {
 	}
 }
 
+func TestGetDNSDomainLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/dns" || r.URL.Query().Get("name") == "" {
+			t.Errorf("Expected a lookup by name, got %s", r.URL.String())
+		}
+		if r.URL.Query().Get("name") == "example.net" {
+			fmt.Fprint(w, `[{"id": "12346", "account_id": "1", "name": "example.net"}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.GetDNSDomain("example.net")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "12346" {
+		t.Errorf("Expected %s, got %s", "12346", got.ID)
+	}
+
+	got, err = client.FindDNSDomain("example.net")
+	if err != nil || got.ID != "12346" {
+		t.Errorf("Expected %s, got %+v (%v)", "12346", got, err)
+	}
+
+	_, err = client.GetDNSDomain("example.io")
+	if err != ErrDNSDomainNotFound {
+		t.Errorf("Expected %+v, got %+v", ErrDNSDomainNotFound, err)
+	}
+}
+
 func TestUpdateDomain(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/dns/12345": `{"id": "12345", "account_id": "1", "name": "example.com"}`,
@@ -278,8 +318,8 @@ func TestUpdateDNSRecord(t *testing.T) {
 		Type:        "MX",
 		Priority:    10,
 		TTL:         600,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updateAt,
+		CreatedAt:   NewTime(createdAt),
+		UpdatedAt:   NewTime(updateAt),
 	}
 	if !reflect.DeepEqual(got, expected) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
@@ -310,3 +350,297 @@ func TestGetRecord(t *testing.T) {
 		return
 	}
 }
+
+func TestGetRecordDirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/dns/1111/records/12346":
+			fmt.Fprint(w, `{"id": "12346", "account_id": "1", "domain_id":"1111", "name": "mail", "type": "MX", "value": "10.0.0.1", "ttl": 600, "priority": 10}`)
+		case "/v2/dns/1111/records/hello":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code": "database_dns_record_not_found", "reason": "record not found"}`)
+		default:
+			t.Errorf("Expected no request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.GetDNSRecord("1111", "12346")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Name != "mail" {
+		t.Errorf("Expected %s, got %s", "mail", got.Name)
+	}
+
+	_, err = client.GetDNSRecord("1111", "hello")
+	if err != ErrDNSRecordNotFound {
+		t.Errorf("Expected %+v, got %+v", ErrDNSRecordNotFound, err)
+	}
+}
+
+func TestNewSRVRecord(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns/12346/records": `{
+			"id": "76cc107f-fbef-4e2b-b97f-f5d34f4075d3",
+			"domain_id": "12346",
+			"name": "_sip._tcp",
+			"value": "sip.example.com",
+			"type": "SRV",
+			"priority": 10,
+			"weight": 5,
+			"port": 5060,
+			"ttl": 600
+		}`,
+	})
+	defer server.Close()
+
+	cfg := &DNSRecordConfig{Name: "_sip._tcp", Type: DNSRecordTypeSRV, Value: "sip.example.com", Priority: 10, Weight: 5, Port: 5060}
+	got, err := client.CreateDNSRecord("12346", cfg)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if got.Weight != 5 {
+		t.Errorf("Expected %d, got %d", 5, got.Weight)
+	}
+
+	if got.Port != 5060 {
+		t.Errorf("Expected %d, got %d", 5060, got.Port)
+	}
+}
+
+func TestNewRecordInvalidType(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns/12346/records": `{}`,
+	})
+	defer server.Close()
+
+	cases := []*DNSRecordConfig{
+		{Name: "www", Type: "BOGUS", Value: "10.0.0.1"},
+		{Name: "_sip._tcp", Type: DNSRecordTypeSRV, Value: "sip.example.com", Priority: 10},
+	}
+
+	for _, cfg := range cases {
+		_, err := client.CreateDNSRecord("12346", cfg)
+		if !errors.Is(err, DNSRecordInvalidError) {
+			t.Errorf("Expected DNSRecordInvalidError for %s record, got %v", cfg.Type, err)
+		}
+	}
+
+	for _, recordType := range []DNSRecordType{DNSRecordTypeAAAA, DNSRecordTypeNS, DNSRecordTypeCAA, DNSRecordTypeALIAS, "txt"} {
+		if !recordType.Valid() {
+			t.Errorf("Expected %s to be a valid record type", recordType)
+		}
+	}
+}
+
+func TestCreateDNSRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		cfg := DNSRecordConfig{}
+		json.NewDecoder(req.Body).Decode(&cfg)
+		if cfg.Name == "broken" {
+			rw.WriteHeader(http.StatusBadRequest)
+			rw.Write([]byte(`{"code": "database_dns_record_create", "reason": "cannot create record"}`))
+			return
+		}
+		fmt.Fprintf(rw, `{"id": "id-%s", "domain_id": "12346", "name": "%s", "type": "A"}`, cfg.Name, cfg.Name)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	records := []DNSRecordConfig{
+		{Name: "www", Type: DNSRecordTypeA, Value: "10.0.0.1"},
+		{Name: "broken", Type: DNSRecordTypeA, Value: "10.0.0.2"},
+		{Name: "api", Type: DNSRecordTypeA, Value: "10.0.0.3"},
+	}
+
+	results, err := client.CreateDNSRecords("12346", records)
+	if !errors.Is(err, DNSBulkOperationFailedError) {
+		t.Errorf("Expected DNSBulkOperationFailedError, got %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	if results[0].ID != "id-www" || results[2].ID != "id-api" {
+		t.Errorf("Expected results in input order, got %s and %s", results[0].ID, results[2].ID)
+	}
+
+	if !errors.Is(results[1].Error, DatabaseDNSRecordCreateError) {
+		t.Errorf("Expected DatabaseDNSRecordCreateError, got %v", results[1].Error)
+	}
+}
+
+func TestDeleteDNSRecords(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns/12346/records/1": `{"result": "success"}`,
+		"/v2/dns/12346/records/2": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	results, err := client.DeleteDNSRecords("12346", []string{"1", "2"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	for i, id := range []string{"1", "2"} {
+		if results[i].ID != id || results[i].Error != nil {
+			t.Errorf("Expected %s to be deleted, got %+v", id, results[i])
+		}
+	}
+}
+
+func TestFindDNSRecordByTypeAndName(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns/12346/records": `[
+			{"id": "1", "domain_id": "12346", "name": "www", "value": "10.0.0.1", "type": "A"},
+			{"id": "2", "domain_id": "12346", "name": "www", "value": "v=spf1 -all", "type": "TXT"},
+			{"id": "3", "domain_id": "12346", "name": "api", "value": "10.0.0.2", "type": "A"},
+			{"id": "4", "domain_id": "12346", "name": "api", "value": "10.0.0.3", "type": "A"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.FindDNSRecordByTypeAndName("12346", DNSRecordTypeTXT, "WWW")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "2" {
+		t.Errorf("Expected %s, got %s", "2", got.ID)
+	}
+
+	if _, err := client.FindDNSRecordByTypeAndName("12346", DNSRecordTypeA, "api"); !errors.Is(err, MultipleMatchesError) {
+		t.Errorf("Expected MultipleMatchesError, got %v", err)
+	}
+
+	if _, err := client.FindDNSRecordByTypeAndName("12346", DNSRecordTypeMX, "www"); err != ErrDNSRecordNotFound {
+		t.Errorf("Expected ErrDNSRecordNotFound, got %v", err)
+	}
+
+	records, err := client.ListDNSRecordsByType("12346", "a")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(records) != 3 {
+		t.Errorf("Expected %d, got %d", 3, len(records))
+	}
+}
+
+func TestDNSRecordConfigValidate(t *testing.T) {
+	valid := []DNSRecordConfig{
+		{Name: "www", Type: DNSRecordTypeA, Value: "10.0.0.1", TTL: 600},
+		{Name: "@", Type: DNSRecordTypeAAAA, Value: "2001:db8::1"},
+		{Name: "*.apps", Type: DNSRecordTypeCName, Value: "lb.example.com."},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com", Priority: 10},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com"},
+		{Name: "_acme-challenge", Type: DNSRecordTypeTXT, Value: "some token"},
+		{Name: "email"},
+	}
+
+	for _, cfg := range valid {
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got %s", cfg, err)
+		}
+	}
+
+	invalid := []DNSRecordConfig{
+		{Name: "www", Type: DNSRecordTypeA, Value: "2001:db8::1"},
+		{Name: "www", Type: DNSRecordTypeAAAA, Value: "10.0.0.1"},
+		{Name: "www", Type: DNSRecordTypeCName, Value: "not a host"},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com", Priority: -1},
+		{Name: "bad name", Type: DNSRecordTypeA, Value: "10.0.0.1"},
+		{Name: "www", Type: DNSRecordTypeA, Value: "10.0.0.1", TTL: 10},
+	}
+
+	for _, cfg := range invalid {
+		if err := cfg.Validate(); !errors.Is(err, DNSRecordInvalidError) {
+			t.Errorf("Expected DNSRecordInvalidError for %+v, got %v", cfg, err)
+		}
+	}
+}
+
+func TestSetDomainDefaultTTL(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"default_ttl":300}`,
+					URL:          "/v2/dns/12345",
+					ResponseBody: `{"id": "12345", "account_id": "1", "name": "example.com", "default_ttl": 300}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetDomainDefaultTTL("12345", 300)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &DNSDomain{ID: "12345", AccountID: "1", Name: "example.com", DefaultTTL: 300}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.SetDomainDefaultTTL("12345", 5); !errors.Is(err, DNSRecordInvalidError) {
+		t.Errorf("Expected an DNSRecordInvalidError for a TTL of 5, got %v", err)
+	}
+}
+
+func TestUpdateAllRecordsTTL(t *testing.T) {
+	var mu sync.Mutex
+	updated := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/v2/dns/12346/records":
+			rw.Write([]byte(`[
+				{"id": "1", "domain_id": "12346", "name": "www", "value": "10.0.0.1", "type": "A", "ttl": 3600},
+				{"id": "2", "domain_id": "12346", "name": "mail", "value": "10.0.0.2", "type": "A", "ttl": 60},
+				{"id": "3", "domain_id": "12346", "name": "api", "value": "10.0.0.3", "type": "A", "ttl": 3600}
+			]`))
+		case req.Method == "PUT" && req.URL.Path == "/v2/dns/12346/records/3":
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"code": "database_dns_record_save_failed", "reason": "failed"}`))
+		case req.Method == "PUT":
+			var config DNSRecordConfig
+			if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			updated[req.URL.Path] = config.TTL
+			mu.Unlock()
+			fmt.Fprintf(rw, `{"id": "1", "domain_id": "12346", "name": %q, "value": %q, "type": %q, "ttl": %d}`, config.Name, config.Value, config.Type, config.TTL)
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.UpdateAllRecordsTTL("12346", 60)
+	if !errors.Is(err, DNSBulkOperationFailedError) {
+		t.Errorf("Expected an DNSBulkOperationFailedError, got %v", err)
+	}
+
+	if len(results) != 2 || results[0].Record == nil || results[0].Record.TTL != 60 || results[1].ID != "3" || results[1].Error == nil {
+		t.Errorf("Expected record 1 to be updated and record 3 to fail, got %+v", results)
+	}
+	if !reflect.DeepEqual(updated, map[string]int{"/v2/dns/12346/records/1": 60}) {
+		t.Errorf("Expected only record 1 to be updated, got %v", updated)
+	}
+}
@@ -0,0 +1,380 @@
+package civogo
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExportDNSZone returns the records of a domain as a BIND zone file
+func (c *Client) ExportDNSZone(domainID string) (string, error) {
+	domain, err := c.getDNSDomainByID(domainID)
+	if err != nil {
+		return "", err
+	}
+
+	records, err := c.ListDNSRecords(domainID)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatDNSZone(domain.Name, records), nil
+}
+
+// ImportDNSZone reads a BIND zone file and creates its records in the domain.
+// SOA records are skipped as Civo manages them, the rest are created with CreateDNSRecords
+// so a failure part way through is reported per record
+func (c *Client) ImportDNSZone(domainID string, zonefile io.Reader) ([]DNSRecordResult, error) {
+	domain, err := c.getDNSDomainByID(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := ParseDNSZone(domain.Name, zonefile)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateDNSRecords(domainID, records)
+}
+
+// getDNSDomainByID returns the domain with the given ID
+func (c *Client) getDNSDomainByID(domainID string) (*DNSDomain, error) {
+	domains, err := c.ListDNSDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range domains {
+		if domains[i].ID == domainID {
+			return domains[i].Clone(), nil
+		}
+	}
+
+	return nil, ErrDNSDomainNotFound
+}
+
+// FormatDNSZone renders records of the domain origin in BIND zone file syntax.
+// Records are sorted by name then type so the output is stable between calls
+func FormatDNSZone(origin string, records []DNSRecord) string {
+	origin = strings.TrimSuffix(origin, ".")
+	sorted := make([]DNSRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", origin)
+	for _, r := range sorted {
+		name := r.Name
+		if name == "" {
+			name = "@"
+		}
+
+		ttl := ""
+		if r.TTL > 0 {
+			ttl = strconv.Itoa(r.TTL)
+		}
+
+		recordType := strings.ToUpper(string(r.Type))
+		fmt.Fprintf(&b, "%s\t%s\tIN\t%s\t%s\n", name, ttl, recordType, zoneRData(recordType, r))
+	}
+
+	return b.String()
+}
+
+// zoneRData formats the data part of a zone file line for the record
+func zoneRData(recordType string, r DNSRecord) string {
+	switch recordType {
+	case DNSRecordTypeMX:
+		return fmt.Sprintf("%d %s", r.Priority, zoneHostname(r.Value))
+	case DNSRecordTypeSRV:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, zoneHostname(r.Value))
+	case DNSRecordTypeCName, DNSRecordTypeNS, DNSRecordTypeALIAS:
+		return zoneHostname(r.Value)
+	case DNSRecordTypeTXT:
+		return zoneQuote(r.Value)
+	default:
+		return r.Value
+	}
+}
+
+// zoneHostname makes a hostname with dots absolute, leaving single labels relative to the origin
+func zoneHostname(name string) string {
+	if name == "@" || strings.HasSuffix(name, ".") || !strings.Contains(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// zoneQuote quotes a TXT value, splitting it in the 255 byte strings the format allows
+func zoneQuote(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var parts []string
+	for len(value) > 255 {
+		parts = append(parts, `"`+escaped.Replace(value[:255])+`"`)
+		value = value[255:]
+	}
+	parts = append(parts, `"`+escaped.Replace(value)+`"`)
+	return strings.Join(parts, " ")
+}
+
+// ParseDNSZone reads a BIND zone file for the domain origin and returns the records in it,
+// with names relative to origin as the Civo API expects. $ORIGIN and $TTL directives,
+// comments and parenthesised multi-line records are understood; SOA records are skipped
+func ParseDNSZone(origin string, zonefile io.Reader) ([]DNSRecordConfig, error) {
+	domain := strings.ToLower(strings.TrimSuffix(origin, "."))
+	p := zoneParser{domain: domain, origin: domain}
+	records := []DNSRecordConfig{}
+
+	scanner := bufio.NewScanner(zonefile)
+	lineNumber, depth := 0, 0
+	var entry []string
+	var continued bool
+	for scanner.Scan() {
+		lineNumber++
+		line := stripZoneComment(scanner.Text())
+		if depth == 0 {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			continued = line[0] == ' ' || line[0] == '\t'
+		}
+		fields, nesting := splitZoneFields(line)
+		depth += nesting
+		entry = append(entry, fields...)
+		if depth > 0 {
+			continue
+		}
+		if len(entry) == 0 {
+			continue
+		}
+
+		record, ok, err := p.parse(entry, continued)
+		if err != nil {
+			err := fmt.Errorf("line %d: %v", lineNumber, err)
+			return nil, DNSZoneInvalidError.wrap(err)
+		}
+		if ok {
+			records = append(records, record)
+		}
+		entry = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		err := fmt.Errorf("unbalanced parentheses")
+		return nil, DNSZoneInvalidError.wrap(err)
+	}
+
+	return records, nil
+}
+
+// zoneParser holds the state carried between the lines of a zone file
+type zoneParser struct {
+	domain string
+	origin string
+	ttl    int
+	owner  string
+}
+
+// parse turns the fields of one logical line into a record, ok is false for directives and skipped records
+func (p *zoneParser) parse(fields []string, continued bool) (record DNSRecordConfig, ok bool, err error) {
+	switch strings.ToUpper(fields[0]) {
+	case "$ORIGIN":
+		if len(fields) < 2 {
+			return record, false, fmt.Errorf("$ORIGIN needs a domain")
+		}
+		p.origin = strings.ToLower(p.absolute(fields[1]))
+		return record, false, nil
+	case "$TTL":
+		if len(fields) < 2 {
+			return record, false, fmt.Errorf("$TTL needs a value")
+		}
+		p.ttl, err = parseZoneTTL(fields[1])
+		return record, false, err
+	case "$INCLUDE":
+		return record, false, fmt.Errorf("$INCLUDE is not supported")
+	}
+
+	if !continued {
+		p.owner = strings.ToLower(p.absolute(fields[0]))
+		fields = fields[1:]
+	}
+	if p.owner == "" {
+		return record, false, fmt.Errorf("record without an owner name")
+	}
+
+	record.TTL = p.ttl
+	for len(fields) > 0 {
+		if ttl, err := parseZoneTTL(fields[0]); err == nil {
+			record.TTL = ttl
+		} else if class := strings.ToUpper(fields[0]); class != "IN" && class != "CH" && class != "HS" {
+			break
+		}
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return record, false, fmt.Errorf("record without a type")
+	}
+
+	record.Type = DNSRecordType(strings.ToUpper(fields[0]))
+	rdata := fields[1:]
+	if record.Type == "SOA" {
+		return record, false, nil
+	}
+	if !record.Type.Valid() {
+		return record, false, fmt.Errorf("unsupported record type %s", record.Type)
+	}
+	if len(rdata) == 0 {
+		return record, false, fmt.Errorf("%s record without data", record.Type)
+	}
+
+	switch {
+	case p.owner == p.domain:
+		record.Name = "@"
+	case strings.HasSuffix(p.owner, "."+p.domain):
+		record.Name = strings.TrimSuffix(p.owner, "."+p.domain)
+	default:
+		return record, false, fmt.Errorf("%s is outside of %s", p.owner, p.domain)
+	}
+
+	switch record.Type {
+	case DNSRecordTypeMX:
+		if len(rdata) != 2 {
+			return record, false, fmt.Errorf("MX record needs a priority and a host")
+		}
+		if record.Priority, err = strconv.Atoi(rdata[0]); err != nil {
+			return record, false, fmt.Errorf("invalid MX priority %q", rdata[0])
+		}
+		record.Value = p.absolute(rdata[1])
+	case DNSRecordTypeSRV:
+		if len(rdata) != 4 {
+			return record, false, fmt.Errorf("SRV record needs a priority, weight, port and target")
+		}
+		numbers := make([]int, 3)
+		for i := range numbers {
+			if numbers[i], err = strconv.Atoi(rdata[i]); err != nil {
+				return record, false, fmt.Errorf("invalid SRV field %q", rdata[i])
+			}
+		}
+		record.Priority, record.Weight, record.Port = numbers[0], numbers[1], numbers[2]
+		record.Value = p.absolute(rdata[3])
+	case DNSRecordTypeCName, DNSRecordTypeNS, DNSRecordTypeALIAS:
+		record.Value = p.absolute(rdata[0])
+	case DNSRecordTypeTXT:
+		var value strings.Builder
+		for _, s := range rdata {
+			value.WriteString(unquoteZoneString(s))
+		}
+		record.Value = value.String()
+	case DNSRecordTypeCAA:
+		record.Value = strings.Join(rdata, " ")
+	default:
+		record.Value = rdata[0]
+	}
+
+	return record, true, nil
+}
+
+// absolute resolves a zone file name against the current origin, without the trailing dot
+func (p *zoneParser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case p.origin == "":
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// parseZoneTTL parses a TTL in seconds, also accepting the BIND units s, m, h, d and w
+func parseZoneTTL(value string) (int, error) {
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	if value == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+
+	multiplier := 1
+	if unit, ok := units[strings.ToLower(value)[len(value)-1]]; ok {
+		multiplier = unit
+		value = value[:len(value)-1]
+	}
+
+	ttl, err := strconv.Atoi(value)
+	if err != nil || ttl < 0 {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return ttl * multiplier, nil
+}
+
+// stripZoneComment removes a ; comment from a line, ignoring semicolons inside quotes
+func stripZoneComment(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// splitZoneFields splits a line on whitespace, keeping quoted strings together with their quotes.
+// Parentheses outside quotes separate fields too, nesting is how many more were opened than closed
+func splitZoneFields(line string) (fields []string, nesting int) {
+	var field strings.Builder
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case ch == '\\' && i+1 < len(line):
+			field.WriteByte(ch)
+			field.WriteByte(line[i+1])
+			i++
+		case ch == '"':
+			quoted = !quoted
+			field.WriteByte(ch)
+		case (ch == ' ' || ch == '\t' || ch == '(' || ch == ')') && !quoted:
+			if ch == '(' {
+				nesting++
+			} else if ch == ')' {
+				nesting--
+			}
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteByte(ch)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields, nesting
+}
+
+// unquoteZoneString removes the quotes and escapes from a zone file string
+func unquoteZoneString(s string) string {
+	s = strings.TrimPrefix(strings.TrimSuffix(s, `"`), `"`)
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(s)
+}
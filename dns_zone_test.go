@@ -0,0 +1,129 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDNSZone(t *testing.T) {
+	zone := `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. admin.example.com. (
+		2023010101 ; serial
+		3600       ; refresh
+		600 86400 300 )
+@		IN	A	10.0.0.1
+www	600	IN	CNAME	example.com.
+	IN	TXT	"v=spf1 include:_spf.example.com; -all" "more"
+@		IN	MX	10 mail
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+mail.example.com.	300	IN	AAAA	2001:db8::1 ; comment
+`
+
+	got, err := ParseDNSZone("example.com", strings.NewReader(zone))
+	if err != nil {
+		t.Fatalf("Parse returned an error: %s", err)
+	}
+
+	expected := []DNSRecordConfig{
+		{Name: "@", Type: DNSRecordTypeA, Value: "10.0.0.1", TTL: 3600},
+		{Name: "www", Type: DNSRecordTypeCName, Value: "example.com", TTL: 600},
+		{Name: "www", Type: DNSRecordTypeTXT, Value: "v=spf1 include:_spf.example.com; -allmore", TTL: 3600},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com", Priority: 10, TTL: 3600},
+		{Name: "_sip._tcp", Type: DNSRecordTypeSRV, Value: "sip.example.com", Priority: 10, Weight: 5, Port: 5060, TTL: 3600},
+		{Name: "mail", Type: DNSRecordTypeAAAA, Value: "2001:db8::1", TTL: 300},
+	}
+
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestParseDNSZoneErrors(t *testing.T) {
+	zones := []string{
+		"www IN HINFO \"PC\" \"Linux\"\n",
+		"www.other.com. IN A 10.0.0.1\n",
+		"@ IN MX mail\n",
+		"@ IN SOA ns1 admin ( 1 2 3\n",
+	}
+
+	for _, zone := range zones {
+		if _, err := ParseDNSZone("example.com", strings.NewReader(zone)); !errors.Is(err, DNSZoneInvalidError) {
+			t.Errorf("Expected DNSZoneInvalidError for %q, got %v", zone, err)
+		}
+	}
+}
+
+func TestParseDNSZoneParentheses(t *testing.T) {
+	zone := `$ORIGIN example.com.
+()
+www	IN	TXT	"a (b"
+txt	IN	TXT	( "(x)"
+		"y" )
+`
+
+	got, err := ParseDNSZone("example.com", strings.NewReader(zone))
+	if err != nil {
+		t.Fatalf("Parse returned an error: %s", err)
+	}
+
+	expected := []DNSRecordConfig{
+		{Name: "www", Type: DNSRecordTypeTXT, Value: "a (b"},
+		{Name: "txt", Type: DNSRecordTypeTXT, Value: "(x)y"},
+	}
+
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestFormatDNSZone(t *testing.T) {
+	records := []DNSRecord{
+		{Name: "www", Type: DNSRecordTypeCName, Value: "example.com", TTL: 600},
+		{Name: "@", Type: DNSRecordTypeMX, Value: "mail.example.com", Priority: 10, TTL: 600},
+		{Name: "@", Type: DNSRecordTypeA, Value: "10.0.0.1"},
+		{Name: "@", Type: DNSRecordTypeTXT, Value: `say "hi"`, TTL: 600},
+	}
+
+	expected := "$ORIGIN example.com.\n" +
+		"@\t\tIN\tA\t10.0.0.1\n" +
+		"@\t600\tIN\tMX\t10 mail.example.com.\n" +
+		"@\t600\tIN\tTXT\t\"say \\\"hi\\\"\"\n" +
+		"www\t600\tIN\tCNAME\texample.com.\n"
+
+	got := FormatDNSZone("example.com", records)
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	parsed, err := ParseDNSZone("example.com", strings.NewReader(got))
+	if err != nil {
+		t.Fatalf("Parse returned an error: %s", err)
+	}
+	if len(parsed) != len(records) || parsed[2].Value != `say "hi"` {
+		t.Errorf("Expected the exported zone to parse back, got %+v", parsed)
+	}
+}
+
+func TestExportDNSZone(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns?": `[{"id": "12346", "account_id": "1", "name": "example.com"}]`,
+		"/v2/dns/12346/records": `[
+			{"id": "1", "domain_id": "12346", "name": "www", "value": "10.0.0.1", "type": "A", "ttl": 600}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.ExportDNSZone("12346")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := "$ORIGIN example.com.\nwww\t600\tIN\tA\t10.0.0.1\n"
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
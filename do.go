@@ -0,0 +1,66 @@
+package civogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Do sends a request to an endpoint civogo has no method for yet, with the authentication, retries,
+// rate limiting, middleware and error decoding of every other call, e.g.
+//
+//	var widgets []Widget
+//	_, err := client.Do(ctx, http.MethodGet, "/v2/widgets", nil, &widgets)
+//
+// path is relative to the API URL and can have a query. The region of the client is added to the query
+// of GET and DELETE requests, other requests must carry it in body if the endpoint needs it. body is
+// encoded like the bodies of the other calls, as JSON unless the endpoint takes forms, an io.Reader or
+// a []byte is sent as is. The response is decoded into out unless it is nil. The last response received
+// is returned, its body can be read even after an error
+func (c *Client) Do(ctx context.Context, method, path string, body, out interface{}) (*http.Response, error) {
+	if ctx == nil {
+		ctx = c.requestContext()
+	}
+
+	u := c.prepareClientURL(path)
+	encoding, _ := c.requestEncoding(u.Path)
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	case []byte:
+		reader = bytes.NewReader(b)
+	default:
+		payload, err := encodeBody(body, encoding)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := body.(io.Reader); !ok && reader != nil {
+		req.Header.Set("Content-Type", encoding.contentType())
+	}
+
+	resp, respBody, err := c.sendRequestResponse(req)
+	if err != nil {
+		return resp, decodeError(err)
+	}
+
+	if out != nil && len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp, fmt.Errorf("unable to decode the response of %s %s: %w", method, path, err)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDo(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("region") != "TEST" || r.Header.Get("Authorization") != "bearer TEST-API-KEY" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(`[{"id": "1", "name": "first"}]`))
+		case http.MethodPost:
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": "widget_not_found", "reason": "no such widget"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	var widgets []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	resp, err := client.Do(context.Background(), http.MethodGet, "/v2/widgets", nil, &widgets)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	g.Expect(widgets).To(HaveLen(1))
+	g.Expect(widgets[0].Name).To(Equal("first"))
+
+	resp, err = client.Do(context.Background(), http.MethodPost, "/v2/widgets", map[string]string{"name": "second"}, nil)
+	g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	var apiErr *APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.Code).To(Equal("widget_not_found"))
+	g.Expect(apiErr.Reason).To(Equal("no such widget"))
+
+	body, _ := io.ReadAll(resp.Body)
+	g.Expect(string(body)).To(ContainSubstring("widget_not_found"))
+}
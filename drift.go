@@ -0,0 +1,202 @@
+package civogo
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Diff is a field of a resource whose actual value is not the desired one, as found by the Compare
+// functions. Those only compare the fields set in the desired config, so defaults filled in by the
+// API are not reported as drift
+type Diff struct {
+	// Field is the name of the field in the config, e.g. "Size" or "Pools[workers].Count"
+	Field   string
+	Desired interface{}
+	Actual  interface{}
+}
+
+// String returns the diff as "Field: want desired, got actual"
+func (d Diff) String() string {
+	return fmt.Sprintf("%s: want %v, got %v", d.Field, d.Desired, d.Actual)
+}
+
+// CompareInstance returns the differences between an instance and the config it should match, empty when
+// it matches. Tags are compared ignoring their order
+func CompareInstance(desired InstanceConfig, actual Instance) []Diff {
+	d := &differ{}
+	d.folded("Hostname", desired.Hostname, actual.Hostname)
+	d.str("ReverseDNS", desired.ReverseDNS, actual.ReverseDNS)
+	d.str("Size", desired.Size, actual.Size)
+	d.folded("Region", desired.Region, actual.Region)
+	d.str("NetworkID", desired.NetworkID, actual.NetworkID)
+	d.str("TemplateID", desired.TemplateID, actual.TemplateID)
+	d.str("SnapshotID", desired.SnapshotID, actual.SnapshotID)
+	d.str("InitialUser", desired.InitialUser, actual.InitialUser)
+	d.str("SSHKeyID", desired.SSHKeyID, actual.SSHKeyID)
+	d.str("FirewallID", desired.FirewallID, actual.FirewallID)
+	d.str("VolumeType", desired.VolumeType, actual.VolumeType)
+	d.str("ReservedIPv4", desired.ReservedIPv4, actual.ReservedIP)
+	d.str("PrivateIPv4", desired.PrivateIPv4, actual.PrivateIP)
+	if strings.EqualFold(desired.PublicIPRequired, "none") && actual.PublicIP != "" {
+		d.add("PublicIPRequired", desired.PublicIPRequired, actual.PublicIP)
+	}
+
+	tags := desired.Tags
+	if len(removeTags(tags, nil)) == 0 {
+		tags = strings.Fields(desired.TagsList)
+	}
+	d.tags("Tags", tags, actual.Tags)
+	d.labels("Labels", desired.Labels, actual.Labels)
+	return d.diffs
+}
+
+// CompareKubernetesCluster returns the differences between a cluster and the config it should match, empty
+// when it matches. Pools are matched by ID, or by size for the pools of the config without an ID (e.g. from
+// KubernetesClusterConfigBuilder.Pool); when the config has pools a pool of the cluster missing from it is reported too
+func CompareKubernetesCluster(desired KubernetesClusterConfig, actual KubernetesCluster) []Diff {
+	d := &differ{}
+	d.str("Name", desired.Name, actual.Name)
+	d.folded("ClusterType", desired.ClusterType, actual.ClusterType)
+	if desired.KubernetesVersion != "" && desired.KubernetesVersion != actual.Version && desired.KubernetesVersion != actual.KubernetesVersion {
+		d.add("KubernetesVersion", desired.KubernetesVersion, actual.Version)
+	}
+	d.str("NetworkID", desired.NetworkID, actual.NetworkID)
+	d.str("FirewallID", desired.FirewallID, actual.FirewallID)
+	d.str("InstanceFirewall", desired.InstanceFirewall, actual.FirewallID)
+	d.folded("CNIPlugin", desired.CNIPlugin, actual.CNIPlugin)
+	d.tags("Tags", strings.Fields(desired.Tags), actual.Tags)
+	d.labels("Labels", desired.Labels, actual.Labels)
+
+	if len(desired.Pools) == 0 {
+		d.num("NumTargetNodes", desired.NumTargetNodes, actual.NumTargetNode)
+		d.str("TargetNodesSize", desired.TargetNodesSize, actual.TargetNodeSize)
+		return d.diffs
+	}
+
+	matched := make([]bool, len(actual.Pools))
+	for p, pool := range desired.Pools {
+		current := -1
+		for i := range actual.Pools {
+			if matched[i] {
+				continue
+			}
+			if (pool.ID != "" && actual.Pools[i].ID == pool.ID) || (pool.ID == "" && strings.EqualFold(actual.Pools[i].Size, pool.Size)) {
+				current = i
+				break
+			}
+		}
+		if current < 0 {
+			field := fmt.Sprintf("Pools[%s]", pool.ID)
+			if pool.ID == "" {
+				field = fmt.Sprintf("Pools[%d]", p)
+			}
+			d.add(field, pool.Size, nil)
+			continue
+		}
+		matched[current] = true
+
+		field := fmt.Sprintf("Pools[%s]", actual.Pools[current].ID)
+		d.num(field+".Count", pool.Count, actual.Pools[current].Count)
+		d.str(field+".Size", pool.Size, actual.Pools[current].Size)
+		d.num(field+".MinCount", pool.MinCount, actual.Pools[current].MinCount)
+		d.num(field+".MaxCount", pool.MaxCount, actual.Pools[current].MaxCount)
+		d.labels(field+".Labels", pool.Labels, actual.Pools[current].Labels)
+	}
+	for i, pool := range actual.Pools {
+		if !matched[i] {
+			d.add(fmt.Sprintf("Pools[%s]", pool.ID), nil, pool.Size)
+		}
+	}
+
+	return d.diffs
+}
+
+// CompareLoadBalancer returns the differences between a load balancer and the config it should match, empty
+// when it matches. Backends are compared as a set, ignoring their order
+func CompareLoadBalancer(desired LoadBalancerConfig, actual LoadBalancer) []Diff {
+	d := &differ{}
+	d.str("Name", desired.Name, actual.Name)
+	d.str("ServiceName", desired.ServiceName, actual.ServiceName)
+	d.str("NetworkID", desired.NetworkID, actual.NetworkID)
+	d.str("Algorithm", desired.Algorithm, actual.Algorithm)
+	d.str("ExternalTrafficPolicy", desired.ExternalTrafficPolicy, actual.ExternalTrafficPolicy)
+	d.str("SessionAffinity", desired.SessionAffinity, actual.SessionAffinity)
+	d.num("SessionAffinityConfigTimeout", int(desired.SessionAffinityConfigTimeout), int(actual.SessionAffinityConfigTimeout))
+	d.str("EnableProxyProtocol", desired.EnableProxyProtocol, actual.EnableProxyProtocol)
+	d.str("ClusterID", desired.ClusterID, actual.ClusterID)
+	d.str("FirewallID", desired.FirewallID, actual.FirewallID)
+	if desired.MaxConcurrentRequests != nil {
+		d.num("MaxConcurrentRequests", *desired.MaxConcurrentRequests, actual.MaxConcurrentRequests)
+	}
+
+	if desired.Backends != nil {
+		want := make([]string, len(desired.Backends))
+		for i, b := range desired.Backends {
+			want[i] = backendKey(b.IP, b.Protocol, b.SourcePort, b.TargetPort)
+		}
+		got := make([]string, len(actual.Backends))
+		for i, b := range actual.Backends {
+			got[i] = backendKey(b.IP, b.Protocol, b.SourcePort, b.TargetPort)
+		}
+		d.set("Backends", want, got)
+	}
+
+	return d.diffs
+}
+
+// backendKey describes a load balancer backend, e.g. "10.0.0.1 tcp 80->8080"
+func backendKey(ip, protocol string, sourcePort, targetPort int32) string {
+	return fmt.Sprintf("%s %s %d->%d", ip, strings.ToLower(protocol), sourcePort, targetPort)
+}
+
+// differ collects the diffs of the fields set in a desired config
+type differ struct {
+	diffs []Diff
+}
+
+func (d *differ) add(field string, desired, actual interface{}) {
+	d.diffs = append(d.diffs, Diff{Field: field, Desired: desired, Actual: actual})
+}
+
+func (d *differ) str(field, desired, actual string) {
+	if desired != "" && desired != actual {
+		d.add(field, desired, actual)
+	}
+}
+
+func (d *differ) folded(field, desired, actual string) {
+	if desired != "" && !strings.EqualFold(desired, actual) {
+		d.add(field, desired, actual)
+	}
+}
+
+func (d *differ) num(field string, desired, actual int) {
+	if desired != 0 && desired != actual {
+		d.add(field, desired, actual)
+	}
+}
+
+func (d *differ) tags(field string, desired, actual []string) {
+	desired = removeTags(desired, nil)
+	if len(desired) > 0 {
+		d.set(field, desired, removeTags(actual, nil))
+	}
+}
+
+// set compares desired and actual ignoring their order
+func (d *differ) set(field string, desired, actual []string) {
+	want, got := cloneSlice(desired), cloneSlice(actual)
+	sort.Strings(want)
+	sort.Strings(got)
+	if strings.Join(want, "\x00") != strings.Join(got, "\x00") {
+		d.add(field, desired, actual)
+	}
+}
+
+func (d *differ) labels(field string, desired, actual map[string]string) {
+	if desired != nil && !(len(desired) == 0 && len(actual) == 0) && !reflect.DeepEqual(desired, actual) {
+		d.add(field, desired, actual)
+	}
+}
@@ -0,0 +1,138 @@
+package civogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareInstance(t *testing.T) {
+	desired := InstanceConfig{
+		Hostname:   "Web-1",
+		Size:       "g3.medium",
+		Region:     "lon1",
+		NetworkID:  "net-1",
+		FirewallID: "fw-2",
+		Tags:       []string{"prod", "web"},
+		Labels:     map[string]string{"team": "web"},
+	}
+	actual := Instance{
+		Hostname:   "web-1",
+		Size:       "g3.large",
+		Region:     "LON1",
+		NetworkID:  "net-1",
+		FirewallID: "fw-1",
+		Tags:       []string{"web", "prod"},
+		Labels:     map[string]string{"team": "web"},
+		PublicIP:   "74.220.1.1",
+	}
+
+	expected := []Diff{
+		{Field: "Size", Desired: "g3.medium", Actual: "g3.large"},
+		{Field: "FirewallID", Desired: "fw-2", Actual: "fw-1"},
+	}
+	if got := CompareInstance(desired, actual); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	desired = InstanceConfig{PublicIPRequired: "none", TagsList: "prod"}
+	expected = []Diff{
+		{Field: "PublicIPRequired", Desired: "none", Actual: "74.220.1.1"},
+		{Field: "Tags", Desired: []string{"prod"}, Actual: []string{"web", "prod"}},
+	}
+	if got := CompareInstance(desired, actual); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if got := CompareInstance(InstanceConfig{}, actual); len(got) != 0 {
+		t.Errorf("Expected an empty config to match, got %v", got)
+	}
+}
+
+func TestCompareKubernetesCluster(t *testing.T) {
+	desired := KubernetesClusterConfig{
+		Name:              "production",
+		KubernetesVersion: "1.28.2-k3s1",
+		Tags:              "prod",
+		Pools: []KubernetesClusterPoolConfig{
+			{ID: "workers", Size: "g4s.kube.medium", Count: 3},
+			{ID: "gpu", Size: "g4g.kube.small", Count: 1},
+		},
+	}
+	actual := KubernetesCluster{
+		Name:    "production",
+		Version: "1.28.2-k3s1",
+		Tags:    []string{"prod"},
+		Pools: []KubernetesPool{
+			{ID: "workers", Size: "g4s.kube.medium", Count: 5},
+			{ID: "legacy", Size: "g4s.kube.small", Count: 1},
+		},
+	}
+
+	expected := []Diff{
+		{Field: "Pools[workers].Count", Desired: 3, Actual: 5},
+		{Field: "Pools[gpu]", Desired: "g4g.kube.small", Actual: nil},
+		{Field: "Pools[legacy]", Desired: nil, Actual: "g4s.kube.small"},
+	}
+	if got := CompareKubernetesCluster(desired, actual); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestCompareKubernetesClusterPoolsWithoutIDs(t *testing.T) {
+	desired, err := NewKubernetesClusterConfigBuilder("production").
+		Network("net-1").
+		Pool("g4s.kube.medium", 3).
+		Pool("g4g.kube.small", 2).
+		Build()
+	if err != nil {
+		t.Fatalf("Building the config returned an error: %s", err)
+	}
+	actual := KubernetesCluster{
+		Name:        "production",
+		ClusterType: KubernetesClusterTypeK3s,
+		CNIPlugin:   KubernetesCNIPluginFlannel,
+		NetworkID:   "net-1",
+		Pools: []KubernetesPool{
+			{ID: "gpu-8a849cc5", Size: "g4g.kube.small", Count: 1},
+			{ID: "workers-e733ea47", Size: "g4s.kube.medium", Count: 3},
+		},
+	}
+
+	expected := []Diff{{Field: "Pools[gpu-8a849cc5].Count", Desired: 2, Actual: 1}}
+	if got := CompareKubernetesCluster(*desired, actual); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestCompareLoadBalancer(t *testing.T) {
+	limit := 1000
+	desired := LoadBalancerConfig{
+		Name:                  "web",
+		MaxConcurrentRequests: &limit,
+		Backends: []LoadBalancerBackendConfig{
+			{IP: "10.0.0.2", Protocol: "TCP", SourcePort: 80, TargetPort: 8080},
+			{IP: "10.0.0.1", Protocol: "tcp", SourcePort: 80, TargetPort: 8080},
+		},
+	}
+	actual := LoadBalancer{
+		Name:                  "web",
+		MaxConcurrentRequests: 1000,
+		Backends: []LoadBalancerBackend{
+			{IP: "10.0.0.1", Protocol: "tcp", SourcePort: 80, TargetPort: 8080},
+			{IP: "10.0.0.2", Protocol: "tcp", SourcePort: 80, TargetPort: 8080},
+		},
+	}
+
+	if got := CompareLoadBalancer(desired, actual); len(got) != 0 {
+		t.Errorf("Expected no drift, got %v", got)
+	}
+
+	actual.Backends = actual.Backends[:1]
+	got := CompareLoadBalancer(desired, actual)
+	if len(got) != 1 || got[0].Field != "Backends" {
+		t.Errorf("Expected the backends to drift, got %v", got)
+	}
+	if got[0].String() != "Backends: want [10.0.0.2 tcp 80->8080 10.0.0.1 tcp 80->8080], got [10.0.0.1 tcp 80->8080]" {
+		t.Errorf("Unexpected description %q", got[0].String())
+	}
+}
@@ -0,0 +1,52 @@
+package civogo
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"os"
+)
+
+// dryRunResponse is the body returned for the requests skipped in dry-run mode, decoding to zero values
+// with a successful Result. Methods decoding a list from a skipped request return an empty list instead
+const dryRunResponse = `{"result": "success"}`
+
+// WithDryRun makes the client skip every request changing something, i.e. anything but GET. The request
+// that would have been sent is logged, with its secrets redacted, and a zero-value response is returned,
+// so pipelines can be tested safely. Configs are still validated, as before any request
+func WithDryRun() ClientOption {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// WithDryRun returns a shallow copy of the client in dry-run mode, see the WithDryRun option, e.g.
+// client.WithDryRun().DeleteInstance(id), the original client keeps sending its requests
+func (c *Client) WithDryRun() *Client {
+	c2 := *c
+	c2.dryRun = true
+	return &c2
+}
+
+// skipDryRun reports whether req must not be sent because the client is in dry-run mode, logging it if so
+func (c *Client) skipDryRun(req *http.Request) bool {
+	if !c.dryRun || req.Method == http.MethodGet {
+		return false
+	}
+
+	body := ""
+	if req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(r)
+			body = string(b)
+		}
+	}
+
+	logger := c.logger
+	if logger == nil {
+		logger = log.New(os.Stderr, "civogo ", log.LstdFlags)
+	}
+	logger.Printf("dry run: %s %s %s", req.Method, req.URL.String(), c.sanitise(req.URL.Path, body))
+
+	return true
+}
@@ -0,0 +1,72 @@
+package civogo
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDryRun(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	methods := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`[{"id": "12345", "name": "example.com"}]`))
+	}))
+	defer server.Close()
+
+	var logged bytes.Buffer
+	client, _ := NewClientForTestingWithServer(server)
+	WithLogger(log.New(&logged, "", 0))(client)
+	dryRun := client.WithDryRun()
+
+	domain, err := dryRun.CreateDNSDomain("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(domain.ID).To(BeEmpty())
+	g.Expect(logged.String()).To(ContainSubstring(`dry run: POST ` + server.URL + `/v2/dns {"name":"example.com"}`))
+
+	result, err := dryRun.DeleteDNSDomain(&DNSDomain{ID: "12345"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(result.Result)).To(Equal(ResultSuccess))
+
+	_, err = dryRun.NewKubernetesClusters(&KubernetesClusterConfig{})
+	g.Expect(errors.Is(err, KubernetesClusterConfigInvalidError)).To(BeTrue())
+
+	domains, err := dryRun.ListDNSDomains()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(domains).To(HaveLen(1))
+	g.Expect(methods).To(Equal([]string{"GET"}))
+
+	_, _ = client.CreateDNSDomain("example.com")
+	g.Expect(methods).To(Equal([]string{"GET", "POST"}))
+}
+
+func TestDryRunListResponses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	methods := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`[{"id": "member-1", "user_id": "user-1"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithLogger(log.New(&bytes.Buffer{}, "", 0))(client)
+	dryRun := client.WithDryRun()
+
+	accounts, err := dryRun.AddAccountToOrganisation("org-1", "token")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(accounts).To(BeEmpty())
+
+	members, err := dryRun.AddTeamMember("team-1", "user-2", "", "")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(members).To(HaveLen(1))
+	g.Expect(methods).To(Equal([]string{"GET"}))
+}
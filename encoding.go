@@ -0,0 +1,202 @@
+package civogo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RequestEncoding is the encoding of the body of POST and PUT requests
+type RequestEncoding string
+
+const (
+	// RequestEncodingJSON sends bodies as JSON, the default
+	RequestEncodingJSON RequestEncoding = "json"
+	// RequestEncodingForm sends bodies as application/x-www-form-urlencoded, nested objects and lists
+	// flattened to the key[field] and key[] notation
+	RequestEncodingForm RequestEncoding = "form"
+)
+
+// contentType returns the Content-Type header of bodies in the encoding
+func (e RequestEncoding) contentType() string {
+	if e == RequestEncodingForm {
+		return "application/x-www-form-urlencoded"
+	}
+	return "application/json"
+}
+
+// encodingState holds the encodings configured with WithRequestEncoding and the ones negotiated with
+// the API, by path prefix, shared by every copy of a Client
+type encodingState struct {
+	mu         sync.RWMutex
+	configured map[string]RequestEncoding
+	negotiated map[string]RequestEncoding
+}
+
+// WithRequestEncoding makes the client send the bodies of the requests to the paths starting with
+// pathPrefix, e.g. "/v2/firewalls", in encoding. The longest matching prefix wins. Bodies are otherwise
+// sent as JSON, and an endpoint rejecting JSON with a 415 Unsupported Media Type is retried once as a
+// form, then always sent a form by this client
+func WithRequestEncoding(pathPrefix string, encoding RequestEncoding) ClientOption {
+	return func(c *Client) {
+		if encoding != RequestEncodingJSON && encoding != RequestEncodingForm {
+			c.setOptionErr(fmt.Errorf("unknown request encoding %q", encoding))
+			return
+		}
+
+		c.encodings.mu.Lock()
+		c.encodings.configured[pathPrefix] = encoding
+		c.encodings.mu.Unlock()
+	}
+}
+
+func newEncodingState() *encodingState {
+	return &encodingState{
+		configured: make(map[string]RequestEncoding),
+		negotiated: make(map[string]RequestEncoding),
+	}
+}
+
+// requestEncoding returns the encoding of the bodies sent to path and whether it was chosen explicitly
+// or negotiated, rather than being the default
+func (c *Client) requestEncoding(path string) (RequestEncoding, bool) {
+	if c.encodings == nil {
+		return RequestEncodingJSON, false
+	}
+
+	c.encodings.mu.RLock()
+	defer c.encodings.mu.RUnlock()
+
+	if encoding, ok := c.encodings.negotiated[path]; ok {
+		return encoding, true
+	}
+
+	best := ""
+	encoding, ok := RequestEncodingJSON, false
+	for prefix, e := range c.encodings.configured {
+		if strings.HasPrefix(path, prefix) && len(prefix) >= len(best) {
+			best, encoding, ok = prefix, e, true
+		}
+	}
+
+	return encoding, ok
+}
+
+// rememberEncoding makes the client send the bodies of the requests to path in encoding from now on
+func (c *Client) rememberEncoding(path string, encoding RequestEncoding) {
+	if c.encodings == nil {
+		return
+	}
+
+	c.encodings.mu.Lock()
+	c.encodings.negotiated[path] = encoding
+	c.encodings.mu.Unlock()
+}
+
+// sendBodyRequest sends params to requestURL with method in the encoding of the endpoint, switching to
+// a form for good when the API answers a JSON body with a 415
+func (c *Client) sendBodyRequest(ctx context.Context, method, requestURL string, params interface{}) ([]byte, error) {
+	u := c.prepareClientURL(requestURL)
+	encoding, chosen := c.requestEncoding(u.Path)
+
+	body, err := c.sendEncodedRequest(ctx, method, u, params, encoding)
+	var httpErr HTTPError
+	if err == nil || chosen || !errors.As(err, &httpErr) || httpErr.Code != http.StatusUnsupportedMediaType {
+		return body, err
+	}
+
+	c.debugf("request: %s rejected JSON, retrying as a form", u.Path)
+	body, err = c.sendEncodedRequest(ctx, method, u, params, RequestEncodingForm)
+	if err == nil {
+		c.rememberEncoding(u.Path, RequestEncodingForm)
+	}
+
+	return body, err
+}
+
+func (c *Client) sendEncodedRequest(ctx context.Context, method string, u *url.URL, params interface{}, encoding RequestEncoding) ([]byte, error) {
+	payload, err := encodeBody(params, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", encoding.contentType())
+
+	return c.sendRequest(req)
+}
+
+// encodeBody encodes params in encoding. Forms are built from the JSON encoding of params, so they use
+// the same field names and omit the same empty fields
+func encodeBody(params interface{}, encoding RequestEncoding) ([]byte, error) {
+	if values, ok := params.(url.Values); ok && encoding == RequestEncodingForm {
+		return []byte(values.Encode()), nil
+	}
+
+	jsonValue, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode the request body: %w", err)
+	}
+	if encoding != RequestEncodingForm {
+		return jsonValue, nil
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonValue))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("unable to encode the request body: %w", err)
+	}
+
+	values := url.Values{}
+	if err := flattenForm(values, "", decoded); err != nil {
+		return nil, err
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+// flattenForm adds value to values under key, objects as key[field] and lists as key[]
+func flattenForm(values url.Values, key string, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		fields := make([]string, 0, len(v))
+		for field := range v {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			name := field
+			if key != "" {
+				name = key + "[" + field + "]"
+			}
+			if err := flattenForm(values, name, v[field]); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := flattenForm(values, key+"[]", item); err != nil {
+				return err
+			}
+		}
+	default:
+		if key == "" {
+			return fmt.Errorf("unable to encode a %T request body as a form", value)
+		}
+		values.Add(key, fmt.Sprint(v))
+	}
+
+	return nil
+}
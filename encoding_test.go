@@ -0,0 +1,66 @@
+package civogo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRequestEncoding(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	contentTypes, bodies := []string{}, []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		bodies = append(bodies, string(body))
+		w.Write([]byte(`{"result": "success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithRequestEncoding("/v2/firewalls", RequestEncodingForm)(client)
+
+	_, err := client.SendPostRequest("/v2/firewalls/1/rules", map[string]interface{}{
+		"protocol": "tcp",
+		"cidr":     []string{"10.0.0.0/8", "192.168.0.0/16"},
+		"pool":     map[string]interface{}{"size": "g4s.small", "count": 3},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(contentTypes[0]).To(Equal("application/x-www-form-urlencoded"))
+	g.Expect(bodies[0]).To(Equal("cidr%5B%5D=10.0.0.0%2F8&cidr%5B%5D=192.168.0.0%2F16&pool%5Bcount%5D=3&pool%5Bsize%5D=g4s.small&protocol=tcp"))
+
+	_, err = client.SendPutRequest("/v2/instances/1", map[string]string{"hostname": "web"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(contentTypes[1]).To(Equal("application/json"))
+	g.Expect(bodies[1]).To(Equal(`{"hostname":"web"}`))
+}
+
+func TestRequestEncodingNegotiation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	contentTypes := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		if r.Header.Get("Content-Type") == "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		w.Write([]byte(`{"result": "success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.SendPostRequest("/v2/legacy", map[string]string{"name": "test"})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.SendPostRequest("/v2/legacy", map[string]string{"name": "test"})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(contentTypes).To(Equal([]string{"application/json", "application/x-www-form-urlencoded", "application/x-www-form-urlencoded"}))
+
+	_, err = NewClientWithURL("key", server.URL, "LON1", WithRequestEncoding("/v2", "xml"))
+	g.Expect(err).To(HaveOccurred())
+}
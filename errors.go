@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,6 +30,13 @@ var (
 	CannotRestoreNewVolumeError             = constError("CannotRestoreNewVolumeError")
 	CannotScaleAlreadyRescalingClusterError = constError("CannotScaleAlreadyRescalingClusterError")
 	VolumeInvalidSizeError                  = constError("VolumeInvalidSizeError")
+	SnapshotScheduleInvalidError            = constError("SnapshotScheduleInvalidError")
+
+	MetricsPeriodInvalidError = constError("MetricsPeriodInvalidError")
+	CostEstimateInvalidError  = constError("CostEstimateInvalidError")
+	CassetteNoMatchError      = constError("CassetteNoMatchError")
+	AuditFilterInvalidError   = constError("AuditFilterInvalidError")
+	SweepInvalidError         = constError("SweepInvalidError")
 
 	DatabaseAccountDestroyError      = constError("DatabaseAccountDestroyError")
 	DatabaseAccountNotFoundError     = constError("DatabaseAccountNotFoundError")
@@ -63,6 +71,9 @@ var (
 	DatabaseDNSRecordNotFoundError      = constError("DatabaseDNSRecordNotFoundError")
 	DatabaseDNSRecordUpdateError        = constError("DatabaseDNSRecordUpdateError")
 	DatabaseListingDNSDomainsError      = constError("DatabaseListingDNSDomainsError")
+	DNSRecordInvalidError               = constError("DNSRecordInvalidError")
+	DNSBulkOperationFailedError         = constError("DNSBulkOperationFailedError")
+	DNSZoneInvalidError                 = constError("DNSZoneInvalidError")
 
 	DatabaseFirewallCreateError           = constError("DatabaseFirewallCreateError")
 	DatabaseFirewallRulesInvalidParams    = constError("DatabaseFirewallRulesInvalidParams")
@@ -76,6 +87,8 @@ var (
 	DatabaseFirewallRulesFindError        = constError("DatabaseFirewallRulesFindError")
 	DatabaseListingFirewallsError         = constError("DatabaseListingFirewallsError")
 	FirewallDuplicateError                = constError("FirewallDuplicateError")
+	FirewallRuleInvalidError              = constError("FirewallRuleInvalidError")
+	KubernetesClusterNoFirewallError      = constError("KubernetesClusterNoFirewallError")
 
 	// Instances Errors
 	DatabaseInstanceAlreadyinRescueStateError              = constError("DatabaseInstanceAlreadyinRescueStateError")
@@ -92,6 +105,16 @@ var (
 	DatabaseOldInstanceFindError                           = constError("DatabaseOldInstanceFindError")
 	DatabaseCannotMoveIPError                              = constError("DatabaseCannotMoveIPError")
 	DatabaseIPFindError                                    = constError("DatabaseIPFindError")
+	InstanceResizeInvalidError                             = constError("InstanceResizeInvalidError")
+	InstanceConfigInvalidError                             = constError("InstanceConfigInvalidError")
+	InstancePasswordUnavailableError                       = constError("InstancePasswordUnavailableError")
+	InstanceBulkOperationFailedError                       = constError("InstanceBulkOperationFailedError")
+	PlacementGroupInvalidError                             = constError("PlacementGroupInvalidError")
+	InstanceSnapshotInvalidError                           = constError("InstanceSnapshotInvalidError")
+
+	// IP Errors
+	IPNotReservedError         = constError("IPNotReservedError")
+	IPResourceTypeInvalidError = constError("IPResourceTypeInvalidError")
 
 	// Kubernetes Errors
 	DatabaseKubernetesClusterInvalidError         = constError("DatabaseKubernetesClusterInvalid")
@@ -100,11 +123,16 @@ var (
 	DatabaseKubernetesClusterDuplicateError       = constError("DatabaseKubernetesClusterDuplicate")
 	DatabaseKubernetesClusterNotFoundError        = constError("DatabaseKubernetesClusterNotFound")
 	DatabaseKubernetesNodeNotFoundError           = constError("DatabaseKubernetesNodeNotFound")
+	KubernetesKubeconfigUnavailableError          = constError("KubernetesKubeconfigUnavailable")
+	KubernetesUpgradeInvalidError                 = constError("KubernetesUpgradeInvalid")
 
 	DatabaseClusterPoolNotFoundError                       = constError("DatabaseClusterPoolNotFound")
 	DatabaseClusterPoolInstanceNotFoundError               = constError("DatabaseClusterPoolInstanceNotFound")
 	DatabaseClusterPoolInstanceDeleteFailedError           = constError("DatabaseClusterPoolInstanceDeleteFailed")
 	DatabaseClusterPoolNoSufficientInstancesAvailableError = constError("DatabaseClusterPoolNoSufficientInstancesAvailable")
+	KubernetesPoolInvalidError                             = constError("KubernetesPoolInvalid")
+	KubernetesClusterConfigInvalidError                    = constError("KubernetesClusterConfigInvalid")
+	ClusterBootstrapFailedError                            = constError("ClusterBootstrapFailedError")
 
 	DatabaseListingAccountsError              = constError("DatabaseListingAccountsError")
 	DatabaseListingMembershipsError           = constError("DatabaseListingMembershipsError")
@@ -122,6 +150,14 @@ var (
 	DatabaseLoadBalancerExistsError    = constError("DatabaseLoadBalancerExistsError")
 	DatabaseLoadBalancerNotFoundError  = constError("DatabaseLoadBalancerNotFoundError")
 
+	LoadBalancerBackendInvalidError     = constError("LoadBalancerBackendInvalidError")
+	LoadBalancerBackendNotFoundError    = constError("LoadBalancerBackendNotFoundError")
+	LoadBalancerCertificateInvalidError = constError("LoadBalancerCertificateInvalidError")
+	LoadBalancerSNIRouteInvalidError    = constError("LoadBalancerSNIRouteInvalidError")
+
+	ObjectStoreInvalidError               = constError("ObjectStoreInvalidError")
+	ObjectStoreCredentialUnavailableError = constError("ObjectStoreCredentialUnavailableError")
+
 	DatabaseNetworksListError              = constError("DatabaseNetworksListError")
 	DatabaseNetworkCreateError             = constError("DatabaseNetworkCreateError")
 	DatabaseNetworkExistsError             = constError("DatabaseNetworkExistsError")
@@ -132,6 +168,25 @@ var (
 	DatabaseNetworkLookupError             = constError("DatabaseNetworkLookupError")
 	DatabaseNetworkNotFoundError           = constError("DatabaseNetworkNotFoundError")
 	DatabaseNetworkSaveError               = constError("DatabaseNetworkSaveError")
+	NetworkConfigInvalidError              = constError("NetworkConfigInvalidError")
+	NetworkInUseError                      = constError("NetworkInUseError")
+
+	SSHKeyInvalidError           = constError("SSHKeyInvalidError")
+	DiskImageInvalidError        = constError("DiskImageInvalidError")
+	DiskImageUploadError         = constError("DiskImageUploadError")
+	RoleInvalidError             = constError("RoleInvalidError")
+	WebhookInvalidError          = constError("WebhookInvalidError")
+	WebhookSignatureInvalidError = constError("WebhookSignatureInvalidError")
+
+	ApplicationInvalidError           = constError("ApplicationInvalidError")
+	ApplicationProcessNotFoundError   = constError("ApplicationProcessNotFoundError")
+	ApplicationDeploymentInvalidError = constError("ApplicationDeploymentInvalidError")
+
+	TagInvalidError   = constError("TagInvalidError")
+	LabelInvalidError = constError("LabelInvalidError")
+
+	DatabaseConfigInvalidError          = constError("DatabaseConfigInvalidError")
+	DatabaseCredentialsUnavailableError = constError("DatabaseCredentialsUnavailableError")
 
 	DatabasePrivateIPFromPublicIPError = constError("DatabasePrivateIPFromPublicIPError")
 
@@ -319,9 +374,25 @@ func (err wrapError) Is(target error) bool {
 }
 
 func decodeError(err error) error {
+	decoded := decodeResponseError(err)
+	if httpErr, ok := err.(HTTPError); ok {
+		return newAPIError(httpErr, decoded)
+	}
+
+	return decoded
+}
+
+// decodeResponseError maps err onto one of the package sentinel errors
+func decodeResponseError(err error) error {
 	var response map[string]interface{}
 	var msg strings.Builder
 
+	// cancellation by the caller is not an API failure, so hand it back untouched
+	// and let errors.Is(err, context.Canceled) keep working
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
 	switch err := err.(type) {
 	case *url.Error:
 		if _, ok := err.Err.(net.Error); ok {
@@ -339,6 +410,8 @@ func decodeError(err error) error {
 		}
 	case wrapError:
 		return err
+	case *APIError:
+		return err
 	case HTTPError:
 		errorData := err
 		reason := []byte(errorData.Reason)
@@ -983,6 +1056,9 @@ func decodeError(err error) error {
 		case "database_instance_list":
 			err := errors.New(msg.String())
 			return DatabaseInstanceListError.wrap(err)
+		case "instance_password_unavailable":
+			err := errors.New(msg.String())
+			return InstancePasswordUnavailableError.wrap(err)
 		case "database_instance_find":
 			err := errors.New(msg.String())
 			return DatabaseInstanceNotFoundError.wrap(err)
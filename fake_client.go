@@ -1,11 +1,18 @@
 package civogo
 
 import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/mod/semver"
 )
 
 // FakeClient is a temporary storage structure for use when you don't want to communicate with a real Civo API server
@@ -17,99 +24,125 @@ type FakeClient struct {
 	Firewalls               []Firewall
 	FirewallRules           []FirewallRule
 	InstanceSizes           []InstanceSize
-	Instances               []Instance
+	InstanceList            []Instance
 	Clusters                []KubernetesCluster
 	IP                      []IP
 	Networks                []Network
+	NetworkAttachments      []NetworkAttachment
 	Volumes                 []Volume
+	VolumeSnapshots         []VolumeSnapshot
+	InstanceSnapshots       []InstanceSnapshot
+	SnapshotSchedules       []SnapshotSchedule
+	PlacementGroups         []PlacementGroup
 	SSHKeys                 []SSHKey
 	Webhooks                []Webhook
 	DiskImage               []DiskImage
 	Quota                   Quota
+	Account                 Account
+	APIKeys                 []APIKey
 	Organisation            Organisation
 	OrganisationAccounts    []Account
 	OrganisationRoles       []Role
 	OrganisationTeams       []Team
 	OrganisationTeamMembers map[string][]TeamMember
 	LoadBalancers           []LoadBalancer
+	LoadBalancerCerts       []LoadBalancerCertificate
 	Pools                   []KubernetesPool
+	ScalingEvents           []KubernetesScalingEvent
+	Applications            []Application
+	ApplicationDeployments  []ApplicationDeployment
+	Databases               []Database
+	DatabaseBackups         []DatabaseBackup
+	ObjectStores            []ObjectStore
+	ObjectStoreCredentials  []ObjectStoreCredential
+	Subnets                 []Subnet
+	KfClusters              []KfCluster
 	PingErr                 error
+	// Metrics are returned by GetInstanceMetrics and GetKubernetesClusterMetrics, by resource ID
+	Metrics map[string]ResourceMetrics
 	// Snapshots            []Snapshot
 	// Templates            []Template
 }
 
 // Clienter is the interface the real civogo.Client and civogo.FakeClient implement
 type Clienter interface {
+	DNSClienter
+	InstanceClienter
+	KubernetesClienter
+
+	// Views of the client restricted to one service
+	DNS() DNSClienter
+	Instances() InstanceClienter
+	Kubernetes() KubernetesClienter
+
+	// Applications
+	ListApplications(opts ...ListOptions) (*PaginatedApplications, error)
+	GetApplication(id string) (*Application, error)
+	FindApplication(search string) (*Application, error)
+	CreateApplication(config *ApplicationConfig) (*Application, error)
+	UpdateApplication(id string, application *UpdateApplicationRequest) (*Application, error)
+	DeleteApplication(id string) (*SimpleResponse, error)
+	ScaleApplicationProcess(appID, processType string, count int) (*Application, error)
+	RestartApplication(appID string) (*SimpleResponse, error)
+	SetApplicationConfigVars(appID string, vars map[string]string) (*Application, error)
+	UnsetApplicationConfigVars(appID string, names ...string) (*Application, error)
+	GetApplicationLogsURL(appID string) (string, error)
+	CreateApplicationDeployment(appID string, source DeploymentSource) (*ApplicationDeployment, error)
+	ListApplicationDeployments(appID string) ([]ApplicationDeployment, error)
+	GetApplicationDeployment(appID, deploymentID string) (*ApplicationDeployment, error)
+	RollbackApplication(appID, deploymentID string) (*ApplicationDeployment, error)
+	AddApplicationDomain(appID, domain string) (*Application, error)
+	AddApplicationDomainWithDNS(appID, domain string) (*ApplicationDomainStatus, error)
+	RemoveApplicationDomain(appID, domain string) (*Application, error)
+	GetApplicationDomainStatus(appID, domain string) (*ApplicationDomainStatus, error)
+
 	// Charges
 	ListCharges(from, to time.Time) ([]Charge, error)
 
-	// DNS
-	ListDNSDomains() ([]DNSDomain, error)
-	FindDNSDomain(search string) (*DNSDomain, error)
-	CreateDNSDomain(name string) (*DNSDomain, error)
-	GetDNSDomain(name string) (*DNSDomain, error)
-	UpdateDNSDomain(d *DNSDomain, name string) (*DNSDomain, error)
-	DeleteDNSDomain(d *DNSDomain) (*SimpleResponse, error)
-	CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecord, error)
-	ListDNSRecords(dnsDomainID string) ([]DNSRecord, error)
-	GetDNSRecord(domainID, domainRecordID string) (*DNSRecord, error)
-	UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error)
-	DeleteDNSRecord(r *DNSRecord) (*SimpleResponse, error)
+	// Databases
+	ListDatabases(opts ...ListOptions) (*PaginatedDatabases, error)
+	GetDatabase(id string) (*Database, error)
+	FindDatabase(search string) (*Database, error)
+	NewDatabase(v *CreateDatabaseRequest) (*Database, error)
+	UpdateDatabase(id string, v *UpdateDatabaseRequest) (*Database, error)
+	DeleteDatabase(id string) (*SimpleResponse, error)
+	RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleResponse, error)
+	CreateDatabase(config *DatabaseConfig) (*Database, error)
+	GetDatabaseCredentials(id string) (*DatabaseCredentials, error)
+	ListDBVersions() (map[string][]SupportedSoftwareVersion, error)
+	ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error)
+	GetDatabaseBackup(dbid, id string) (*DatabaseBackup, error)
+	FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error)
+	CreateDatabaseBackup(did string, v *DatabaseBackupCreateRequest) (*DatabaseBackup, error)
+	UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error)
+	DeleteDatabaseBackup(dbid, id string) (*SimpleResponse, error)
+	UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error)
+	RestoreDatabaseToPointInTime(did string, timestamp time.Time) (*SimpleResponse, error)
 
 	// Firewalls
 	ListFirewalls() ([]Firewall, error)
 	FindFirewall(search string) (*Firewall, error)
 	NewFirewall(*FirewallConfig) (*FirewallResult, error)
+	CreateFirewall(*FirewallConfig) (*FirewallResult, error)
 	RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse, error)
 	DeleteFirewall(id string) (*SimpleResponse, error)
 	NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error)
 	ListFirewallRules(id string) ([]FirewallRule, error)
 	FindFirewallRule(firewallID string, search string) (*FirewallRule, error)
 	DeleteFirewallRule(id string, ruleID string) (*SimpleResponse, error)
+	SyncFirewallRules(firewallID string, desired []FirewallRuleConfig) (*FirewallRuleSyncResult, error)
 
-	// Instances
-	ListInstances(page int, perPage int) (*PaginatedInstanceList, error)
-	ListAllInstances() ([]Instance, error)
-	FindInstance(search string) (*Instance, error)
-	GetInstance(id string) (*Instance, error)
-	NewInstanceConfig() (*InstanceConfig, error)
-	CreateInstance(config *InstanceConfig) (*Instance, error)
-	SetInstanceTags(i *Instance, tags string) (*SimpleResponse, error)
-	UpdateInstance(i *Instance) (*SimpleResponse, error)
-	DeleteInstance(id string) (*SimpleResponse, error)
-	RebootInstance(id string) (*SimpleResponse, error)
-	HardRebootInstance(id string) (*SimpleResponse, error)
-	SoftRebootInstance(id string) (*SimpleResponse, error)
-	StopInstance(id string) (*SimpleResponse, error)
-	StartInstance(id string) (*SimpleResponse, error)
-	GetInstanceConsoleURL(id string) (string, error)
-	UpgradeInstance(id, newSize string) (*SimpleResponse, error)
-	MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error)
-	SetInstanceFirewall(id, firewallID string) (*SimpleResponse, error)
+	// Labels
+	MergeLabels(resource LabelledResource, id string, changes map[string]string) (map[string]string, error)
+	ReplaceLabels(resource LabelledResource, id string, labels map[string]string) (map[string]string, error)
 
 	// Instance sizes
 	ListInstanceSizes() ([]InstanceSize, error)
 	FindInstanceSizes(search string) (*InstanceSize, error)
-
-	// Clusters
-	ListKubernetesClusters() (*PaginatedKubernetesClusters, error)
-	FindKubernetesCluster(search string) (*KubernetesCluster, error)
-	NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error)
-	GetKubernetesCluster(id string) (*KubernetesCluster, error)
-	UpdateKubernetesCluster(id string, i *KubernetesClusterConfig) (*KubernetesCluster, error)
-	ListKubernetesMarketplaceApplications() ([]KubernetesMarketplaceApplication, error)
-	DeleteKubernetesCluster(id string) (*SimpleResponse, error)
-	RecycleKubernetesCluster(id string, hostname string) (*SimpleResponse, error)
-	ListAvailableKubernetesVersions() ([]KubernetesVersion, error)
-	ListKubernetesClusterInstances(id string) ([]Instance, error)
-	FindKubernetesClusterInstance(clusterID, search string) (*Instance, error)
-
-	//Pools
-	ListKubernetesClusterPools(cid string) ([]KubernetesPool, error)
-	GetKubernetesClusterPool(cid, pid string) (*KubernetesPool, error)
-	FindKubernetesClusterPool(cid, search string) (*KubernetesPool, error)
-	DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*SimpleResponse, error)
-	UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error)
+	SizesForType(sizeType string) ([]InstanceSize, error)
+	FindSizeByResources(minCPU, minRAMMB int) (*InstanceSize, error)
+	ListGPUTypes() ([]GPUType, error)
+	GPUSizes(gpuType string, minCount int) ([]InstanceSize, error)
 
 	// Networks
 	GetDefaultNetwork() (*Network, error)
@@ -119,12 +152,26 @@ type Clienter interface {
 	FindNetwork(search string) (*Network, error)
 	RenameNetwork(label, id string) (*NetworkResult, error)
 	DeleteNetwork(id string) (*SimpleResponse, error)
+	GetNetwork(id string) (*Network, error)
+	UpdateNetwork(id string, nc NetworkConfig) (*NetworkResult, error)
+	ListSubnets(networkID string) ([]Subnet, error)
+	GetSubnet(networkID, subnetID string) (*Subnet, error)
+	FindSubnet(search, networkID string) (*Subnet, error)
+	CreateSubnet(networkID string, subnet SubnetConfig) (*Subnet, error)
+	AttachInstanceToNetwork(instanceID, networkID string) (*NetworkAttachment, error)
+	ListInstanceNetworkAttachments(instanceID string) ([]NetworkAttachment, error)
+	GetInstanceNetworkAttachment(instanceID, networkID string) (*NetworkAttachment, error)
+	DetachInstanceFromNetwork(instanceID, networkID string) (*SimpleResponse, error)
+	DeleteSubnet(networkID, subnetID string) (*SimpleResponse, error)
 
 	// Quota
 	GetQuota() (*Quota, error)
+	CheckQuotaFor(config *InstanceConfig) error
 
 	// Regions
 	ListRegions() ([]Region, error)
+	FindRegion(search string) (*Region, error)
+	GetDefaultRegion() (*Region, error)
 	CreateRegion(r *CreateRegionRequest) (*Region, error)
 	ConnectRegion(r *ConnectRegionRequest) error
 	DisconnectRegion(r *DisconnectRegionRequest) error
@@ -138,6 +185,7 @@ type Clienter interface {
 	// SSHKeys
 	ListSSHKeys() ([]SSHKey, error)
 	NewSSHKey(name string, publicKey string) (*SimpleResponse, error)
+	CreateSSHKey(name string, publicKey string) (*SSHKey, error)
 	UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error)
 	FindSSHKey(search string) (*SSHKey, error)
 	DeleteSSHKey(id string) (*SimpleResponse, error)
@@ -154,16 +202,103 @@ type Clienter interface {
 	ListDiskImages() ([]DiskImage, error)
 	GetDiskImage(id string) (*DiskImage, error)
 	FindDiskImage(search string) (*DiskImage, error)
+	GetDiskImageByName(name string) (*DiskImage, error)
+	GetMostRecentDistro(name string) (*DiskImage, error)
+	NewDiskImage(config *DiskImageConfig) (*DiskImageUpload, error)
+
+	// Kubeflow clusters
+	ListKfClusters(opts ...ListOptions) (*PaginatedKfClusters, error)
+	GetKfCluster(id string) (*KfCluster, error)
+	FindKfCluster(search string) (*KfCluster, error)
+	CreateKfCluster(req CreateKfClusterReq) (*KfCluster, error)
+	UpdateKfCluster(id string, kfc *UpdateKfClusterReq) (*KfCluster, error)
+	DeleteKfCluster(id string) (*SimpleResponse, error)
+
+	// Object stores
+	ListObjectStores(opts ...ListOptions) (*PaginatedObjectstores, error)
+	GetObjectStore(id string) (*ObjectStore, error)
+	FindObjectStore(search string) (*ObjectStore, error)
+	NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error)
+	CreateObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error)
+	ResizeObjectStore(id string, maxSizeGB int64) (*ObjectStore, error)
+	UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error)
+	DeleteObjectStore(id string) (*SimpleResponse, error)
+	GetObjectStoreStats(id string) (*ObjectStoreStats, error)
+	GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error)
+	ListObjectStoreCredentials(page, perPage int) (*PaginatedObjectStoreCredentials, error)
+	GetObjectStoreCredential(id string) (*ObjectStoreCredential, error)
+	FindObjectStoreCredential(search string) (*ObjectStoreCredential, error)
+	NewObjectStoreCredential(v *CreateObjectStoreCredentialRequest) (*ObjectStoreCredential, error)
+	CreateObjectStoreCredential(name string) (*ObjectStoreCredential, error)
+	GetObjectStoreCredentialKeys(id string) (accessKeyID, secretAccessKey string, err error)
+	UpdateObjectStoreCredential(id string, v *UpdateObjectStoreCredentialRequest) (*ObjectStoreCredential, error)
+	DeleteObjectStoreCredential(id string) (*SimpleResponse, error)
+
+	// Account and API keys
+	GetAccount() (*Account, error)
+	ListAPIKeys() ([]APIKey, error)
+	CreateAPIKey(name string) (*APIKey, error)
+	RevokeAPIKey(id string) (*SimpleResponse, error)
+
+	// Organisations, teams and roles
+	GetOrganisation() (*Organisation, error)
+	CreateOrganisation(name string) (*Organisation, error)
+	RenameOrganisation(name string) (*Organisation, error)
+	AddAccountToOrganisation(organisationID, organisationToken string) ([]Account, error)
+	ListAccountsInOrganisation() ([]Account, error)
+	ListTeams() ([]Team, error)
+	FindTeam(search string) (*Team, error)
+	CreateTeam(name string) (*Team, error)
+	RenameTeam(teamID, name string) (*Team, error)
+	DeleteTeam(id string) (*SimpleResponse, error)
+	ListTeamMembers(teamID string) ([]TeamMember, error)
+	AddTeamMember(teamID, userID, permissions, roles string) ([]TeamMember, error)
+	UpdateTeamMember(teamID, teamMemberID, permissions, roles string) (*TeamMember, error)
+	RemoveTeamMember(teamID, teamMemberID string) (*SimpleResponse, error)
+	ListRoles() ([]Role, error)
+	CreateRole(name, permissions string) (*Role, error)
+	CreateRoleWithPermissions(name string, permissions []string) (*Role, error)
+	DeleteRole(id string) (*SimpleResponse, error)
+	ListPermissions() ([]Permission, error)
 
 	// Volumes
 	ListVolumes() ([]Volume, error)
 	GetVolume(id string) (*Volume, error)
 	FindVolume(search string) (*Volume, error)
 	NewVolume(v *VolumeConfig) (*VolumeResult, error)
+	CreateVolume(v *VolumeConfig) (*VolumeResult, error)
 	ResizeVolume(id string, size int) (*SimpleResponse, error)
 	AttachVolume(id string, cfg VolumeAttachConfig) (*SimpleResponse, error)
 	DetachVolume(id string) (*SimpleResponse, error)
 	DeleteVolume(id string) (*SimpleResponse, error)
+	ListVolumesForCluster(clusterID string) ([]Volume, error)
+	ListDanglingVolumes() ([]Volume, error)
+	ListVolumeTypes() ([]VolumeType, error)
+
+	// Instance snapshots
+	CreateInstanceSnapshot(instanceID, name string) (*InstanceSnapshot, error)
+	ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error)
+	GetInstanceSnapshot(id string) (*InstanceSnapshot, error)
+	DeleteInstanceSnapshot(id string) (*SimpleResponse, error)
+	PromoteSnapshotToDiskImage(snapshotID string) (*DiskImage, error)
+
+	// Volume snapshots
+	CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotConfig) (*VolumeSnapshot, error)
+	ListVolumeSnapshots() ([]VolumeSnapshot, error)
+	ListVolumeSnapshotsByVolumeID(volumeID string) ([]VolumeSnapshot, error)
+	GetVolumeSnapshot(id string) (*VolumeSnapshot, error)
+	DeleteVolumeSnapshot(id string) (*SimpleResponse, error)
+	CreateSnapshotSchedule(config *SnapshotScheduleConfig) (*SnapshotSchedule, error)
+	ListSnapshotSchedules() ([]SnapshotSchedule, error)
+	GetSnapshotSchedule(id string) (*SnapshotSchedule, error)
+	DeleteSnapshotSchedule(id string) (*SimpleResponse, error)
+
+	// Placement groups
+	CreatePlacementGroup(config *PlacementGroupConfig) (*PlacementGroup, error)
+	ListPlacementGroups() ([]PlacementGroup, error)
+	GetPlacementGroup(id string) (*PlacementGroup, error)
+	FindPlacementGroup(search string) (*PlacementGroup, error)
+	DeletePlacementGroup(id string) (*SimpleResponse, error)
 
 	// Webhooks
 	CreateWebhook(r *WebhookConfig) (*Webhook, error)
@@ -173,14 +308,16 @@ type Clienter interface {
 	DeleteWebhook(id string) (*SimpleResponse, error)
 
 	// Reserved IPs
-	ListIPs() (*PaginatedIPs, error)
+	ListIPs(opts ...ListOptions) (*PaginatedIPs, error)
 	FindIP(search string) (*IP, error)
 	GetIP(id string) (*IP, error)
 	NewIP(v *CreateIPRequest) (*IP, error)
+	CreateIP(name string) (*IP, error)
 	UpdateIP(id string, v *UpdateIPRequest) (*IP, error)
 	DeleteIP(id string) (*SimpleResponse, error)
 	AssignIP(id, resourceID, resourceType, region string) (*SimpleResponse, error)
 	UnassignIP(id, region string) (*SimpleResponse, error)
+	ReassignIP(id, resourceID, resourceType string) (*SimpleResponse, error)
 
 	// LoadBalancer
 	ListLoadBalancers() ([]LoadBalancer, error)
@@ -189,6 +326,13 @@ type Clienter interface {
 	CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error)
 	UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error)
 	DeleteLoadBalancer(id string) (*SimpleResponse, error)
+	AddLoadBalancerBackend(id string, backend *LoadBalancerBackendConfig) (*LoadBalancer, error)
+	RemoveLoadBalancerBackend(id, ipOrInstanceID string) (*LoadBalancer, error)
+	GetLoadBalancerBackendHealth(lbID string) ([]LoadBalancerBackendHealth, error)
+	UploadLoadBalancerCertificate(lbID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error)
+	ListLoadBalancerCertificates(lbID string) ([]LoadBalancerCertificate, error)
+	RotateLoadBalancerCertificate(lbID, certificateID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error)
+	DeleteLoadBalancerCertificate(lbID, certificateID string) (*SimpleResponse, error)
 
 	// Ping
 	Ping() error
@@ -211,19 +355,25 @@ func NewFakeClient() (*FakeClient, error) {
 		},
 		InstanceSizes: []InstanceSize{
 			{
+				Type:          InstanceSizeTypeInstance,
 				Name:          "g3.xsmall",
+				Selectable:    true,
 				CPUCores:      1,
 				RAMMegabytes:  1024,
 				DiskGigabytes: 10,
 			},
 			{
+				Type:          InstanceSizeTypeInstance,
 				Name:          "g3.small",
+				Selectable:    true,
 				CPUCores:      2,
 				RAMMegabytes:  2048,
 				DiskGigabytes: 20,
 			},
 			{
+				Type:          InstanceSizeTypeInstance,
 				Name:          "g3.medium",
+				Selectable:    true,
 				CPUCores:      4,
 				RAMMegabytes:  4096,
 				DiskGigabytes: 40,
@@ -291,7 +441,14 @@ func (c *FakeClient) generatePublicIP() string {
 
 // ListCharges implemented in a fake way for automated tests
 func (c *FakeClient) ListCharges(from, to time.Time) ([]Charge, error) {
-	return []Charge{}, nil
+	charges := []Charge{}
+	for _, charge := range c.Charges {
+		if charge.To.After(from) && charge.From.Before(to) {
+			charges = append(charges, charge)
+		}
+	}
+
+	return charges, nil
 }
 
 // ListDNSDomains implemented in a fake way for automated tests
@@ -303,7 +460,7 @@ func (c *FakeClient) ListDNSDomains() ([]DNSDomain, error) {
 func (c *FakeClient) FindDNSDomain(search string) (*DNSDomain, error) {
 	for _, domain := range c.Domains {
 		if strings.Contains(domain.Name, search) {
-			return &domain, nil
+			return domain.Clone(), nil
 		}
 	}
 
@@ -325,7 +482,7 @@ func (c *FakeClient) CreateDNSDomain(name string) (*DNSDomain, error) {
 func (c *FakeClient) GetDNSDomain(name string) (*DNSDomain, error) {
 	for _, domain := range c.Domains {
 		if domain.Name == name {
-			return &domain, nil
+			return domain.Clone(), nil
 		}
 	}
 
@@ -344,6 +501,22 @@ func (c *FakeClient) UpdateDNSDomain(d *DNSDomain, name string) (*DNSDomain, err
 	return nil, ErrDNSDomainNotFound
 }
 
+// SetDomainDefaultTTL implemented in a fake way for automated tests
+func (c *FakeClient) SetDomainDefaultTTL(domainID string, ttl int) (*DNSDomain, error) {
+	if err := validateDNSTTL(ttl); err != nil {
+		return nil, DNSRecordInvalidError.wrap(err)
+	}
+
+	for i, domain := range c.Domains {
+		if domain.ID == domainID {
+			c.Domains[i].DefaultTTL = ttl
+			return c.Domains[i].Clone(), nil
+		}
+	}
+
+	return nil, ErrDNSDomainNotFound
+}
+
 // DeleteDNSDomain implemented in a fake way for automated tests
 func (c *FakeClient) DeleteDNSDomain(d *DNSDomain) (*SimpleResponse, error) {
 	for i, domain := range c.Domains {
@@ -365,6 +538,10 @@ func (c *FakeClient) CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSR
 		Name:        r.Name,
 		Value:       r.Value,
 		Type:        r.Type,
+		Priority:    r.Priority,
+		Weight:      r.Weight,
+		Port:        r.Port,
+		TTL:         r.TTL,
 	}
 
 	c.DomainRecords = append(c.DomainRecords, record)
@@ -380,13 +557,31 @@ func (c *FakeClient) ListDNSRecords(dnsDomainID string) ([]DNSRecord, error) {
 func (c *FakeClient) GetDNSRecord(domainID, domainRecordID string) (*DNSRecord, error) {
 	for _, record := range c.DomainRecords {
 		if record.ID == domainRecordID && record.DNSDomainID == domainID {
-			return &record, nil
+			return record.Clone(), nil
 		}
 	}
 
 	return nil, ErrDNSRecordNotFound
 }
 
+// ListDNSRecordsByType implemented in a fake way for automated tests
+func (c *FakeClient) ListDNSRecordsByType(domainID string, t DNSRecordType) ([]DNSRecord, error) {
+	records := []DNSRecord{}
+	for _, record := range c.DomainRecords {
+		if record.DNSDomainID == domainID {
+			records = append(records, record)
+		}
+	}
+
+	return filterDNSRecordsByType(records, t), nil
+}
+
+// FindDNSRecordByTypeAndName implemented in a fake way for automated tests
+func (c *FakeClient) FindDNSRecordByTypeAndName(domainID string, t DNSRecordType, name string) (*DNSRecord, error) {
+	records, _ := c.ListDNSRecordsByType(domainID, t)
+	return findDNSRecordByName(records, t, name)
+}
+
 // UpdateDNSRecord implemented in a fake way for automated tests
 func (c *FakeClient) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error) {
 	for i, record := range c.DomainRecords {
@@ -397,10 +592,14 @@ func (c *FakeClient) UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRec
 				Name:        rc.Name,
 				Value:       rc.Value,
 				Type:        rc.Type,
+				Priority:    rc.Priority,
+				Weight:      rc.Weight,
+				Port:        rc.Port,
+				TTL:         rc.TTL,
 			}
 
 			c.DomainRecords[i] = record
-			return &record, nil
+			return record.Clone(), nil
 		}
 	}
 
@@ -420,6 +619,91 @@ func (c *FakeClient) DeleteDNSRecord(r *DNSRecord) (*SimpleResponse, error) {
 	return nil, ErrDNSRecordNotFound
 }
 
+// CreateDNSRecords implemented in a fake way for automated tests
+func (c *FakeClient) CreateDNSRecords(domainID string, records []DNSRecordConfig) ([]DNSRecordResult, error) {
+	results := make([]DNSRecordResult, len(records))
+	for i := range records {
+		record, _ := c.CreateDNSRecord(domainID, &records[i])
+		results[i] = DNSRecordResult{ID: record.ID, Record: record}
+	}
+
+	return results, nil
+}
+
+// DeleteDNSRecords implemented in a fake way for automated tests
+func (c *FakeClient) DeleteDNSRecords(domainID string, ids []string) ([]DNSRecordResult, error) {
+	results := make([]DNSRecordResult, len(ids))
+	failed := 0
+	for i, id := range ids {
+		_, err := c.DeleteDNSRecord(&DNSRecord{ID: id, DNSDomainID: domainID})
+		if err != nil {
+			failed++
+		}
+		results[i] = DNSRecordResult{ID: id, Error: err}
+	}
+
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d records failed", failed, len(ids))
+		return results, DNSBulkOperationFailedError.wrap(err)
+	}
+
+	return results, nil
+}
+
+// UpdateAllRecordsTTL implemented in a fake way for automated tests
+func (c *FakeClient) UpdateAllRecordsTTL(domainID string, ttl int) ([]DNSRecordResult, error) {
+	if err := validateDNSTTL(ttl); err != nil {
+		return nil, DNSRecordInvalidError.wrap(err)
+	}
+
+	results := []DNSRecordResult{}
+	for i, record := range c.DomainRecords {
+		if record.DNSDomainID == domainID && record.TTL != ttl {
+			c.DomainRecords[i].TTL = ttl
+			results = append(results, DNSRecordResult{ID: record.ID, Record: c.DomainRecords[i].Clone()})
+		}
+	}
+
+	return results, nil
+}
+
+// SetDNSRecordValues implemented in a fake way for automated tests
+func (c *FakeClient) SetDNSRecordValues(domainID, name string, t DNSRecordType, values []string) (*DNSRecordSyncResult, error) {
+	return setDNSRecordValues(c, domainID, name, t, values)
+}
+
+// ExportDNSZone implemented in a fake way for automated tests
+func (c *FakeClient) ExportDNSZone(domainID string) (string, error) {
+	for _, domain := range c.Domains {
+		if domain.ID == domainID {
+			records := []DNSRecord{}
+			for _, record := range c.DomainRecords {
+				if record.DNSDomainID == domainID {
+					records = append(records, record)
+				}
+			}
+			return FormatDNSZone(domain.Name, records), nil
+		}
+	}
+
+	return "", ErrDNSDomainNotFound
+}
+
+// ImportDNSZone implemented in a fake way for automated tests
+func (c *FakeClient) ImportDNSZone(domainID string, zonefile io.Reader) ([]DNSRecordResult, error) {
+	for _, domain := range c.Domains {
+		if domain.ID == domainID {
+			records, err := ParseDNSZone(domain.Name, zonefile)
+			if err != nil {
+				return nil, err
+			}
+			return c.CreateDNSRecords(domainID, records)
+		}
+	}
+
+	return nil, ErrDNSDomainNotFound
+}
+
 // ListFirewalls implemented in a fake way for automated tests
 func (c *FakeClient) ListFirewalls() ([]Firewall, error) {
 	return c.Firewalls, nil
@@ -427,14 +711,7 @@ func (c *FakeClient) ListFirewalls() ([]Firewall, error) {
 
 // FindFirewall implemented in a fake way for automated tests
 func (c *FakeClient) FindFirewall(search string) (*Firewall, error) {
-	for _, firewall := range c.Firewalls {
-		if strings.Contains(firewall.Name, search) {
-			return &firewall, nil
-		}
-	}
-
-	err := fmt.Errorf("unable to find %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	return findMatch(c.Firewalls, search, false, func(f Firewall) (string, []string) { return f.ID, []string{f.Name} })
 }
 
 // NewFirewall implemented in a fake way for automated tests
@@ -452,6 +729,28 @@ func (c *FakeClient) NewFirewall(*FirewallConfig) (*FirewallResult, error) {
 	}, nil
 }
 
+// CreateFirewall implemented in a fake way for automated tests
+func (c *FakeClient) CreateFirewall(config *FirewallConfig) (*FirewallResult, error) {
+	if err := validateFirewallConfig(config); err != nil {
+		return nil, err
+	}
+
+	firewall := Firewall{
+		ID:         c.generateID(),
+		Name:       config.Name,
+		NetworkID:  config.NetworkID,
+		Rules:      config.Rules,
+		RulesCount: len(config.Rules),
+	}
+	c.Firewalls = append(c.Firewalls, firewall)
+
+	return &FirewallResult{
+		ID:     firewall.ID,
+		Name:   firewall.Name,
+		Result: "success",
+	}, nil
+}
+
 // RenameFirewall implemented in a fake way for automated tests
 func (c *FakeClient) RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse, error) {
 	for i, firewall := range c.Firewalls {
@@ -480,13 +779,21 @@ func (c *FakeClient) DeleteFirewall(id string) (*SimpleResponse, error) {
 
 // NewFirewallRule implemented in a fake way for automated tests
 func (c *FakeClient) NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	rule := FirewallRule{
-		ID:        c.generateID(),
-		Protocol:  r.Protocol,
-		StartPort: r.StartPort,
-		EndPort:   r.EndPort,
-		Cidr:      r.Cidr,
-		Label:     r.Label,
+		ID:         c.generateID(),
+		FirewallID: r.FirewallID,
+		Protocol:   r.Protocol,
+		StartPort:  r.StartPort,
+		EndPort:    r.EndPort,
+		Cidr:       r.Cidr,
+		Direction:  r.Direction,
+		Action:     r.Action,
+		Label:      r.Label,
+		Ports:      r.Ports,
 	}
 	c.FirewallRules = append(c.FirewallRules, rule)
 	return &rule, nil
@@ -501,7 +808,7 @@ func (c *FakeClient) ListFirewallRules(id string) ([]FirewallRule, error) {
 func (c *FakeClient) FindFirewallRule(firewallID string, search string) (*FirewallRule, error) {
 	for _, rule := range c.FirewallRules {
 		if rule.FirewallID == firewallID && strings.Contains(rule.Label, search) {
-			return &rule, nil
+			return rule.Clone(), nil
 		}
 	}
 
@@ -522,38 +829,94 @@ func (c *FakeClient) DeleteFirewallRule(id string, ruleID string) (*SimpleRespon
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
+// SyncFirewallRules implemented in a fake way for automated tests
+func (c *FakeClient) SyncFirewallRules(firewallID string, desired []FirewallRuleConfig) (*FirewallRuleSyncResult, error) {
+	for i := range desired {
+		if err := desired[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	existing := []FirewallRule{}
+	for _, rule := range c.FirewallRules {
+		if rule.FirewallID == firewallID {
+			existing = append(existing, rule)
+		}
+	}
+
+	toCreate, toDelete, unchanged := diffFirewallRules(existing, desired)
+	result := &FirewallRuleSyncResult{Unchanged: unchanged}
+	for _, config := range toCreate {
+		config.FirewallID = firewallID
+		rule, _ := c.NewFirewallRule(&config)
+		result.Created = append(result.Created, *rule)
+	}
+	for _, rule := range toDelete {
+		c.DeleteFirewallRule(firewallID, rule.ID)
+		result.Deleted = append(result.Deleted, rule)
+	}
+
+	return result, nil
+}
+
 // ListInstances implemented in a fake way for automated tests
 func (c *FakeClient) ListInstances(page int, perPage int) (*PaginatedInstanceList, error) {
 	return &PaginatedInstanceList{
-		Items:   c.Instances,
+		Items:   c.InstanceList,
 		Page:    page,
 		PerPage: perPage,
 		Pages:   page,
 	}, nil
 }
 
-// ListAllInstances implemented in a fake way for automated tests
-func (c *FakeClient) ListAllInstances() ([]Instance, error) {
-	return c.Instances, nil
+// ListInstancesWithOptions implemented in a fake way for automated tests, the options
+// filter the instances but every match is returned in a single page
+func (c *FakeClient) ListInstancesWithOptions(opts ListOptions) (*PaginatedInstanceList, error) {
+	instances, _ := c.ListAllInstances(opts)
+	return &PaginatedInstanceList{
+		Items:   instances,
+		Page:    1,
+		PerPage: len(instances),
+		Pages:   1,
+	}, nil
 }
 
-// FindInstance implemented in a fake way for automated tests
-func (c *FakeClient) FindInstance(search string) (*Instance, error) {
-	for _, instance := range c.Instances {
-		if strings.Contains(instance.Hostname, search) {
-			return &instance, nil
+// ListAllInstances implemented in a fake way for automated tests
+func (c *FakeClient) ListAllInstances(opts ...ListOptions) ([]Instance, error) {
+	if len(opts) == 0 {
+		return c.InstanceList, nil
+	}
+
+	o := opts[len(opts)-1]
+	instances := []Instance{}
+	for _, instance := range c.InstanceList {
+		if o.Search != "" && !strings.Contains(strings.ToLower(instance.Hostname), strings.ToLower(o.Search)) {
+			continue
+		}
+		if o.Tag != "" && !hasTag(instance.Tags, o.Tag) {
+			continue
 		}
+		instances = append(instances, instance)
 	}
 
-	err := fmt.Errorf("unable to find %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	return instances, nil
+}
+
+// ListInstancesAllRegions implemented in a fake way for automated tests
+func (c *FakeClient) ListInstancesAllRegions() ([]Instance, error) {
+	return c.ListAllInstances()
+}
+
+// FindInstance implemented in a fake way for automated tests
+func (c *FakeClient) FindInstance(search string) (*Instance, error) {
+	return findMatch(c.InstanceList, search, false, func(i Instance) (string, []string) { return i.ID, []string{i.Hostname} })
 }
 
 // GetInstance implemented in a fake way for automated tests
 func (c *FakeClient) GetInstance(id string) (*Instance, error) {
-	for _, instance := range c.Instances {
+	for _, instance := range c.InstanceList {
 		if instance.ID == id {
-			return &instance, nil
+			return instance.Clone(), nil
 		}
 	}
 
@@ -568,26 +931,67 @@ func (c *FakeClient) NewInstanceConfig() (*InstanceConfig, error) {
 
 // CreateInstance implemented in a fake way for automated tests
 func (c *FakeClient) CreateInstance(config *InstanceConfig) (*Instance, error) {
+	group := -1
+	if config.PlacementGroupID != "" {
+		for i := range c.PlacementGroups {
+			if c.PlacementGroups[i].ID == config.PlacementGroupID {
+				group = i
+			}
+		}
+		if group < 0 {
+			err := fmt.Errorf("unable to find placement group %s, zero matches", config.PlacementGroupID)
+			return nil, ZeroMatchesError.wrap(err)
+		}
+	}
+
 	instance := Instance{
-		ID:          c.generateID(),
-		Hostname:    config.Hostname,
-		Size:        config.Size,
-		Region:      config.Region,
-		TemplateID:  config.TemplateID,
-		InitialUser: config.InitialUser,
-		SSHKey:      config.SSHKeyID,
-		Tags:        config.Tags,
-		PublicIP:    c.generatePublicIP(),
-	}
-	c.Instances = append(c.Instances, instance)
+		ID:               c.generateID(),
+		Hostname:         config.Hostname,
+		Size:             config.Size,
+		Region:           config.Region,
+		TemplateID:       config.TemplateID,
+		InitialUser:      config.InitialUser,
+		SSHKey:           config.SSHKeyID,
+		Tags:             config.Tags,
+		Script:           decodeUserData(config.Script),
+		Labels:           config.Labels,
+		GPUCount:         config.GPUCount,
+		GPUType:          config.GPUType,
+		PublicIP:         c.generatePublicIP(),
+		PlacementGroupID: config.PlacementGroupID,
+	}
+	if group >= 0 {
+		c.PlacementGroups[group].InstanceIDs = append(c.PlacementGroups[group].InstanceIDs, instance.ID)
+	}
+	c.InstanceList = append(c.InstanceList, instance)
 	return &instance, nil
 }
 
+// CreateInstances implemented in a fake way for automated tests
+func (c *FakeClient) CreateInstances(template InstanceConfig, count int, namePattern string) ([]InstanceResult, error) {
+	if err := validateBulkInstances(&template, count, namePattern); err != nil {
+		return nil, err
+	}
+
+	results := make([]InstanceResult, count)
+	for i := range results {
+		config := template
+		config.Count = 1
+		config.Hostname = fmt.Sprintf(namePattern, i+1)
+		config.Tags = cloneSlice(template.Tags)
+		config.Labels = cloneMap(template.Labels)
+		instance, err := c.CreateInstance(&config)
+		results[i] = InstanceResult{ID: instance.ID, Hostname: config.Hostname, Instance: instance, Error: err}
+	}
+
+	return results, bulkInstancesError(results)
+}
+
 // SetInstanceTags implemented in a fake way for automated tests
 func (c *FakeClient) SetInstanceTags(i *Instance, tags string) (*SimpleResponse, error) {
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.ID == i.ID {
-			c.Instances[idx].Tags = strings.Split(tags, " ")
+			c.InstanceList[idx].Tags = strings.Split(tags, " ")
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -595,11 +999,63 @@ func (c *FakeClient) SetInstanceTags(i *Instance, tags string) (*SimpleResponse,
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
+// ListInstancesByTag implemented in a fake way for automated tests
+func (c *FakeClient) ListInstancesByTag(tags ...string) ([]Instance, error) {
+	return filterTagged(c.InstanceList, TagFilter(tags), func(i Instance) []string { return i.Tags }), nil
+}
+
+// TagInstance implemented in a fake way for automated tests
+func (c *FakeClient) TagInstance(id string, tags []string) (*SimpleResponse, error) {
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(addTags(instance.Tags, tags), " "))
+}
+
+// UntagInstance implemented in a fake way for automated tests
+func (c *FakeClient) UntagInstance(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(removeTags(instance.Tags, tags), " "))
+}
+
+// SetInstanceUserData implemented in a fake way for automated tests
+func (c *FakeClient) SetInstanceUserData(instanceID string, script string) (*SimpleResponse, error) {
+	for idx, instance := range c.InstanceList {
+		if instance.ID == instanceID {
+			c.InstanceList[idx].Script = decodeUserData(script)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", instanceID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// GetInstanceUserData implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceUserData(instanceID string) (string, error) {
+	instance, err := c.GetInstance(instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.Script, nil
+}
+
 // UpdateInstance implemented in a fake way for automated tests
 func (c *FakeClient) UpdateInstance(i *Instance) (*SimpleResponse, error) {
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.ID == i.ID {
-			c.Instances[idx] = *i
+			c.InstanceList[idx] = *i
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -609,10 +1065,10 @@ func (c *FakeClient) UpdateInstance(i *Instance) (*SimpleResponse, error) {
 
 // DeleteInstance implemented in a fake way for automated tests
 func (c *FakeClient) DeleteInstance(id string) (*SimpleResponse, error) {
-	for i, instance := range c.Instances {
+	for i, instance := range c.InstanceList {
 		if instance.ID == id {
-			c.Instances[len(c.Instances)-1], c.Instances[i] = c.Instances[i], c.Instances[len(c.Instances)-1]
-			c.Instances = c.Instances[:len(c.Instances)-1]
+			c.InstanceList[len(c.InstanceList)-1], c.InstanceList[i] = c.InstanceList[i], c.InstanceList[len(c.InstanceList)-1]
+			c.InstanceList = c.InstanceList[:len(c.InstanceList)-1]
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -637,12 +1093,44 @@ func (c *FakeClient) SoftRebootInstance(id string) (*SimpleResponse, error) {
 
 // StopInstance implemented in a fake way for automated tests
 func (c *FakeClient) StopInstance(id string) (*SimpleResponse, error) {
-	return &SimpleResponse{Result: "success"}, nil
+	return c.setInstanceStatus(id, string(InstanceStatusShutoff))
 }
 
 // StartInstance implemented in a fake way for automated tests
 func (c *FakeClient) StartInstance(id string) (*SimpleResponse, error) {
-	return &SimpleResponse{Result: "success"}, nil
+	return c.setInstanceStatus(id, string(InstanceStatusActive))
+}
+
+// StopInstances implemented in a fake way for automated tests
+func (c *FakeClient) StopInstances(ids []string) ([]InstanceResult, error) {
+	results := make([]InstanceResult, len(ids))
+	for i, id := range ids {
+		_, err := c.StopInstance(id)
+		results[i] = InstanceResult{ID: id, Error: err}
+	}
+
+	return results, bulkInstancesError(results)
+}
+
+// StartInstances implemented in a fake way for automated tests
+func (c *FakeClient) StartInstances(ids []string) ([]InstanceResult, error) {
+	results := make([]InstanceResult, len(ids))
+	for i, id := range ids {
+		_, err := c.StartInstance(id)
+		results[i] = InstanceResult{ID: id, Error: err}
+	}
+
+	return results, bulkInstancesError(results)
+}
+
+// StopInstancesByTag implemented in a fake way for automated tests
+func (c *FakeClient) StopInstancesByTag(tag string) ([]InstanceResult, error) {
+	instances, err := c.ListInstancesByTag(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.StopInstances(runningInstanceIDs(instances))
 }
 
 // GetInstanceConsoleURL implemented in a fake way for automated tests
@@ -650,11 +1138,69 @@ func (c *FakeClient) GetInstanceConsoleURL(id string) (string, error) {
 	return fmt.Sprintf("https://console.example.com/%s", id), nil
 }
 
+// GetInstanceInitialPassword implemented in a fake way for automated tests, revealing InitialPassword only once
+func (c *FakeClient) GetInstanceInitialPassword(id string) (*InstanceCredentials, error) {
+	for idx, instance := range c.InstanceList {
+		if instance.ID == id {
+			if instance.InitialPassword == "" {
+				err := fmt.Errorf("the password of instance %s was already revealed", id)
+				return nil, InstancePasswordUnavailableError.wrap(err)
+			}
+
+			c.InstanceList[idx].InitialPassword = ""
+			return &InstanceCredentials{User: instance.InitialUser, Password: instance.InitialPassword}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ResetInstancePassword implemented in a fake way for automated tests
+func (c *FakeClient) ResetInstancePassword(id string) (*InstanceCredentials, error) {
+	for _, instance := range c.InstanceList {
+		if instance.ID == id {
+			return &InstanceCredentials{User: instance.InitialUser, Password: c.generateID()}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// EnableRecoveryMode implemented in a fake way for automated tests
+func (c *FakeClient) EnableRecoveryMode(id string) (*SimpleResponse, error) {
+	return c.setInstanceStatus(id, string(InstanceStatusRecovery))
+}
+
+// DisableRecoveryMode implemented in a fake way for automated tests
+func (c *FakeClient) DisableRecoveryMode(id string) (*SimpleResponse, error) {
+	return c.setInstanceStatus(id, string(InstanceStatusActive))
+}
+
+// setInstanceStatus changes the status of a fake instance
+func (c *FakeClient) setInstanceStatus(id, status string) (*SimpleResponse, error) {
+	for idx, instance := range c.InstanceList {
+		if instance.ID == id {
+			c.InstanceList[idx].Status = status
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ResizeInstance implemented in a fake way for automated tests
+func (c *FakeClient) ResizeInstance(id, newSize string) (*SimpleResponse, error) {
+	return c.UpgradeInstance(id, newSize)
+}
+
 // UpgradeInstance implemented in a fake way for automated tests
 func (c *FakeClient) UpgradeInstance(id, newSize string) (*SimpleResponse, error) {
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.ID == id {
-			c.Instances[idx].Size = newSize
+			c.InstanceList[idx].Size = newSize
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -665,14 +1211,14 @@ func (c *FakeClient) UpgradeInstance(id, newSize string) (*SimpleResponse, error
 // MovePublicIPToInstance implemented in a fake way for automated tests
 func (c *FakeClient) MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error) {
 	oldIndex := -1
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.PublicIP == ipAddress {
 			oldIndex = idx
 		}
 	}
 
 	newIndex := -1
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.ID == id {
 			newIndex = idx
 		}
@@ -682,17 +1228,17 @@ func (c *FakeClient) MovePublicIPToInstance(id, ipAddress string) (*SimpleRespon
 		return &SimpleResponse{Result: "failed"}, nil
 	}
 
-	c.Instances[newIndex].PublicIP = c.Instances[oldIndex].PublicIP
-	c.Instances[oldIndex].PublicIP = ""
+	c.InstanceList[newIndex].PublicIP = c.InstanceList[oldIndex].PublicIP
+	c.InstanceList[oldIndex].PublicIP = ""
 
 	return &SimpleResponse{Result: "success"}, nil
 }
 
 // SetInstanceFirewall implemented in a fake way for automated tests
 func (c *FakeClient) SetInstanceFirewall(id, firewallID string) (*SimpleResponse, error) {
-	for idx, instance := range c.Instances {
+	for idx, instance := range c.InstanceList {
 		if instance.ID == id {
-			c.Instances[idx].FirewallID = firewallID
+			c.InstanceList[idx].FirewallID = firewallID
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -700,6 +1246,63 @@ func (c *FakeClient) SetInstanceFirewall(id, firewallID string) (*SimpleResponse
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
+// GetInstanceMetrics implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceMetrics(instanceID string, period MetricsPeriod) (*ResourceMetrics, error) {
+	return c.fakeMetrics(instanceID, period)
+}
+
+// MergeLabels implemented in a fake way for automated tests
+func (c *FakeClient) MergeLabels(resource LabelledResource, id string, changes map[string]string) (map[string]string, error) {
+	current, err := c.labelsOf(resource, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ReplaceLabels(resource, id, mergeLabels(*current, changes))
+}
+
+// ReplaceLabels implemented in a fake way for automated tests
+func (c *FakeClient) ReplaceLabels(resource LabelledResource, id string, labels map[string]string) (map[string]string, error) {
+	if err := validateLabels(labels); err != nil {
+		return nil, err
+	}
+
+	current, err := c.labelsOf(resource, id)
+	if err != nil {
+		return nil, err
+	}
+	*current = mergeLabels(labels, nil)
+
+	return *current, nil
+}
+
+// labelsOf returns where the labels of a resource are stored
+func (c *FakeClient) labelsOf(resource LabelledResource, id string) (*map[string]string, error) {
+	switch resource {
+	case LabelledInstance:
+		for i := range c.InstanceList {
+			if c.InstanceList[i].ID == id {
+				return &c.InstanceList[i].Labels, nil
+			}
+		}
+	case LabelledVolume:
+		for i := range c.Volumes {
+			if c.Volumes[i].ID == id {
+				return &c.Volumes[i].Labels, nil
+			}
+		}
+	case LabelledKubernetesCluster:
+		for i := range c.Clusters {
+			if c.Clusters[i].ID == id {
+				return &c.Clusters[i].Labels, nil
+			}
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s %s, zero matches", resource, id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // ListInstanceSizes implemented in a fake way for automated tests
 func (c *FakeClient) ListInstanceSizes() ([]InstanceSize, error) {
 	return c.InstanceSizes, nil
@@ -717,8 +1320,28 @@ func (c *FakeClient) FindInstanceSizes(search string) (*InstanceSize, error) {
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// ListGPUTypes implemented in a fake way for automated tests
+func (c *FakeClient) ListGPUTypes() ([]GPUType, error) {
+	return gpuTypes(c.InstanceSizes), nil
+}
+
+// GPUSizes implemented in a fake way for automated tests
+func (c *FakeClient) GPUSizes(gpuType string, minCount int) ([]InstanceSize, error) {
+	return filterGPUSizes(c.InstanceSizes, gpuType, minCount), nil
+}
+
+// SizesForType implemented in a fake way for automated tests
+func (c *FakeClient) SizesForType(sizeType string) ([]InstanceSize, error) {
+	return filterSizesByType(c.InstanceSizes, sizeType), nil
+}
+
+// FindSizeByResources implemented in a fake way for automated tests
+func (c *FakeClient) FindSizeByResources(minCPU, minRAMMB int) (*InstanceSize, error) {
+	return smallestSizeWith(c.InstanceSizes, minCPU, minRAMMB)
+}
+
 // ListKubernetesClusters implemented in a fake way for automated tests
-func (c *FakeClient) ListKubernetesClusters() (*PaginatedKubernetesClusters, error) {
+func (c *FakeClient) ListKubernetesClusters(opts ...ListOptions) (*PaginatedKubernetesClusters, error) {
 	return &PaginatedKubernetesClusters{
 		Items:   c.Clusters,
 		Page:    1,
@@ -727,16 +1350,14 @@ func (c *FakeClient) ListKubernetesClusters() (*PaginatedKubernetesClusters, err
 	}, nil
 }
 
+// ListKubernetesClustersByTag implemented in a fake way for automated tests
+func (c *FakeClient) ListKubernetesClustersByTag(tags ...string) ([]KubernetesCluster, error) {
+	return filterTagged(c.Clusters, TagFilter(tags), func(k KubernetesCluster) []string { return k.Tags }), nil
+}
+
 // FindKubernetesCluster implemented in a fake way for automated tests
 func (c *FakeClient) FindKubernetesCluster(search string) (*KubernetesCluster, error) {
-	for _, cluster := range c.Clusters {
-		if strings.Contains(cluster.Name, search) || cluster.ID == search {
-			return &cluster, nil
-		}
-	}
-
-	err := fmt.Errorf("unable to find %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	return findMatch(c.Clusters, search, true, func(k KubernetesCluster) (string, []string) { return k.ID, []string{k.Name} })
 }
 
 // ListKubernetesClusterInstances implemented in a fake way for automated tests
@@ -745,12 +1366,13 @@ func (c *FakeClient) ListKubernetesClusterInstances(id string) ([]Instance, erro
 		if cluster.ID == id {
 			instaces := make([]Instance, 0)
 			for _, kins := range cluster.Instances {
-				for _, instance := range c.Instances {
+				for _, instance := range c.InstanceList {
 					if instance.ID == kins.ID {
 						instaces = append(instaces, instance)
 					}
 				}
 			}
+			setInstancePools(instaces, cluster.Pools)
 			return instaces, nil
 		}
 	}
@@ -795,30 +1417,80 @@ func (c *FakeClient) FindKubernetesClusterInstance(clusterID, search string) (*I
 
 // NewKubernetesClusters implemented in a fake way for automated tests
 func (c *FakeClient) NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
+	if err := kc.Validate(); err != nil {
+		return nil, err
+	}
+	for _, pool := range kc.Pools {
+		if pool.RequestsGPU() {
+			if err := c.ValidateKubernetesGPUPools(kc.Pools); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
 	cluster := KubernetesCluster{
 		ID:             c.generateID(),
 		Name:           kc.Name,
 		MasterIP:       c.generatePublicIP(),
 		NumTargetNode:  kc.NumTargetNodes,
 		TargetNodeSize: kc.TargetNodesSize,
+		ClusterType:    kc.ClusterType,
+		NetworkID:      kc.NetworkID,
+		FirewallID:     kc.InstanceFirewall,
+		CNIPlugin:      kc.CNIPlugin,
 		Ready:          true,
-		Status:         "ACTIVE",
+		Status:         string(ClusterStatusActive),
 		Instances:      make([]KubernetesInstance, 0),
 		Pools:          make([]KubernetesPool, 0),
+		Labels:         kc.Labels,
 	}
-	pool := KubernetesPool{
-		Instances: make([]KubernetesInstance, 0),
+	if cluster.FirewallID == "" {
+		cluster.FirewallID = kc.FirewallID
 	}
-	for i := 0; i < kc.NumTargetNodes; i++ {
-		instance := KubernetesInstance{
-			ID:       c.generateID(),
-			Hostname: fmt.Sprintf("%s_pool_%d", kc.Name, i),
+	for _, app := range kc.ApplicationList() {
+		name, plan, _ := strings.Cut(app, ":")
+		cluster.InstalledApplications = append(cluster.InstalledApplications, KubernetesInstalledApplication{Name: name, Plan: plan, Installed: true})
+	}
+
+	pools := kc.Pools
+	if len(pools) == 0 {
+		pools = []KubernetesClusterPoolConfig{{Count: kc.NumTargetNodes, Size: kc.TargetNodesSize}}
+	}
+	for p, poolConfig := range pools {
+		pool := KubernetesPool{
+			ID:               poolConfig.ID,
+			Count:            poolConfig.Count,
+			Size:             poolConfig.Size,
+			Labels:           poolConfig.Labels,
+			Annotations:      poolConfig.Annotations,
+			Taints:           poolConfig.Taints,
+			PublicIPNodePool: poolConfig.PublicIPNodePool,
+			MinCount:         poolConfig.MinCount,
+			MaxCount:         poolConfig.MaxCount,
+			GPUCount:         poolConfig.GPUCount,
+			GPUType:          poolConfig.GPUType,
+			Instances:        make([]KubernetesInstance, 0),
+		}
+		for i := 0; i < poolConfig.Count; i++ {
+			hostname := fmt.Sprintf("%s_pool_%d", kc.Name, i)
+			if p > 0 {
+				hostname = fmt.Sprintf("%s_pool%d_%d", kc.Name, p, i)
+			}
+			instance := KubernetesInstance{
+				ID:       c.generateID(),
+				Hostname: hostname,
+				Size:     poolConfig.Size,
+				GPUCount: poolConfig.GPUCount,
+				GPUType:  poolConfig.GPUType,
+			}
+			pool.Instances = append(pool.Instances, instance)
+			pool.InstanceNames = append(pool.InstanceNames, hostname)
+			cluster.Instances = append(cluster.Instances, instance)
 		}
-		pool.Instances = append(pool.Instances, instance)
-		cluster.Instances = append(pool.Instances, instance)
+		cluster.Pools = append(cluster.Pools, pool)
 	}
 
-	cluster.Pools = append(cluster.Pools, pool)
 	c.Clusters = append(c.Clusters, cluster)
 	return &cluster, nil
 }
@@ -827,7 +1499,7 @@ func (c *FakeClient) NewKubernetesClusters(kc *KubernetesClusterConfig) (*Kubern
 func (c *FakeClient) GetKubernetesCluster(id string) (*KubernetesCluster, error) {
 	for _, cluster := range c.Clusters {
 		if cluster.ID == id {
-			return &cluster, nil
+			return cluster.Clone(), nil
 		}
 	}
 
@@ -835,14 +1507,39 @@ func (c *FakeClient) GetKubernetesCluster(id string) (*KubernetesCluster, error)
 	return nil, ZeroMatchesError.wrap(err)
 }
 
-// UpdateKubernetesCluster implemented in a fake way for automated tests
-func (c *FakeClient) UpdateKubernetesCluster(id string, kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
+// GetKubernetesKubeconfig implemented in a fake way for automated tests
+func (c *FakeClient) GetKubernetesKubeconfig(clusterID string) (string, error) {
+	cluster, err := c.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	if cluster.KubeConfig == "" {
+		err := fmt.Errorf("cluster %s has no kubeconfig yet", cluster.Name)
+		return "", KubernetesKubeconfigUnavailableError.wrap(err)
+	}
+
+	return cluster.KubeConfig, nil
+}
+
+// MergeKubeconfig implemented in a fake way for automated tests
+func (c *FakeClient) MergeKubeconfig(clusterID, path string) (string, error) {
+	incoming, err := c.GetKubernetesKubeconfig(clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	return mergeKubeconfigFile(path, incoming)
+}
+
+// UpdateKubernetesCluster implemented in a fake way for automated tests
+func (c *FakeClient) UpdateKubernetesCluster(id string, kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
 	for i, cluster := range c.Clusters {
 		if cluster.ID == id {
 			c.Clusters[i].Name = kc.Name
 			c.Clusters[i].NumTargetNode = kc.NumTargetNodes
 			c.Clusters[i].TargetNodeSize = kc.TargetNodesSize
-			return &cluster, nil
+			return cluster.Clone(), nil
 		}
 	}
 
@@ -855,6 +1552,47 @@ func (c *FakeClient) ListKubernetesMarketplaceApplications() ([]KubernetesMarket
 	return []KubernetesMarketplaceApplication{}, nil
 }
 
+// InstallKubernetesApplication implemented in a fake way for automated tests
+func (c *FakeClient) InstallKubernetesApplication(clusterID, appName, plan string, config map[string]string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == clusterID {
+			app := KubernetesInstalledApplication{
+				Application: appName,
+				Name:        appName,
+				Installed:   true,
+				Plan:        plan,
+			}
+			if len(config) > 0 {
+				app.Configuration = map[string]ApplicationConfiguration{appName: config}
+			}
+			c.Clusters[i].InstalledApplications = append(c.Clusters[i].InstalledApplications, app)
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", clusterID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// RemoveKubernetesApplication implemented in a fake way for automated tests
+func (c *FakeClient) RemoveKubernetesApplication(clusterID, appName string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == clusterID {
+			apps := []KubernetesInstalledApplication{}
+			for _, app := range cluster.InstalledApplications {
+				if app.Name != appName {
+					apps = append(apps, app)
+				}
+			}
+			c.Clusters[i].InstalledApplications = apps
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", clusterID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // DeleteKubernetesCluster implemented in a fake way for automated tests
 func (c *FakeClient) DeleteKubernetesCluster(id string) (*SimpleResponse, error) {
 	for i, cluster := range c.Clusters {
@@ -873,6 +1611,49 @@ func (c *FakeClient) RecycleKubernetesCluster(id string, hostname string) (*Simp
 	return &SimpleResponse{Result: "success"}, nil
 }
 
+// RecycleKubernetesNode implemented in a fake way for automated tests, the node is replaced by a new
+// active instance in the same pool
+func (c *FakeClient) RecycleKubernetesNode(clusterID, search string) (*Instance, error) {
+	recycled, err := c.FindKubernetesClusterInstance(clusterID, search)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := Instance{
+		ID:        c.generateID(),
+		Hostname:  recycled.Hostname,
+		Size:      recycled.Size,
+		Status:    string(InstanceStatusActive),
+		PoolID:    recycled.PoolID,
+		CreatedAt: NewTime(time.Now()),
+	}
+	for i, instance := range c.InstanceList {
+		if instance.ID == recycled.ID {
+			c.InstanceList[i] = replacement
+		}
+	}
+	for i := range c.Clusters {
+		if c.Clusters[i].ID != clusterID {
+			continue
+		}
+		replaceKubernetesInstance(c.Clusters[i].Instances, recycled.ID, replacement.ID)
+		for p := range c.Clusters[i].Pools {
+			replaceKubernetesInstance(c.Clusters[i].Pools[p].Instances, recycled.ID, replacement.ID)
+		}
+	}
+
+	return recycled, nil
+}
+
+// replaceKubernetesInstance changes the ID of the instance oldID of instances to newID
+func replaceKubernetesInstance(instances []KubernetesInstance, oldID, newID string) {
+	for i := range instances {
+		if instances[i].ID == oldID {
+			instances[i].ID = newID
+		}
+	}
+}
+
 // ListAvailableKubernetesVersions implemented in a fake way for automated tests
 func (c *FakeClient) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
 	return []KubernetesVersion{
@@ -883,11 +1664,25 @@ func (c *FakeClient) ListAvailableKubernetesVersions() ([]KubernetesVersion, err
 	}, nil
 }
 
+// UpgradeKubernetesCluster implemented in a fake way for automated tests
+func (c *FakeClient) UpgradeKubernetesCluster(clusterID, version string) (*KubernetesCluster, error) {
+	for i, cluster := range c.Clusters {
+		if cluster.ID == clusterID {
+			c.Clusters[i].Version = version
+			c.Clusters[i].KubernetesVersion = version
+			return &c.Clusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", clusterID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // GetDefaultNetwork implemented in a fake way for automated tests
 func (c *FakeClient) GetDefaultNetwork() (*Network, error) {
 	for _, network := range c.Networks {
 		if network.Default {
-			return &network, nil
+			return network.Clone(), nil
 		}
 	}
 
@@ -913,16 +1708,27 @@ func (c *FakeClient) NewNetwork(label string) (*NetworkResult, error) {
 
 // CreateNetwork creates a new network within the FakeClient, including VLAN configurations
 func (c *FakeClient) CreateNetwork(config NetworkConfig) (*NetworkResult, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	networkID := c.generateID()
 
 	// Prepare the new Network object
 	newNetwork := Network{
-		ID:          networkID,
-		Name:        config.Label,
-		Default:     config.Default == "true",
-		CIDR:        config.CIDRv4,
-		Label:       config.Label,
-		IPv4Enabled: config.IPv4Enabled != nil && *config.IPv4Enabled,
+		ID:            networkID,
+		Name:          config.Label,
+		Default:       config.Default == "true",
+		CIDR:          config.CIDRv4,
+		Label:         config.Label,
+		IPv4Enabled:   config.IPv4Enabled != nil && *config.IPv4Enabled,
+		IPv6Enabled:   config.IPv6Enabled != nil && *config.IPv6Enabled,
+		CIDRV6:        config.CIDRv6,
+		NameserversV4: config.NameserversV4,
+		NameserversV6: config.NameserversV6,
+	}
+	if newNetwork.CIDRV6 != "" {
+		newNetwork.IPv6Ranges = []string{newNetwork.CIDRV6}
 	}
 
 	// Handle VLAN configuration if present
@@ -931,7 +1737,7 @@ func (c *FakeClient) CreateNetwork(config NetworkConfig) (*NetworkResult, error)
 		newNetwork.PhysicalInterface = config.VLanConfig.PhysicalInterface
 		newNetwork.GatewayIPv4 = config.VLanConfig.GatewayIPv4
 		newNetwork.AllocationPoolV4Start = config.VLanConfig.AllocationPoolV4Start
-		newNetwork.AllocationPoolV4Start = config.VLanConfig.AllocationPoolV4End
+		newNetwork.AllocationPoolV4End = config.VLanConfig.AllocationPoolV4End
 	}
 
 	// Append the newly created network to the networks slice
@@ -952,14 +1758,7 @@ func (c *FakeClient) ListNetworks() ([]Network, error) {
 
 // FindNetwork implemented in a fake way for automated tests
 func (c *FakeClient) FindNetwork(search string) (*Network, error) {
-	for _, network := range c.Networks {
-		if strings.Contains(network.Name, search) {
-			return &network, nil
-		}
-	}
-
-	err := fmt.Errorf("unable to find default network, zero matches")
-	return nil, ZeroMatchesError.wrap(err)
+	return findMatch(c.Networks, search, false, func(n Network) (string, []string) { return n.ID, []string{n.Name, n.Label} })
 }
 
 // RenameNetwork implemented in a fake way for automated tests
@@ -997,13 +1796,24 @@ func (c *FakeClient) GetQuota() (*Quota, error) {
 	return &c.Quota, nil
 }
 
+// CheckQuotaFor implemented in a fake way for automated tests
+func (c *FakeClient) CheckQuotaFor(config *InstanceConfig) error {
+	size, err := c.FindInstanceSizes(config.Size)
+	if err != nil {
+		return err
+	}
+
+	return c.Quota.checkInstances(size, config)
+}
+
 // ListRegions implemented in a fake way for automated tests
 func (c *FakeClient) ListRegions() ([]Region, error) {
 	return []Region{
 		{
-			Code:    "FAKE1",
-			Name:    "Fake testing region",
-			Default: true,
+			Code:     "FAKE1",
+			Name:     "Fake testing region",
+			Features: Feature{Iaas: true, Kubernetes: true, GPU: true},
+			Default:  true,
 		},
 	}, nil
 }
@@ -1072,6 +1882,25 @@ func (c *FakeClient) DisconnectRegion(r *DisconnectRegionRequest) error {
 // 	return &SimpleResponse{Result: "failed"}, nil
 // }
 
+// CreateSSHKey implemented in a fake way for automated tests
+func (c *FakeClient) CreateSSHKey(name string, publicKey string) (*SSHKey, error) {
+	fingerprint, err := SSHKeyFingerprintSHA256(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := SSHKey{
+		ID:          c.generateID(),
+		Name:        name,
+		Fingerprint: fingerprint,
+		PublicKey:   strings.TrimSpace(publicKey),
+		CreatedAt:   NewTime(time.Now()),
+	}
+	c.SSHKeys = append(c.SSHKeys, key)
+
+	return &key, nil
+}
+
 // ListSSHKeys implemented in a fake way for automated tests
 func (c *FakeClient) ListSSHKeys() ([]SSHKey, error) {
 	return c.SSHKeys, nil
@@ -1092,7 +1921,7 @@ func (c *FakeClient) UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error)
 	for i, sshKey := range c.SSHKeys {
 		if sshKey.ID == sshKeyID {
 			c.SSHKeys[i].Name = name
-			return &sshKey, nil
+			return sshKey.Clone(), nil
 		}
 	}
 
@@ -1104,7 +1933,7 @@ func (c *FakeClient) UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error)
 func (c *FakeClient) FindSSHKey(search string) (*SSHKey, error) {
 	for _, sshKey := range c.SSHKeys {
 		if strings.Contains(sshKey.Name, search) {
-			return &sshKey, nil
+			return sshKey.Clone(), nil
 		}
 	}
 
@@ -1215,7 +2044,7 @@ func (c *FakeClient) GetDiskImage(id string) (*DiskImage, error) {
 func (c *FakeClient) FindDiskImage(search string) (*DiskImage, error) {
 	for _, diskimage := range c.DiskImage {
 		if strings.Contains(diskimage.Name, search) || strings.Contains(diskimage.ID, search) {
-			return &diskimage, nil
+			return diskimage.Clone(), nil
 		}
 	}
 
@@ -1223,6 +2052,31 @@ func (c *FakeClient) FindDiskImage(search string) (*DiskImage, error) {
 	return nil, ZeroMatchesError.wrap(err)
 }
 
+// NewDiskImage implemented in a fake way for automated tests
+func (c *FakeClient) NewDiskImage(config *DiskImageConfig) (*DiskImageUpload, error) {
+	if config.Name == "" || config.Distribution == "" || config.Version == "" {
+		err := fmt.Errorf("the name, distribution and version of the disk image are required")
+		return nil, DiskImageInvalidError.wrap(err)
+	}
+
+	image := DiskImage{
+		ID:           c.generateID(),
+		Name:         config.Name,
+		Version:      config.Version,
+		State:        DiskImageStateUploading,
+		Distribution: config.Distribution,
+		OS:           config.OS,
+	}
+	c.DiskImage = append(c.DiskImage, image)
+
+	return &DiskImageUpload{
+		ID:        image.ID,
+		Name:      image.Name,
+		State:     image.State,
+		UploadURL: fmt.Sprintf("https://upload.example.com/disk_images/%s", image.ID),
+	}, nil
+}
+
 // ListVolumes implemented in a fake way for automated tests
 func (c *FakeClient) ListVolumes() ([]Volume, error) {
 	return c.Volumes, nil
@@ -1232,7 +2086,7 @@ func (c *FakeClient) ListVolumes() ([]Volume, error) {
 func (c *FakeClient) GetVolume(id string) (*Volume, error) {
 	for _, volume := range c.Volumes {
 		if volume.ID == id {
-			return &volume, nil
+			return volume.Clone(), nil
 		}
 	}
 
@@ -1242,14 +2096,7 @@ func (c *FakeClient) GetVolume(id string) (*Volume, error) {
 
 // FindVolume implemented in a fake way for automated tests
 func (c *FakeClient) FindVolume(search string) (*Volume, error) {
-	for _, volume := range c.Volumes {
-		if strings.Contains(volume.Name, search) || strings.Contains(volume.ID, search) {
-			return &volume, nil
-		}
-	}
-
-	err := fmt.Errorf("unable to find volume %s, zero matches", search)
-	return nil, ZeroMatchesError.wrap(err)
+	return findMatch(c.Volumes, search, false, func(v Volume) (string, []string) { return v.ID, []string{v.Name} })
 }
 
 // NewVolume implemented in a fake way for automated tests
@@ -1258,7 +2105,8 @@ func (c *FakeClient) NewVolume(v *VolumeConfig) (*VolumeResult, error) {
 		ID:            c.generateID(),
 		Name:          v.Name,
 		SizeGigabytes: v.SizeGigabytes,
-		Status:        "available",
+		Status:        VolumeStatusAvailable,
+		Labels:        v.Labels,
 	}
 	c.Volumes = append(c.Volumes, volume)
 
@@ -1269,6 +2117,16 @@ func (c *FakeClient) NewVolume(v *VolumeConfig) (*VolumeResult, error) {
 	}, nil
 }
 
+// CreateVolume implemented in a fake way for automated tests
+func (c *FakeClient) CreateVolume(v *VolumeConfig) (*VolumeResult, error) {
+	if v.SizeGigabytes <= 0 && v.SnapshotID == "" {
+		err := fmt.Errorf("the size of the volume must be positive, got %dGB", v.SizeGigabytes)
+		return nil, VolumeInvalidSizeError.wrap(err)
+	}
+
+	return c.NewVolume(v)
+}
+
 // ResizeVolume implemented in a fake way for automated tests
 func (c *FakeClient) ResizeVolume(id string, size int) (*SimpleResponse, error) {
 	for i, volume := range c.Volumes {
@@ -1287,7 +2145,7 @@ func (c *FakeClient) AttachVolume(id string, cfg VolumeAttachConfig) (*SimpleRes
 	for i, volume := range c.Volumes {
 		if volume.ID == id {
 			c.Volumes[i].InstanceID = cfg.InstanceID
-			c.Volumes[i].Status = "attached"
+			c.Volumes[i].Status = VolumeStatusAttached
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -1301,7 +2159,7 @@ func (c *FakeClient) DetachVolume(id string) (*SimpleResponse, error) {
 	for i, volume := range c.Volumes {
 		if volume.ID == id {
 			c.Volumes[i].InstanceID = ""
-			c.Volumes[i].Status = "available"
+			c.Volumes[i].Status = VolumeStatusAvailable
 			return &SimpleResponse{Result: "success"}, nil
 		}
 	}
@@ -1323,8 +2181,260 @@ func (c *FakeClient) DeleteVolume(id string) (*SimpleResponse, error) {
 	return &SimpleResponse{Result: "failed"}, nil
 }
 
+// CreateVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotConfig) (*VolumeSnapshot, error) {
+	volume, err := c.GetVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := VolumeSnapshot{
+		ID:            c.generateID(),
+		Name:          config.Name,
+		Description:   config.Description,
+		VolumeID:      volume.ID,
+		SourceVolume:  volume.Name,
+		SizeGigabytes: volume.SizeGigabytes,
+		State:         "Ready",
+		CreatedAt:     NewTime(time.Now()),
+	}
+	c.VolumeSnapshots = append(c.VolumeSnapshots, snapshot)
+
+	return &snapshot, nil
+}
+
+// ListVolumeSnapshots implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumeSnapshots() ([]VolumeSnapshot, error) {
+	return c.VolumeSnapshots, nil
+}
+
+// ListVolumeSnapshotsByVolumeID implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumeSnapshotsByVolumeID(volumeID string) ([]VolumeSnapshot, error) {
+	snapshots := []VolumeSnapshot{}
+	for _, snapshot := range c.VolumeSnapshots {
+		if snapshot.VolumeID == volumeID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) GetVolumeSnapshot(id string) (*VolumeSnapshot, error) {
+	for _, snapshot := range c.VolumeSnapshots {
+		if snapshot.ID == id {
+			return &snapshot, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find volume snapshot %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteVolumeSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) DeleteVolumeSnapshot(id string) (*SimpleResponse, error) {
+	for i, snapshot := range c.VolumeSnapshots {
+		if snapshot.ID == id {
+			c.VolumeSnapshots = append(c.VolumeSnapshots[:i], c.VolumeSnapshots[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) CreateInstanceSnapshot(instanceID, name string) (*InstanceSnapshot, error) {
+	if name == "" {
+		err := fmt.Errorf("a name is required to snapshot instance %s", instanceID)
+		return nil, InstanceSnapshotInvalidError.wrap(err)
+	}
+
+	instance, err := c.GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := InstanceSnapshot{
+		ID:            c.generateID(),
+		Name:          name,
+		InstanceID:    instance.ID,
+		SizeGigabytes: instance.DiskGigabytes,
+		Status:        InstanceSnapshotStatusAvailable,
+		CreatedAt:     NewTime(time.Now()),
+	}
+	c.InstanceSnapshots = append(c.InstanceSnapshots, snapshot)
+
+	return &snapshot, nil
+}
+
+// ListInstanceSnapshots implemented in a fake way for automated tests
+func (c *FakeClient) ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error) {
+	snapshots := []InstanceSnapshot{}
+	for _, snapshot := range c.InstanceSnapshots {
+		if snapshot.InstanceID == instanceID {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceSnapshot(id string) (*InstanceSnapshot, error) {
+	for _, snapshot := range c.InstanceSnapshots {
+		if snapshot.ID == id {
+			return &snapshot, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find instance snapshot %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteInstanceSnapshot implemented in a fake way for automated tests
+func (c *FakeClient) DeleteInstanceSnapshot(id string) (*SimpleResponse, error) {
+	for i, snapshot := range c.InstanceSnapshots {
+		if snapshot.ID == id {
+			c.InstanceSnapshots = append(c.InstanceSnapshots[:i], c.InstanceSnapshots[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// PromoteSnapshotToDiskImage implemented in a fake way for automated tests
+func (c *FakeClient) PromoteSnapshotToDiskImage(snapshotID string) (*DiskImage, error) {
+	for i, snapshot := range c.InstanceSnapshots {
+		if snapshot.ID == snapshotID {
+			image := DiskImage{
+				ID:    c.generateID(),
+				Name:  snapshot.Name,
+				State: DiskImageStateAvailable,
+			}
+			c.DiskImage = append(c.DiskImage, image)
+			c.InstanceSnapshots[i].DiskImageID = image.ID
+			return &image, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find instance snapshot %s, zero matches", snapshotID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) CreateSnapshotSchedule(config *SnapshotScheduleConfig) (*SnapshotSchedule, error) {
+	if err := validateSnapshotScheduleConfig(config); err != nil {
+		return nil, err
+	}
+
+	schedule := SnapshotSchedule{
+		ID:              c.generateID(),
+		Name:            config.Name,
+		Description:     config.Description,
+		CronExpression:  config.CronExpression,
+		RetentionPolicy: config.RetentionPolicy,
+		VolumeIDs:       config.VolumeIDs,
+		Status:          "active",
+		CreatedAt:       NewTime(time.Now()),
+	}
+	c.SnapshotSchedules = append(c.SnapshotSchedules, schedule)
+
+	return &schedule, nil
+}
+
+// ListSnapshotSchedules implemented in a fake way for automated tests
+func (c *FakeClient) ListSnapshotSchedules() ([]SnapshotSchedule, error) {
+	return c.SnapshotSchedules, nil
+}
+
+// GetSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) GetSnapshotSchedule(id string) (*SnapshotSchedule, error) {
+	for _, schedule := range c.SnapshotSchedules {
+		if schedule.ID == id {
+			return &schedule, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find snapshot schedule %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteSnapshotSchedule implemented in a fake way for automated tests
+func (c *FakeClient) DeleteSnapshotSchedule(id string) (*SimpleResponse, error) {
+	for i, schedule := range c.SnapshotSchedules {
+		if schedule.ID == id {
+			c.SnapshotSchedules = append(c.SnapshotSchedules[:i], c.SnapshotSchedules[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreatePlacementGroup implemented in a fake way for automated tests
+func (c *FakeClient) CreatePlacementGroup(config *PlacementGroupConfig) (*PlacementGroup, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	group := PlacementGroup{
+		ID:        c.generateID(),
+		Name:      config.Name,
+		Policy:    config.Policy,
+		Region:    config.Region,
+		CreatedAt: NewTime(time.Now()),
+	}
+	if group.Policy == "" {
+		group.Policy = PlacementGroupPolicyAntiAffinity
+	}
+	c.PlacementGroups = append(c.PlacementGroups, group)
+
+	return &group, nil
+}
+
+// ListPlacementGroups implemented in a fake way for automated tests
+func (c *FakeClient) ListPlacementGroups() ([]PlacementGroup, error) {
+	return c.PlacementGroups, nil
+}
+
+// GetPlacementGroup implemented in a fake way for automated tests
+func (c *FakeClient) GetPlacementGroup(id string) (*PlacementGroup, error) {
+	for _, group := range c.PlacementGroups {
+		if group.ID == id {
+			return &group, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find placement group %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindPlacementGroup implemented in a fake way for automated tests
+func (c *FakeClient) FindPlacementGroup(search string) (*PlacementGroup, error) {
+	return findMatch(c.PlacementGroups, search, false, func(g PlacementGroup) (string, []string) { return g.ID, []string{g.Name} })
+}
+
+// DeletePlacementGroup implemented in a fake way for automated tests
+func (c *FakeClient) DeletePlacementGroup(id string) (*SimpleResponse, error) {
+	for i, group := range c.PlacementGroups {
+		if group.ID == id {
+			c.PlacementGroups = append(c.PlacementGroups[:i], c.PlacementGroups[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
 // CreateWebhook implemented in a fake way for automated tests
 func (c *FakeClient) CreateWebhook(r *WebhookConfig) (*Webhook, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	webhook := Webhook{
 		ID:     c.generateID(),
 		Events: r.Events,
@@ -1345,7 +2455,7 @@ func (c *FakeClient) ListWebhooks() ([]Webhook, error) {
 func (c *FakeClient) FindWebhook(search string) (*Webhook, error) {
 	for _, webhook := range c.Webhooks {
 		if strings.Contains(webhook.Secret, search) || strings.Contains(webhook.URL, search) {
-			return &webhook, nil
+			return webhook.Clone(), nil
 		}
 	}
 
@@ -1355,13 +2465,17 @@ func (c *FakeClient) FindWebhook(search string) (*Webhook, error) {
 
 // UpdateWebhook implemented in a fake way for automated tests
 func (c *FakeClient) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error) {
+	if err := r.validate(true); err != nil {
+		return nil, err
+	}
+
 	for i, webhook := range c.Webhooks {
 		if webhook.ID == id {
 			c.Webhooks[i].Events = r.Events
 			c.Webhooks[i].Secret = r.Secret
 			c.Webhooks[i].URL = r.URL
 
-			return &webhook, nil
+			return webhook.Clone(), nil
 		}
 	}
 
@@ -1396,6 +2510,49 @@ func (c *FakeClient) ListPermissions() ([]Permission, error) {
 	}, nil
 }
 
+// GetAccount implemented in a fake way for automated tests
+func (c *FakeClient) GetAccount() (*Account, error) {
+	account := c.Account
+	return &account, nil
+}
+
+// ListAPIKeys implemented in a fake way for automated tests, without the secrets of the keys
+func (c *FakeClient) ListAPIKeys() ([]APIKey, error) {
+	keys := make([]APIKey, 0, len(c.APIKeys))
+	for _, key := range c.APIKeys {
+		key.Key = ""
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// CreateAPIKey implemented in a fake way for automated tests
+func (c *FakeClient) CreateAPIKey(name string) (*APIKey, error) {
+	key := APIKey{
+		ID:        c.generateID(),
+		Name:      name,
+		Key:       c.generateID(),
+		CreatedAt: NewTime(time.Now()),
+	}
+	c.APIKeys = append(c.APIKeys, key)
+
+	return &key, nil
+}
+
+// RevokeAPIKey implemented in a fake way for automated tests
+func (c *FakeClient) RevokeAPIKey(id string) (*SimpleResponse, error) {
+	for i, key := range c.APIKeys {
+		if key.ID == id {
+			c.APIKeys = append(c.APIKeys[:i], c.APIKeys[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
 // GetOrganisation implemented in a fake way for automated tests
 func (c *FakeClient) GetOrganisation() (*Organisation, error) {
 	return &c.Organisation, nil
@@ -1414,13 +2571,22 @@ func (c *FakeClient) RenameOrganisation(name string) (*Organisation, error) {
 	return &c.Organisation, nil
 }
 
-// AddAccountToOrganisation implemented in a fake way for automated tests
-func (c *FakeClient) AddAccountToOrganisation(accountID string) ([]Account, error) {
-	c.OrganisationAccounts = append(c.OrganisationAccounts, Account{
-		ID:        accountID,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	})
+// AddAccountToOrganisation implemented in a fake way for automated tests, it adds the calling Account
+func (c *FakeClient) AddAccountToOrganisation(organisationID, organisationToken string) ([]Account, error) {
+	if c.Account.ID == "" {
+		c.Account.ID = c.generateID()
+		c.Account.CreatedAt = NewTime(time.Now())
+	}
+
+	for _, account := range c.OrganisationAccounts {
+		if account.ID == c.Account.ID {
+			return c.ListAccountsInOrganisation()
+		}
+	}
+
+	account := c.Account
+	account.UpdatedAt = NewTime(time.Now())
+	c.OrganisationAccounts = append(c.OrganisationAccounts, account)
 	return c.ListAccountsInOrganisation()
 }
 
@@ -1445,6 +2611,15 @@ func (c *FakeClient) CreateRole(name, permissions string) (*Role, error) {
 	return &role, nil
 }
 
+// CreateRoleWithPermissions implemented in a fake way for automated tests
+func (c *FakeClient) CreateRoleWithPermissions(name string, permissions []string) (*Role, error) {
+	if err := validateRole(name, permissions); err != nil {
+		return nil, err
+	}
+
+	return c.CreateRole(name, strings.Join(permissions, ","))
+}
+
 // DeleteRole implemented in a fake way for automated tests
 func (c *FakeClient) DeleteRole(id string) (*SimpleResponse, error) {
 	for i, role := range c.OrganisationRoles {
@@ -1468,8 +2643,8 @@ func (c *FakeClient) CreateTeam(name string) (*Team, error) {
 	team := Team{
 		ID:        c.generateID(),
 		Name:      name,
-		CreatedAt: time.Time{},
-		UpdatedAt: time.Time{},
+		CreatedAt: NewTime(time.Time{}),
+		UpdatedAt: NewTime(time.Time{}),
 	}
 	c.OrganisationTeams = append(c.OrganisationTeams, team)
 	return &team, nil
@@ -1513,8 +2688,8 @@ func (c *FakeClient) AddTeamMember(teamID, userID, permissions, roles string) ([
 		UserID:      userID,
 		Permissions: permissions,
 		Roles:       roles,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		CreatedAt:   NewTime(time.Now()),
+		UpdatedAt:   NewTime(time.Now()),
 	})
 
 	return c.ListTeamMembers(teamID)
@@ -1555,7 +2730,7 @@ func (c *FakeClient) ListLoadBalancers() ([]LoadBalancer, error) {
 func (c *FakeClient) GetLoadBalancer(id string) (*LoadBalancer, error) {
 	for _, lb := range c.LoadBalancers {
 		if lb.ID == id {
-			return &lb, nil
+			return lb.Clone(), nil
 		}
 	}
 
@@ -1594,6 +2769,10 @@ func (c *FakeClient) FindLoadBalancer(search string) (*LoadBalancer, error) {
 
 // CreateLoadBalancer implemented in a fake way for automated tests
 func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error) {
+	if err := validateSNIRoutes(r.SNIRoutes); err != nil {
+		return nil, err
+	}
+
 	loadbalancer := LoadBalancer{
 		ID:                           c.generateID(),
 		Name:                         r.Name,
@@ -1604,6 +2783,7 @@ func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, e
 		EnableProxyProtocol:          r.EnableProxyProtocol,
 		FirewallID:                   r.FirewallID,
 		ClusterID:                    r.ClusterID,
+		SNIRoutes:                    cloneEach(r.SNIRoutes, (*LoadBalancerSNIRoute).Clone),
 	}
 
 	if r.Algorithm == "" {
@@ -1623,6 +2803,7 @@ func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, e
 			Protocol:   b.Protocol,
 			SourcePort: b.SourcePort,
 			TargetPort: b.TargetPort,
+			InstanceID: b.InstanceID,
 		}
 		backends = append(backends, backend)
 	}
@@ -1636,7 +2817,12 @@ func (c *FakeClient) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, e
 
 // UpdateLoadBalancer implemented in a fake way for automated tests
 func (c *FakeClient) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error) {
-	for _, lb := range c.LoadBalancers {
+	if err := validateSNIRoutes(r.SNIRoutes); err != nil {
+		return nil, err
+	}
+
+	for i := range c.LoadBalancers {
+		lb := &c.LoadBalancers[i]
 		if lb.ID == id {
 			lb.Name = r.Name
 			lb.Algorithm = r.Algorithm
@@ -1652,12 +2838,16 @@ func (c *FakeClient) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig)
 				backends[i].SourcePort = b.SourcePort
 				backends[i].TargetPort = b.TargetPort
 			}
+			lb.Backends = backends
+			if r.SNIRoutes != nil {
+				lb.SNIRoutes = cloneEach(r.SNIRoutes, (*LoadBalancerSNIRoute).Clone)
+			}
 
 			if r.ExternalTrafficPolicy == "" {
 				lb.ExternalTrafficPolicy = "Cluster"
 			}
 
-			return &lb, nil
+			return lb.Clone(), nil
 		}
 	}
 
@@ -1665,17 +2855,177 @@ func (c *FakeClient) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig)
 	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
 }
 
-// DeleteLoadBalancer implemented in a fake way for automated tests
-func (c *FakeClient) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
-	for i, lb := range c.LoadBalancers {
-		if lb.ID == id {
-			c.LoadBalancers[len(c.LoadBalancers)-1], c.LoadBalancers[i] = c.LoadBalancers[i], c.LoadBalancers[len(c.LoadBalancers)-1]
-			c.LoadBalancers = c.LoadBalancers[:len(c.LoadBalancers)-1]
-			return &SimpleResponse{Result: "success"}, nil
-		}
+// fakeCertificate returns the certificate of config uploaded to lbID as id
+func fakeCertificate(id, lbID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	leaf, err := config.parse()
+	if err != nil {
+		return nil, err
 	}
 
-	return &SimpleResponse{Result: "failed"}, nil
+	return &LoadBalancerCertificate{
+		ID:             id,
+		LoadBalancerID: lbID,
+		Name:           config.Name,
+		Domains:        cloneSlice(leaf.DNSNames),
+		Fingerprint:    certificateFingerprint(leaf),
+		NotBefore:      NewTime(leaf.NotBefore),
+		NotAfter:       NewTime(leaf.NotAfter),
+		CreatedAt:      NewTime(time.Now()),
+	}, nil
+}
+
+// certificateFingerprint returns the hex encoded SHA-256 of the DER encoding of cert
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// UploadLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) UploadLoadBalancerCertificate(lbID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	if _, err := c.GetLoadBalancer(lbID); err != nil {
+		return nil, err
+	}
+
+	certificate, err := fakeCertificate(c.generateID(), lbID, config)
+	if err != nil {
+		return nil, err
+	}
+	c.LoadBalancerCerts = append(c.LoadBalancerCerts, *certificate)
+
+	return certificate.Clone(), nil
+}
+
+// ListLoadBalancerCertificates implemented in a fake way for automated tests
+func (c *FakeClient) ListLoadBalancerCertificates(lbID string) ([]LoadBalancerCertificate, error) {
+	certificates := []LoadBalancerCertificate{}
+	for i := range c.LoadBalancerCerts {
+		if c.LoadBalancerCerts[i].LoadBalancerID == lbID {
+			certificates = append(certificates, *c.LoadBalancerCerts[i].Clone())
+		}
+	}
+
+	return certificates, nil
+}
+
+// RotateLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) RotateLoadBalancerCertificate(lbID, certificateID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	for i, existing := range c.LoadBalancerCerts {
+		if existing.ID == certificateID && existing.LoadBalancerID == lbID {
+			certificate, err := fakeCertificate(certificateID, lbID, config)
+			if err != nil {
+				return nil, err
+			}
+			c.LoadBalancerCerts[i] = *certificate
+
+			return certificate.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find certificate %s", certificateID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteLoadBalancerCertificate implemented in a fake way for automated tests
+func (c *FakeClient) DeleteLoadBalancerCertificate(lbID, certificateID string) (*SimpleResponse, error) {
+	for _, lb := range c.LoadBalancers {
+		for _, route := range lb.SNIRoutes {
+			if lb.ID == lbID && route.CertificateID == certificateID {
+				err := fmt.Errorf("the certificate %s is used by the route of %s", certificateID, route.Hostname)
+				return nil, LoadBalancerCertificateInvalidError.wrap(err)
+			}
+		}
+	}
+
+	for i, existing := range c.LoadBalancerCerts {
+		if existing.ID == certificateID && existing.LoadBalancerID == lbID {
+			c.LoadBalancerCerts = append(c.LoadBalancerCerts[:i], c.LoadBalancerCerts[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find certificate %s", certificateID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// AddLoadBalancerBackend implemented in a fake way for automated tests
+func (c *FakeClient) AddLoadBalancerBackend(id string, backend *LoadBalancerBackendConfig) (*LoadBalancer, error) {
+	for i, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			c.LoadBalancers[i].Backends = append(c.LoadBalancers[i].Backends, LoadBalancerBackend(*backend))
+			return &c.LoadBalancers[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find load balancer %s", id)
+	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+}
+
+// RemoveLoadBalancerBackend implemented in a fake way for automated tests
+func (c *FakeClient) RemoveLoadBalancerBackend(id, ipOrInstanceID string) (*LoadBalancer, error) {
+	for i, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			backends := []LoadBalancerBackend{}
+			for _, b := range lb.Backends {
+				if b.IP != ipOrInstanceID && b.InstanceID != ipOrInstanceID {
+					backends = append(backends, b)
+				}
+			}
+			if len(backends) == len(lb.Backends) {
+				err := fmt.Errorf("unable to find the backend %s", ipOrInstanceID)
+				return nil, LoadBalancerBackendNotFoundError.wrap(err)
+			}
+			c.LoadBalancers[i].Backends = backends
+			return &c.LoadBalancers[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find load balancer %s", id)
+	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+}
+
+// GetLoadBalancerBackendHealth implemented in a fake way for automated tests, backends are healthy
+// unless they point to a fake instance that isn't active
+func (c *FakeClient) GetLoadBalancerBackendHealth(lbID string) ([]LoadBalancerBackendHealth, error) {
+	for _, lb := range c.LoadBalancers {
+		if lb.ID != lbID {
+			continue
+		}
+
+		health := make([]LoadBalancerBackendHealth, 0, len(lb.Backends))
+		for _, b := range lb.Backends {
+			h := LoadBalancerBackendHealth{
+				IP:            b.IP,
+				InstanceID:    b.InstanceID,
+				TargetPort:    b.TargetPort,
+				Status:        LoadBalancerBackendHealthy,
+				LastCheckedAt: NewTime(time.Now()),
+			}
+			for _, instance := range c.InstanceList {
+				if instance.ID == b.InstanceID && !InstanceStatusActive.Is(instance.Status) {
+					h.Status = LoadBalancerBackendUnhealthy
+					h.FailureReason = fmt.Sprintf("instance %s is %s", instance.Hostname, instance.Status)
+				}
+			}
+			health = append(health, h)
+		}
+		return health, nil
+	}
+
+	err := fmt.Errorf("unable to find load balancer %s", lbID)
+	return nil, DatabaseLoadBalancerNotFoundError.wrap(err)
+}
+
+// DeleteLoadBalancer implemented in a fake way for automated tests
+func (c *FakeClient) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
+	for i, lb := range c.LoadBalancers {
+		if lb.ID == id {
+			c.LoadBalancers[len(c.LoadBalancers)-1], c.LoadBalancers[i] = c.LoadBalancers[i], c.LoadBalancers[len(c.LoadBalancers)-1]
+			c.LoadBalancers = c.LoadBalancers[:len(c.LoadBalancers)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
 }
 
 // ListKubernetesClusterPools implemented in a fake way for automated tests
@@ -1820,14 +3170,30 @@ func (c *FakeClient) UpdateKubernetesClusterPool(cid, pid string, config *Kubern
 	poolFound := false
 
 	pool := KubernetesPool{}
-	for _, cs := range c.Clusters {
+	for ci, cs := range c.Clusters {
 		if cs.ID == cid {
 			clusterFound = true
-			for _, p := range cs.Pools {
+			for pi, p := range cs.Pools {
 				if p.ID == pid {
 					poolFound = true
-					p.Count = *config.Count
-					pool = p
+					p := &c.Clusters[ci].Pools[pi]
+					if config.Count != nil && *config.Count != p.Count {
+						c.recordScalingEvent(cid, pid, p.Count, *config.Count)
+						p.Count = *config.Count
+					}
+					if config.MinCount != nil {
+						p.MinCount = *config.MinCount
+					}
+					if config.MaxCount != nil {
+						p.MaxCount = *config.MaxCount
+					}
+					if config.Labels != nil {
+						p.Labels = config.Labels
+					}
+					if config.Taints != nil {
+						p.Taints = config.Taints
+					}
+					pool = *p
 				}
 			}
 		}
@@ -1846,8 +3212,76 @@ func (c *FakeClient) UpdateKubernetesClusterPool(cid, pid string, config *Kubern
 	return &pool, nil
 }
 
+// recordScalingEvent records the node count of a pool changing from from to to
+func (c *FakeClient) recordScalingEvent(clusterID, poolID string, from, to int) {
+	action := KubernetesScalingActionScaleUp
+	if to < from {
+		action = KubernetesScalingActionScaleDown
+	}
+
+	c.ScalingEvents = append([]KubernetesScalingEvent{{
+		ID:        c.generateID(),
+		ClusterID: clusterID,
+		PoolID:    poolID,
+		Action:    action,
+		FromCount: from,
+		ToCount:   to,
+		CreatedAt: NewTime(time.Now()),
+	}}, c.ScalingEvents...)
+}
+
+// EnablePoolAutoscaling implemented in a fake way for automated tests
+func (c *FakeClient) EnablePoolAutoscaling(clusterID, poolID string, min, max int) (*KubernetesPool, error) {
+	if max < 1 {
+		err := fmt.Errorf("max count must be at least 1, got %d", max)
+		return nil, KubernetesPoolInvalidError.wrap(err)
+	}
+	if err := validatePoolCounts(0, min, max); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateKubernetesClusterPool(clusterID, poolID, &KubernetesClusterPoolUpdateConfig{MinCount: &min, MaxCount: &max})
+}
+
+// DisablePoolAutoscaling implemented in a fake way for automated tests
+func (c *FakeClient) DisablePoolAutoscaling(clusterID, poolID string) (*KubernetesPool, error) {
+	zero := 0
+	return c.UpdateKubernetesClusterPool(clusterID, poolID, &KubernetesClusterPoolUpdateConfig{MinCount: &zero, MaxCount: &zero})
+}
+
+// GetClusterScalingEvents implemented in a fake way for automated tests
+func (c *FakeClient) GetClusterScalingEvents(clusterID string) ([]KubernetesScalingEvent, error) {
+	events := []KubernetesScalingEvent{}
+	for _, event := range c.ScalingEvents {
+		if event.ClusterID == clusterID {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// GetKubernetesClusterMetrics implemented in a fake way for automated tests
+func (c *FakeClient) GetKubernetesClusterMetrics(clusterID string, period MetricsPeriod) (*ResourceMetrics, error) {
+	return c.fakeMetrics(clusterID, period)
+}
+
+// fakeMetrics returns the metrics set for id in c.Metrics, or empty series
+func (c *FakeClient) fakeMetrics(id string, period MetricsPeriod) (*ResourceMetrics, error) {
+	if err := period.validate(); err != nil {
+		return nil, err
+	}
+
+	metrics, ok := c.Metrics[id]
+	if !ok {
+		metrics = ResourceMetrics{ResourceID: id}
+	}
+	metrics.Period = period
+	return &metrics, nil
+}
+
 // ListIPs returns a list of fake IPs
-func (c *FakeClient) ListIPs() (*PaginatedIPs, error) {
+func (c *FakeClient) ListIPs(opts ...ListOptions) (*PaginatedIPs, error) {
 	return &PaginatedIPs{
 		Page:    1,
 		PerPage: 20,
@@ -1889,6 +3323,11 @@ func (c *FakeClient) NewIP(v *CreateIPRequest) (*IP, error) {
 	}, nil
 }
 
+// CreateIP implemented in a fake way for automated tests
+func (c *FakeClient) CreateIP(name string) (*IP, error) {
+	return c.NewIP(&CreateIPRequest{Name: name})
+}
+
 // UpdateIP updates a fake IP
 func (c *FakeClient) UpdateIP(id string, v *UpdateIPRequest) (*IP, error) {
 	return &IP{
@@ -1918,3 +3357,1150 @@ func (c *FakeClient) UnassignIP(id, region string) (*SimpleResponse, error) {
 		Result: "success",
 	}, nil
 }
+
+// ReassignIP implemented in a fake way for automated tests
+func (c *FakeClient) ReassignIP(id, resourceID, resourceType string) (*SimpleResponse, error) {
+	if _, err := c.UnassignIP(id, ""); err != nil {
+		return nil, err
+	}
+
+	return c.AssignIP(id, resourceID, resourceType, "")
+}
+
+// ListApplications implemented in a fake way for automated tests
+func (c *FakeClient) ListApplications(opts ...ListOptions) (*PaginatedApplications, error) {
+	return &PaginatedApplications{
+		Items:   c.Applications,
+		Page:    1,
+		PerPage: len(c.Applications),
+		Pages:   1,
+	}, nil
+}
+
+// GetApplication implemented in a fake way for automated tests
+func (c *FakeClient) GetApplication(id string) (*Application, error) {
+	for _, app := range c.Applications {
+		if app.ID == id {
+			return app.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindApplication implemented in a fake way for automated tests
+func (c *FakeClient) FindApplication(search string) (*Application, error) {
+	for _, app := range c.Applications {
+		if app.ID == search || strings.Contains(app.Name, search) {
+			return app.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateApplication implemented in a fake way for automated tests
+func (c *FakeClient) CreateApplication(config *ApplicationConfig) (*Application, error) {
+	app := Application{
+		ID:          c.generateID(),
+		Name:        config.Name,
+		NetworkID:   config.NetworkID,
+		Description: config.Description,
+		Size:        config.Size,
+		SSHKeyIDs:   config.SSHKeyIDs,
+		Status:      "building",
+	}
+	c.Applications = append(c.Applications, app)
+	return &app, nil
+}
+
+// UpdateApplication implemented in a fake way for automated tests
+func (c *FakeClient) UpdateApplication(id string, application *UpdateApplicationRequest) (*Application, error) {
+	for i, app := range c.Applications {
+		if app.ID == id {
+			c.Applications[i].Name = application.Name
+			c.Applications[i].Description = application.Description
+			c.Applications[i].Image = application.Image
+			c.Applications[i].Size = application.Size
+			c.Applications[i].ProcessInfo = application.ProcessInfo
+			c.Applications[i].SSHKeyIDs = application.SSHKeyIDs
+			c.Applications[i].Config = application.Config
+			c.Applications[i].Domains = application.Domains
+			return &c.Applications[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteApplication implemented in a fake way for automated tests
+func (c *FakeClient) DeleteApplication(id string) (*SimpleResponse, error) {
+	for i, app := range c.Applications {
+		if app.ID == id {
+			c.Applications[len(c.Applications)-1], c.Applications[i] = c.Applications[i], c.Applications[len(c.Applications)-1]
+			c.Applications = c.Applications[:len(c.Applications)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// ScaleApplicationProcess implemented in a fake way for automated tests
+func (c *FakeClient) ScaleApplicationProcess(appID, processType string, count int) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if err := scaleProcess(req, processType, count); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// RestartApplication implemented in a fake way for automated tests
+func (c *FakeClient) RestartApplication(appID string) (*SimpleResponse, error) {
+	if _, err := c.GetApplication(appID); err != nil {
+		return nil, err
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// SetApplicationConfigVars implemented in a fake way for automated tests
+func (c *FakeClient) SetApplicationConfigVars(appID string, vars map[string]string) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	req.Config = setEnvVars(req.Config, vars)
+
+	return c.UpdateApplication(appID, req)
+}
+
+// UnsetApplicationConfigVars implemented in a fake way for automated tests
+func (c *FakeClient) UnsetApplicationConfigVars(appID string, names ...string) (*Application, error) {
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	req.Config = unsetEnvVars(req.Config, names)
+
+	return c.UpdateApplication(appID, req)
+}
+
+// GetApplicationLogsURL implemented in a fake way for automated tests
+func (c *FakeClient) GetApplicationLogsURL(appID string) (string, error) {
+	if _, err := c.GetApplication(appID); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://logs.example.com/applications/%s", appID), nil
+}
+
+// CreateApplicationDeployment implemented in a fake way for automated tests
+func (c *FakeClient) CreateApplicationDeployment(appID string, source DeploymentSource) (*ApplicationDeployment, error) {
+	if err := source.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := c.GetApplication(appID); err != nil {
+		return nil, err
+	}
+
+	deployment := ApplicationDeployment{
+		ID:            c.generateID(),
+		ApplicationID: appID,
+		Source:        source,
+		Status:        ApplicationDeploymentStatusDeployed,
+		CreatedAt:     NewTime(time.Now()),
+	}
+	c.ApplicationDeployments = append([]ApplicationDeployment{deployment}, c.ApplicationDeployments...)
+
+	return &deployment, nil
+}
+
+// ListApplicationDeployments implemented in a fake way for automated tests
+func (c *FakeClient) ListApplicationDeployments(appID string) ([]ApplicationDeployment, error) {
+	deployments := []ApplicationDeployment{}
+	for _, deployment := range c.ApplicationDeployments {
+		if deployment.ApplicationID == appID {
+			deployments = append(deployments, deployment)
+		}
+	}
+
+	return deployments, nil
+}
+
+// GetApplicationDeployment implemented in a fake way for automated tests
+func (c *FakeClient) GetApplicationDeployment(appID, deploymentID string) (*ApplicationDeployment, error) {
+	for _, deployment := range c.ApplicationDeployments {
+		if deployment.ApplicationID == appID && deployment.ID == deploymentID {
+			return &deployment, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find deployment %s, zero matches", deploymentID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// RollbackApplication implemented in a fake way for automated tests
+func (c *FakeClient) RollbackApplication(appID, deploymentID string) (*ApplicationDeployment, error) {
+	previous, err := c.GetApplicationDeployment(appID, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := c.CreateApplicationDeployment(appID, previous.Source)
+	if err != nil {
+		return nil, err
+	}
+	deployment.RollbackOf = previous.ID
+	c.ApplicationDeployments[0].RollbackOf = previous.ID
+
+	return deployment, nil
+}
+
+// AddApplicationDomain implemented in a fake way for automated tests
+func (c *FakeClient) AddApplicationDomain(appID, domain string) (*Application, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if !addDomain(req, domain) {
+		return app, nil
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// AddApplicationDomainWithDNS implemented in a fake way for automated tests
+func (c *FakeClient) AddApplicationDomainWithDNS(appID, domain string) (*ApplicationDomainStatus, error) {
+	if _, err := c.AddApplicationDomain(appID, domain); err != nil {
+		return nil, err
+	}
+
+	status, err := c.GetApplicationDomainStatus(appID, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pointDomainAt(c, status.Domain, status.CNAMETarget); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// RemoveApplicationDomain implemented in a fake way for automated tests
+func (c *FakeClient) RemoveApplicationDomain(appID, domain string) (*Application, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := app.updateRequest()
+	if !removeDomain(req, domain) {
+		return nil, ErrAppDomainNotFound
+	}
+
+	return c.UpdateApplication(appID, req)
+}
+
+// GetApplicationDomainStatus implemented in a fake way for automated tests
+func (c *FakeClient) GetApplicationDomainStatus(appID, domain string) (*ApplicationDomainStatus, error) {
+	domain, err := normaliseApplicationDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := c.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range app.Domains {
+		if d == domain {
+			return &ApplicationDomainStatus{
+				Domain:            domain,
+				CNAMETarget:       fmt.Sprintf("%s.apps.example.com", app.ID),
+				DNSConfigured:     true,
+				CertificateStatus: ApplicationCertificateStatusIssued,
+			}, nil
+		}
+	}
+
+	return nil, ErrAppDomainNotFound
+}
+
+// ListDatabases implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabases(opts ...ListOptions) (*PaginatedDatabases, error) {
+	return &PaginatedDatabases{
+		Items:   c.Databases,
+		Page:    1,
+		PerPage: len(c.Databases),
+		Pages:   1,
+	}, nil
+}
+
+// GetDatabase implemented in a fake way for automated tests
+func (c *FakeClient) GetDatabase(id string) (*Database, error) {
+	for _, db := range c.Databases {
+		if db.ID == id {
+			return db.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindDatabase implemented in a fake way for automated tests
+func (c *FakeClient) FindDatabase(search string) (*Database, error) {
+	for _, db := range c.Databases {
+		if db.ID == search || strings.Contains(db.Name, search) {
+			return db.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewDatabase implemented in a fake way for automated tests
+func (c *FakeClient) NewDatabase(v *CreateDatabaseRequest) (*Database, error) {
+	db := Database{
+		ID:              c.generateID(),
+		Name:            v.Name,
+		Nodes:           v.Nodes,
+		Size:            v.Size,
+		Software:        v.Software,
+		SoftwareVersion: v.SoftwareVersion,
+		NetworkID:       v.NetworkID,
+		FirewallID:      v.FirewallID,
+		PublicIPv4:      c.generatePublicIP(),
+		Status:          "Pending",
+	}
+	c.Databases = append(c.Databases, db)
+	return &db, nil
+}
+
+// CreateDatabase implemented in a fake way for automated tests
+func (c *FakeClient) CreateDatabase(config *DatabaseConfig) (*Database, error) {
+	versions, _ := c.ListDBVersions()
+	request, err := databaseRequest(config, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewDatabase(request)
+}
+
+// GetDatabaseCredentials implemented in a fake way for automated tests
+func (c *FakeClient) GetDatabaseCredentials(id string) (*DatabaseCredentials, error) {
+	db, err := c.GetDatabase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.credentials()
+}
+
+// UpdateDatabase implemented in a fake way for automated tests
+func (c *FakeClient) UpdateDatabase(id string, v *UpdateDatabaseRequest) (*Database, error) {
+	for i, db := range c.Databases {
+		if db.ID == id {
+			if v.Name != "" {
+				c.Databases[i].Name = v.Name
+			}
+			if v.Nodes != nil {
+				c.Databases[i].Nodes = *v.Nodes
+			}
+			if v.FirewallID != "" {
+				c.Databases[i].FirewallID = v.FirewallID
+			}
+			return &c.Databases[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteDatabase implemented in a fake way for automated tests
+func (c *FakeClient) DeleteDatabase(id string) (*SimpleResponse, error) {
+	for i, db := range c.Databases {
+		if db.ID == id {
+			c.Databases[len(c.Databases)-1], c.Databases[i] = c.Databases[i], c.Databases[len(c.Databases)-1]
+			c.Databases = c.Databases[:len(c.Databases)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// RestoreDatabase implemented in a fake way for automated tests
+func (c *FakeClient) RestoreDatabase(id string, v *RestoreDatabaseRequest) (*SimpleResponse, error) {
+	if _, err := c.GetDatabase(id); err != nil {
+		return nil, err
+	}
+
+	return &SimpleResponse{Result: "success"}, nil
+}
+
+// ListDBVersions implemented in a fake way for automated tests
+func (c *FakeClient) ListDBVersions() (map[string][]SupportedSoftwareVersion, error) {
+	return map[string][]SupportedSoftwareVersion{
+		"mysql":      {{SoftwareVersion: "8.0", Default: true}},
+		"postgresql": {{SoftwareVersion: "14", Default: true}},
+	}, nil
+}
+
+// ListDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) ListDatabaseBackup(did string) (*PaginatedDatabaseBackup, error) {
+	backups := []DatabaseBackup{}
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == did {
+			backups = append(backups, backup)
+		}
+	}
+
+	return &PaginatedDatabaseBackup{
+		Items:   backups,
+		Page:    1,
+		PerPage: len(backups),
+		Pages:   1,
+	}, nil
+}
+
+// GetDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) GetDatabaseBackup(dbid, id string) (*DatabaseBackup, error) {
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == dbid && backup.ID == id {
+			return &backup, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) FindDatabaseBackup(dbid, search string) (*DatabaseBackup, error) {
+	for _, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == dbid && (backup.ID == search || strings.Contains(backup.Name, search)) {
+			return &backup, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) CreateDatabaseBackup(did string, v *DatabaseBackupCreateRequest) (*DatabaseBackup, error) {
+	db, err := c.GetDatabase(did)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := DatabaseBackup{
+		ID:           c.generateID(),
+		Name:         v.Name,
+		Software:     db.Software,
+		Status:       "pending",
+		Schedule:     v.Schedule,
+		DatabaseName: db.Name,
+		DatabaseID:   db.ID,
+		IsScheduled:  v.Schedule != "",
+		CreatedAt:    NewTime(time.Now()),
+	}
+	c.DatabaseBackups = append(c.DatabaseBackups, backup)
+	return &backup, nil
+}
+
+// UpdateDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) UpdateDatabaseBackup(did string, v *DatabaseBackupUpdateRequest) (*DatabaseBackup, error) {
+	for i, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == did && backup.IsScheduled {
+			c.DatabaseBackups[i].Name = v.Name
+			c.DatabaseBackups[i].Schedule = v.Schedule
+			return &c.DatabaseBackups[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find a scheduled backup for %s", did)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteDatabaseBackup implemented in a fake way for automated tests
+func (c *FakeClient) DeleteDatabaseBackup(dbid, id string) (*SimpleResponse, error) {
+	for i, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == dbid && backup.ID == id {
+			c.DatabaseBackups[len(c.DatabaseBackups)-1], c.DatabaseBackups[i] = c.DatabaseBackups[i], c.DatabaseBackups[len(c.DatabaseBackups)-1]
+			c.DatabaseBackups = c.DatabaseBackups[:len(c.DatabaseBackups)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// UpdateDatabaseBackupSchedule implemented in a fake way for automated tests
+func (c *FakeClient) UpdateDatabaseBackupSchedule(did, schedule string) (*DatabaseBackup, error) {
+	if err := validateCronExpression(schedule); err != nil {
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	for i, backup := range c.DatabaseBackups {
+		if backup.DatabaseID == did && backup.IsScheduled {
+			c.DatabaseBackups[i].Schedule = schedule
+			return &c.DatabaseBackups[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find a scheduled backup for %s", did)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// RestoreDatabaseToPointInTime implemented in a fake way for automated tests
+func (c *FakeClient) RestoreDatabaseToPointInTime(did string, timestamp time.Time) (*SimpleResponse, error) {
+	if timestamp.IsZero() || timestamp.After(time.Now()) {
+		err := fmt.Errorf("the restore time must be in the past, got %s", timestamp)
+		return nil, DatabaseConfigInvalidError.wrap(err)
+	}
+
+	return c.RestoreDatabase(did, &RestoreDatabaseRequest{PointInTime: timestamp.UTC().Format(time.RFC3339)})
+}
+
+// GetDiskImageByName implemented in a fake way for automated tests
+func (c *FakeClient) GetDiskImageByName(name string) (*DiskImage, error) {
+	for _, diskimage := range c.DiskImage {
+		if diskimage.Name == name {
+			return diskimage.Clone(), nil
+		}
+	}
+
+	return nil, errors.New("diskimage not found")
+}
+
+// GetMostRecentDistro implemented in a fake way for automated tests
+func (c *FakeClient) GetMostRecentDistro(name string) (*DiskImage, error) {
+	var highestVersionDistro *DiskImage
+	for i, diskimage := range c.DiskImage {
+		if strings.Contains(diskimage.Name, name) {
+			if highestVersionDistro == nil || semver.Compare(highestVersionDistro.Version, diskimage.Version) < 0 {
+				highestVersionDistro = &c.DiskImage[i]
+			}
+		}
+	}
+
+	if highestVersionDistro == nil {
+		return nil, fmt.Errorf("%s image not found", name)
+	}
+
+	return highestVersionDistro, nil
+}
+
+// ListKfClusters implemented in a fake way for automated tests
+func (c *FakeClient) ListKfClusters(opts ...ListOptions) (*PaginatedKfClusters, error) {
+	return &PaginatedKfClusters{
+		Items:   c.KfClusters,
+		Page:    1,
+		PerPage: len(c.KfClusters),
+		Pages:   1,
+	}, nil
+}
+
+// GetKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) GetKfCluster(id string) (*KfCluster, error) {
+	for _, kfc := range c.KfClusters {
+		if kfc.ID == id {
+			return kfc.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) FindKfCluster(search string) (*KfCluster, error) {
+	for _, kfc := range c.KfClusters {
+		if kfc.ID == search || strings.Contains(kfc.Name, search) {
+			return kfc.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// CreateKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) CreateKfCluster(req CreateKfClusterReq) (*KfCluster, error) {
+	kfc := KfCluster{
+		ID:         c.generateID(),
+		Name:       req.Name,
+		NetworkID:  req.NetworkID,
+		FirewallID: req.FirewallID,
+		Size:       req.Size,
+		CreatedAt:  NewTime(time.Now()),
+	}
+	c.KfClusters = append(c.KfClusters, kfc)
+	return &kfc, nil
+}
+
+// UpdateKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) UpdateKfCluster(id string, kfc *UpdateKfClusterReq) (*KfCluster, error) {
+	for i, cluster := range c.KfClusters {
+		if cluster.ID == id {
+			c.KfClusters[i].Name = kfc.Name
+			return &c.KfClusters[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteKfCluster implemented in a fake way for automated tests
+func (c *FakeClient) DeleteKfCluster(id string) (*SimpleResponse, error) {
+	for i, kfc := range c.KfClusters {
+		if kfc.ID == id {
+			c.KfClusters[len(c.KfClusters)-1], c.KfClusters[i] = c.KfClusters[i], c.KfClusters[len(c.KfClusters)-1]
+			c.KfClusters = c.KfClusters[:len(c.KfClusters)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error) {
+	if i.RequestsGPU() {
+		regions, _ := c.ListRegions()
+		if err := validateGPUPool(i, regionByCode(regions, ""), c.InstanceSizes).wrap(KubernetesPoolInvalidError); err != nil {
+			return nil, err
+		}
+	}
+
+	for ci, cs := range c.Clusters {
+		if cs.ID == id {
+			pool := KubernetesPool{
+				ID:               i.ID,
+				Count:            i.Count,
+				Size:             i.Size,
+				Labels:           i.Labels,
+				Taints:           i.Taints,
+				PublicIPNodePool: i.PublicIPNodePool,
+				MinCount:         i.MinCount,
+				MaxCount:         i.MaxCount,
+				GPUCount:         i.GPUCount,
+				GPUType:          i.GPUType,
+			}
+			if pool.ID == "" {
+				pool.ID = c.generateID()
+			}
+			c.Clusters[ci].Pools = append(c.Clusters[ci].Pools, pool)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", id)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// ValidateKubernetesGPUPools implemented in a fake way for automated tests, against the default fake region
+func (c *FakeClient) ValidateKubernetesGPUPools(pools []KubernetesClusterPoolConfig) error {
+	regions, _ := c.ListRegions()
+	return validateGPUPools(pools, regionByCode(regions, ""), c.InstanceSizes)
+}
+
+// DeleteKubernetesClusterPool implemented in a fake way for automated tests
+func (c *FakeClient) DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse, error) {
+	for ci, cs := range c.Clusters {
+		if cs.ID == id {
+			for pi, p := range cs.Pools {
+				if p.ID == poolID {
+					c.Clusters[ci].Pools = append(cs.Pools[:pi], cs.Pools[pi+1:]...)
+					return &SimpleResponse{Result: "success"}, nil
+				}
+			}
+
+			err := fmt.Errorf("unable to get kubernetes pool %s", poolID)
+			return nil, DatabaseClusterPoolNotFoundError.wrap(err)
+		}
+	}
+
+	err := fmt.Errorf("unable to get kubernetes cluster %s", id)
+	return nil, DatabaseKubernetesClusterNotFoundError.wrap(err)
+}
+
+// GetNetwork implemented in a fake way for automated tests
+func (c *FakeClient) GetNetwork(id string) (*Network, error) {
+	for _, network := range c.Networks {
+		if network.ID == id {
+			return network.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// UpdateNetwork implemented in a fake way for automated tests
+func (c *FakeClient) UpdateNetwork(id string, nc NetworkConfig) (*NetworkResult, error) {
+	for i, network := range c.Networks {
+		if network.ID == id {
+			c.Networks[i].Label = nc.Label
+			c.Networks[i].Name = nc.Label
+			if nc.NameserversV4 != nil {
+				c.Networks[i].NameserversV4 = nc.NameserversV4
+			}
+			return &NetworkResult{
+				ID:     network.ID,
+				Label:  nc.Label,
+				Result: "success",
+			}, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// ListSubnets implemented in a fake way for automated tests
+func (c *FakeClient) ListSubnets(networkID string) ([]Subnet, error) {
+	subnets := []Subnet{}
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID {
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets, nil
+}
+
+// GetSubnet implemented in a fake way for automated tests
+func (c *FakeClient) GetSubnet(networkID, subnetID string) (*Subnet, error) {
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && subnet.ID == subnetID {
+			return subnet.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", subnetID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindSubnet implemented in a fake way for automated tests
+func (c *FakeClient) FindSubnet(search, networkID string) (*Subnet, error) {
+	for _, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && (subnet.ID == search || strings.Contains(subnet.Name, search)) {
+			return subnet.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// AttachInstanceToNetwork implemented in a fake way for automated tests
+func (c *FakeClient) AttachInstanceToNetwork(instanceID, networkID string) (*NetworkAttachment, error) {
+	if _, err := c.GetNetwork(networkID); err != nil {
+		return nil, err
+	}
+
+	attachment := NetworkAttachment{
+		ID:         c.generateID(),
+		InstanceID: instanceID,
+		NetworkID:  networkID,
+		PrivateIP:  "10.0.0.1",
+		Status:     NetworkAttachmentStatusAttached,
+	}
+	c.NetworkAttachments = append(c.NetworkAttachments, attachment)
+
+	return &attachment, nil
+}
+
+// ListInstanceNetworkAttachments implemented in a fake way for automated tests
+func (c *FakeClient) ListInstanceNetworkAttachments(instanceID string) ([]NetworkAttachment, error) {
+	attachments := []NetworkAttachment{}
+	for _, attachment := range c.NetworkAttachments {
+		if attachment.InstanceID == instanceID {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, nil
+}
+
+// GetInstanceNetworkAttachment implemented in a fake way for automated tests
+func (c *FakeClient) GetInstanceNetworkAttachment(instanceID, networkID string) (*NetworkAttachment, error) {
+	for _, attachment := range c.NetworkAttachments {
+		if attachment.InstanceID == instanceID && attachment.NetworkID == networkID {
+			return attachment.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("instance %s is not attached to network %s", instanceID, networkID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DetachInstanceFromNetwork implemented in a fake way for automated tests
+func (c *FakeClient) DetachInstanceFromNetwork(instanceID, networkID string) (*SimpleResponse, error) {
+	for i, attachment := range c.NetworkAttachments {
+		if attachment.InstanceID == instanceID && attachment.NetworkID == networkID {
+			c.NetworkAttachments = append(c.NetworkAttachments[:i], c.NetworkAttachments[i+1:]...)
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// CreateSubnet implemented in a fake way for automated tests
+func (c *FakeClient) CreateSubnet(networkID string, subnet SubnetConfig) (*Subnet, error) {
+	s := Subnet{
+		ID:        c.generateID(),
+		Name:      subnet.Name,
+		NetworkID: networkID,
+		Status:    "ACTIVE",
+	}
+	c.Subnets = append(c.Subnets, s)
+	return &s, nil
+}
+
+// DeleteSubnet implemented in a fake way for automated tests
+func (c *FakeClient) DeleteSubnet(networkID, subnetID string) (*SimpleResponse, error) {
+	for i, subnet := range c.Subnets {
+		if subnet.NetworkID == networkID && subnet.ID == subnetID {
+			c.Subnets[len(c.Subnets)-1], c.Subnets[i] = c.Subnets[i], c.Subnets[len(c.Subnets)-1]
+			c.Subnets = c.Subnets[:len(c.Subnets)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// ListObjectStores implemented in a fake way for automated tests
+func (c *FakeClient) ListObjectStores(opts ...ListOptions) (*PaginatedObjectstores, error) {
+	return &PaginatedObjectstores{
+		Items:   c.ObjectStores,
+		Page:    1,
+		PerPage: len(c.ObjectStores),
+		Pages:   1,
+	}, nil
+}
+
+// GetObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStore(id string) (*ObjectStore, error) {
+	for _, store := range c.ObjectStores {
+		if store.ID == id {
+			return store.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) FindObjectStore(search string) (*ObjectStore, error) {
+	for _, store := range c.ObjectStores {
+		if store.ID == search || strings.Contains(store.Name, search) {
+			return store.Clone(), nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error) {
+	store := ObjectStore{
+		ID:        c.generateID(),
+		Name:      v.Name,
+		MaxSize:   int(v.MaxSizeGB),
+		OwnerInfo: BucketOwner{AccessKeyID: v.AccessKeyID},
+		BucketURL: "objectstore.fake.civo.com",
+		Status:    "ready",
+	}
+	c.ObjectStores = append(c.ObjectStores, store)
+	return &store, nil
+}
+
+// CreateObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) CreateObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error) {
+	if v.MaxSizeGB <= 0 {
+		err := fmt.Errorf("the size of the objectstore must be positive, got %dGB", v.MaxSizeGB)
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	return c.NewObjectStore(v)
+}
+
+// ResizeObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) ResizeObjectStore(id string, maxSizeGB int64) (*ObjectStore, error) {
+	if maxSizeGB <= 0 {
+		err := fmt.Errorf("the size of the objectstore must be positive, got %dGB", maxSizeGB)
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	return c.UpdateObjectStore(id, &UpdateObjectStoreRequest{MaxSizeGB: maxSizeGB})
+}
+
+// UpdateObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error) {
+	for i, store := range c.ObjectStores {
+		if store.ID == id {
+			c.ObjectStores[i].MaxSize = int(v.MaxSizeGB)
+			return &c.ObjectStores[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteObjectStore implemented in a fake way for automated tests
+func (c *FakeClient) DeleteObjectStore(id string) (*SimpleResponse, error) {
+	for i, store := range c.ObjectStores {
+		if store.ID == id {
+			c.ObjectStores[len(c.ObjectStores)-1], c.ObjectStores[i] = c.ObjectStores[i], c.ObjectStores[len(c.ObjectStores)-1]
+			c.ObjectStores = c.ObjectStores[:len(c.ObjectStores)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// GetObjectStoreStats implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreStats(id string) (*ObjectStoreStats, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectStoreStats{MaxSizeKB: int64(store.MaxSize) * 1024 * 1024}, nil
+}
+
+// GetObjectStoreS3Config implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	config := store.S3Config()
+	config.SecretAccessKey = c.generateID()
+	return config, nil
+}
+
+// ListObjectStoreCredentials implemented in a fake way for automated tests
+func (c *FakeClient) ListObjectStoreCredentials(page, perPage int) (*PaginatedObjectStoreCredentials, error) {
+	return &PaginatedObjectStoreCredentials{
+		Items:   c.ObjectStoreCredentials,
+		Page:    1,
+		PerPage: len(c.ObjectStoreCredentials),
+		Pages:   1,
+	}, nil
+}
+
+// GetObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreCredential(id string) (*ObjectStoreCredential, error) {
+	for _, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			return &credential, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) FindObjectStoreCredential(search string) (*ObjectStoreCredential, error) {
+	for _, credential := range c.ObjectStoreCredentials {
+		if credential.ID == search || strings.Contains(credential.Name, search) {
+			return &credential, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// NewObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) NewObjectStoreCredential(v *CreateObjectStoreCredentialRequest) (*ObjectStoreCredential, error) {
+	credential := ObjectStoreCredential{
+		ID:                c.generateID(),
+		Name:              v.Name,
+		AccessKeyID:       c.generateID(),
+		SecretAccessKeyID: c.generateID(),
+		Status:            "ready",
+	}
+	if v.AccessKeyID != nil {
+		credential.AccessKeyID = *v.AccessKeyID
+	}
+	if v.SecretAccessKeyID != nil {
+		credential.SecretAccessKeyID = *v.SecretAccessKeyID
+	}
+	if v.MaxSizeGB != nil {
+		credential.MaxSizeGB = *v.MaxSizeGB
+	}
+	c.ObjectStoreCredentials = append(c.ObjectStoreCredentials, credential)
+	return &credential, nil
+}
+
+// CreateObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) CreateObjectStoreCredential(name string) (*ObjectStoreCredential, error) {
+	return c.NewObjectStoreCredential(&CreateObjectStoreCredentialRequest{Name: name})
+}
+
+// GetObjectStoreCredentialKeys implemented in a fake way for automated tests
+func (c *FakeClient) GetObjectStoreCredentialKeys(id string) (accessKeyID, secretAccessKey string, err error) {
+	credential, err := c.GetObjectStoreCredential(id)
+	if err != nil {
+		return "", "", err
+	}
+
+	return credential.AccessKeyID, credential.SecretAccessKeyID, nil
+}
+
+// UpdateObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) UpdateObjectStoreCredential(id string, v *UpdateObjectStoreCredentialRequest) (*ObjectStoreCredential, error) {
+	for i, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			if v.AccessKeyID != nil {
+				c.ObjectStoreCredentials[i].AccessKeyID = *v.AccessKeyID
+			}
+			if v.SecretAccessKeyID != nil {
+				c.ObjectStoreCredentials[i].SecretAccessKeyID = *v.SecretAccessKeyID
+			}
+			if v.MaxSizeGB != nil {
+				c.ObjectStoreCredentials[i].MaxSizeGB = *v.MaxSizeGB
+			}
+			return &c.ObjectStoreCredentials[i], nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", id)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DeleteObjectStoreCredential implemented in a fake way for automated tests
+func (c *FakeClient) DeleteObjectStoreCredential(id string) (*SimpleResponse, error) {
+	for i, credential := range c.ObjectStoreCredentials {
+		if credential.ID == id {
+			c.ObjectStoreCredentials[len(c.ObjectStoreCredentials)-1], c.ObjectStoreCredentials[i] = c.ObjectStoreCredentials[i], c.ObjectStoreCredentials[len(c.ObjectStoreCredentials)-1]
+			c.ObjectStoreCredentials = c.ObjectStoreCredentials[:len(c.ObjectStoreCredentials)-1]
+			return &SimpleResponse{Result: "success"}, nil
+		}
+	}
+
+	return &SimpleResponse{Result: "failed"}, nil
+}
+
+// FindTeam implemented in a fake way for automated tests
+func (c *FakeClient) FindTeam(search string) (*Team, error) {
+	for _, team := range c.OrganisationTeams {
+		if team.ID == search || strings.Contains(team.Name, search) {
+			return &team, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s team, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// FindRegion implemented in a fake way for automated tests
+func (c *FakeClient) FindRegion(search string) (*Region, error) {
+	regions, _ := c.ListRegions()
+	for _, region := range regions {
+		if strings.EqualFold(region.Code, search) || strings.Contains(strings.ToUpper(region.Name), strings.ToUpper(search)) {
+			return &region, nil
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// GetDefaultRegion implemented in a fake way for automated tests
+func (c *FakeClient) GetDefaultRegion() (*Region, error) {
+	regions, _ := c.ListRegions()
+	for _, region := range regions {
+		if region.Default {
+			return &region, nil
+		}
+	}
+
+	return nil, errors.New("no default region found")
+}
+
+// ListVolumesForCluster implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumesForCluster(clusterID string) ([]Volume, error) {
+	var volumes []Volume
+	for _, volume := range c.Volumes {
+		if volume.ClusterID != "" && volume.ClusterID == clusterID {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	return volumes, nil
+}
+
+// ListDanglingVolumes implemented in a fake way for automated tests
+func (c *FakeClient) ListDanglingVolumes() ([]Volume, error) {
+	clusterIDs := []string{}
+	for _, cluster := range c.Clusters {
+		clusterIDs = append(clusterIDs, cluster.ID)
+	}
+
+	var danglingVolumes = make([]Volume, 0)
+	for _, volume := range c.Volumes {
+		if volume.ClusterID != "" && !findString(clusterIDs, volume.ClusterID) {
+			danglingVolumes = append(danglingVolumes, volume)
+		}
+	}
+
+	return danglingVolumes, nil
+}
+
+// ListVolumeTypes implemented in a fake way for automated tests
+func (c *FakeClient) ListVolumeTypes() ([]VolumeType, error) {
+	return []VolumeType{
+		{
+			Name:        "standard",
+			Description: "Standard block storage",
+			Enabled:     true,
+		},
+	}, nil
+}
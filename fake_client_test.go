@@ -3,6 +3,7 @@ package civogo
 import (
 	"errors"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -85,6 +86,28 @@ func TestInstances(t *testing.T) {
 	}
 }
 
+func TestFakeLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	instance, err := client.CreateInstance(&InstanceConfig{Hostname: "foo.example.com", Labels: map[string]string{"env": "staging"}})
+	g.Expect(err).To(BeNil())
+
+	labels, err := client.MergeLabels(LabelledInstance, instance.ID, map[string]string{"env": "prod", "app": "web"})
+	g.Expect(err).To(BeNil())
+	g.Expect(labels).To(Equal(map[string]string{"env": "prod", "app": "web"}))
+
+	labels, err = client.ReplaceLabels(LabelledInstance, instance.ID, map[string]string{"app": "api"})
+	g.Expect(err).To(BeNil())
+	g.Expect(labels).To(Equal(map[string]string{"app": "api"}))
+
+	found, _ := client.GetInstance(instance.ID)
+	g.Expect(found.Labels).To(Equal(map[string]string{"app": "api"}))
+
+	_, err = client.MergeLabels(LabelledVolume, "missing", map[string]string{"app": "api"})
+	g.Expect(err).To(MatchError(ZeroMatchesError))
+}
+
 // TestLoadBalancers is a test for the LoadBalancers method.
 func TestLoadBalancers(t *testing.T) {
 	g := NewWithT(t)
@@ -172,7 +195,7 @@ func TestKubernetesClustersInstances(t *testing.T) {
 			},
 		},
 	}
-	client.Instances = []Instance{
+	client.InstanceList = []Instance{
 		{
 			ID:       "ad0dbf3f-4036-47f5-b33b-6822cf90799c0",
 			Hostname: "foo",
@@ -188,6 +211,236 @@ func TestKubernetesClustersInstances(t *testing.T) {
 	g.Expect(instance.Hostname).To(Equal("foo"))
 }
 
+// TestRecycleKubernetesNode is a test for the RecycleKubernetesNode method.
+func TestRecycleKubernetesNode(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.Clusters = []KubernetesCluster{
+		{
+			ID:        "9c89d8b9",
+			Name:      "foo-cluster",
+			Instances: []KubernetesInstance{{ID: "ad0dbf3f", Hostname: "foo"}},
+			Pools:     []KubernetesPool{{ID: "workers", Instances: []KubernetesInstance{{ID: "ad0dbf3f", Hostname: "foo"}}}},
+		},
+	}
+	client.InstanceList = []Instance{{ID: "ad0dbf3f", Hostname: "foo", Status: "ACTIVE"}}
+
+	recycled, err := client.RecycleKubernetesNode("9c89d8b9", "foo")
+	g.Expect(err).To(BeNil())
+	g.Expect(recycled.ID).To(Equal("ad0dbf3f"))
+	g.Expect(recycled.PoolID).To(Equal("workers"))
+
+	instances, err := client.ListKubernetesClusterInstances("9c89d8b9")
+	g.Expect(err).To(BeNil())
+	g.Expect(instances).To(HaveLen(1))
+	g.Expect(instances[0].ID).ToNot(Equal("ad0dbf3f"))
+	g.Expect(instances[0].PoolID).To(Equal("workers"))
+}
+
+// TestInstanceInitialPassword is a test for the GetInstanceInitialPassword method.
+func TestInstanceInitialPassword(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.InstanceList = []Instance{{ID: "ad0dbf3f", InitialUser: "civo", InitialPassword: "s3cret"}}
+
+	credentials, err := client.GetInstanceInitialPassword("ad0dbf3f")
+	g.Expect(err).To(BeNil())
+	g.Expect(credentials).To(Equal(&InstanceCredentials{User: "civo", Password: "s3cret"}))
+
+	_, err = client.GetInstanceInitialPassword("ad0dbf3f")
+	g.Expect(errors.Is(err, InstancePasswordUnavailableError)).To(BeTrue())
+
+	credentials, err = client.ResetInstancePassword("ad0dbf3f")
+	g.Expect(err).To(BeNil())
+	g.Expect(credentials.Password).ToNot(BeEmpty())
+}
+
+// TestLoadBalancerCertificates is a test for the load balancer certificate methods.
+func TestLoadBalancerCertificates(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	lb, err := client.CreateLoadBalancer(&LoadBalancerConfig{Name: "web"})
+	g.Expect(err).To(BeNil())
+
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour), "example.com")
+	uploaded, err := client.UploadLoadBalancerCertificate(lb.ID, &LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key})
+	g.Expect(err).To(BeNil())
+	g.Expect(uploaded.Domains).To(Equal([]string{"example.com"}))
+
+	cert, key = testCertificate(t, time.Now().Add(48*time.Hour), "example.com")
+	rotated, err := client.RotateLoadBalancerCertificate(lb.ID, uploaded.ID, &LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key})
+	g.Expect(err).To(BeNil())
+	g.Expect(rotated.ID).To(Equal(uploaded.ID))
+	g.Expect(rotated.Fingerprint).ToNot(Equal(uploaded.Fingerprint))
+
+	certificates, err := client.ListLoadBalancerCertificates(lb.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(certificates).To(HaveLen(1))
+
+	_, err = client.UpdateLoadBalancer(lb.ID, &LoadBalancerUpdateConfig{
+		SNIRoutes: []LoadBalancerSNIRoute{{Hostname: "example.com", CertificateID: uploaded.ID}},
+	})
+	g.Expect(err).To(BeNil())
+	_, err = client.DeleteLoadBalancerCertificate(lb.ID, uploaded.ID)
+	g.Expect(err).ToNot(BeNil())
+
+	_, err = client.UpdateLoadBalancer(lb.ID, &LoadBalancerUpdateConfig{SNIRoutes: []LoadBalancerSNIRoute{}})
+	g.Expect(err).To(BeNil())
+	_, err = client.DeleteLoadBalancerCertificate(lb.ID, uploaded.ID)
+	g.Expect(err).To(BeNil())
+}
+
+// TestLoadBalancerBackendHealth is a test for the load balancer backend health method.
+func TestLoadBalancerBackendHealth(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.InstanceList = []Instance{{ID: "i-1", Hostname: "web-1", Status: "SHUTOFF"}}
+	lb, err := client.CreateLoadBalancer(&LoadBalancerConfig{
+		Name: "web",
+		Backends: []LoadBalancerBackendConfig{
+			{IP: "10.0.0.1", InstanceID: "i-1", TargetPort: 8080},
+			{IP: "10.0.0.2", TargetPort: 8080},
+		},
+	})
+	g.Expect(err).To(BeNil())
+
+	health, err := client.GetLoadBalancerBackendHealth(lb.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(health).To(HaveLen(2))
+	g.Expect(health[0].Healthy()).To(BeFalse())
+	g.Expect(health[0].FailureReason).To(Equal("instance web-1 is SHUTOFF"))
+	g.Expect(health[1].Healthy()).To(BeTrue())
+}
+
+// TestDNSTTL is a test for the DNS TTL methods.
+func TestDNSTTL(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	domain, err := client.CreateDNSDomain("example.com")
+	g.Expect(err).To(BeNil())
+	_, err = client.CreateDNSRecords(domain.ID, []DNSRecordConfig{
+		{Type: DNSRecordTypeA, Name: "www", Value: "10.0.0.1", TTL: 3600},
+		{Type: DNSRecordTypeA, Name: "mail", Value: "10.0.0.2", TTL: 60},
+	})
+	g.Expect(err).To(BeNil())
+
+	domain, err = client.SetDomainDefaultTTL(domain.ID, 60)
+	g.Expect(err).To(BeNil())
+	g.Expect(domain.DefaultTTL).To(Equal(60))
+
+	results, err := client.UpdateAllRecordsTTL(domain.ID, 60)
+	g.Expect(err).To(BeNil())
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].Record.Name).To(Equal("www"))
+	g.Expect(results[0].Record.TTL).To(Equal(60))
+}
+
+// TestInstanceListOptions is a test for the instance list options.
+func TestInstanceListOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.InstanceList = []Instance{
+		{ID: "1", Hostname: "web-1", Tags: []string{"prod"}},
+		{ID: "2", Hostname: "web-2"},
+		{ID: "3", Hostname: "db-1", Tags: []string{"prod"}},
+	}
+
+	instances, err := client.ListAllInstances(ListOptions{Search: "WEB-"})
+	g.Expect(err).To(BeNil())
+	g.Expect(instances).To(HaveLen(2))
+
+	page, err := client.ListInstancesWithOptions(ListOptions{Search: "web-", Tag: "prod"})
+	g.Expect(err).To(BeNil())
+	g.Expect(page.Items).To(HaveLen(1))
+	g.Expect(page.Items[0].ID).To(Equal("1"))
+}
+
+func TestCreateInstancesInBulk(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	results, err := client.CreateInstances(InstanceConfig{Size: "g3.small", Tags: []string{"web"}}, 3, "web-%02d")
+	g.Expect(err).To(BeNil())
+	g.Expect(results).To(HaveLen(3))
+	g.Expect(results[2].Instance.Hostname).To(Equal("web-03"))
+
+	instances, err := client.ListInstancesByTag("web")
+	g.Expect(err).To(BeNil())
+	g.Expect(instances).To(HaveLen(3))
+
+	_, err = client.CreateInstances(InstanceConfig{}, 2, "web")
+	g.Expect(errors.Is(err, InstanceConfigInvalidError)).To(BeTrue())
+
+	results, err = client.StopInstancesByTag("web")
+	g.Expect(err).To(BeNil())
+	g.Expect(results).To(HaveLen(3))
+	g.Expect(client.InstanceList[0].Status).To(Equal("SHUTOFF"))
+
+	results, err = client.StopInstancesByTag("web")
+	g.Expect(err).To(BeNil())
+	g.Expect(results).To(BeEmpty())
+
+	_, err = client.StartInstances([]string{client.InstanceList[1].ID, "missing"})
+	g.Expect(errors.Is(err, InstanceBulkOperationFailedError)).To(BeTrue())
+	g.Expect(client.InstanceList[1].Status).To(Equal("ACTIVE"))
+}
+
+// TestPoolAutoscaling is a test for the pool autoscaling methods.
+func TestPoolAutoscaling(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	client.Clusters = []KubernetesCluster{
+		{ID: "9c89d8b9", Pools: []KubernetesPool{{ID: "workers", Count: 2}}},
+	}
+
+	pool, err := client.EnablePoolAutoscaling("9c89d8b9", "workers", 1, 5)
+	g.Expect(err).To(BeNil())
+	g.Expect(pool.AutoscalingEnabled()).To(BeTrue())
+	g.Expect(pool.MinCount).To(Equal(1))
+	g.Expect(pool.MaxCount).To(Equal(5))
+
+	_, err = client.EnablePoolAutoscaling("9c89d8b9", "workers", 3, 2)
+	g.Expect(errors.Is(err, KubernetesPoolInvalidError)).To(BeTrue())
+
+	count := 4
+	_, err = client.UpdateKubernetesClusterPool("9c89d8b9", "workers", &KubernetesClusterPoolUpdateConfig{Count: &count})
+	g.Expect(err).To(BeNil())
+
+	events, err := client.GetClusterScalingEvents("9c89d8b9")
+	g.Expect(err).To(BeNil())
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Action).To(Equal(KubernetesScalingActionScaleUp))
+	g.Expect(events[0].FromCount).To(Equal(2))
+	g.Expect(events[0].ToCount).To(Equal(4))
+
+	pool, err = client.DisablePoolAutoscaling("9c89d8b9", "workers")
+	g.Expect(err).To(BeNil())
+	g.Expect(pool.AutoscalingEnabled()).To(BeFalse())
+}
+
 // TestKubernetesClustersPools is a test for the KubernetesClustersPools method.
 func TestKubernetesClustersPools(t *testing.T) {
 	g := NewWithT(t)
@@ -284,3 +537,203 @@ func TestPing(t *testing.T) {
 		t.Errorf("Expected nil, got '%v'", err)
 	}
 }
+
+func TestDatabases(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	db, err := client.NewDatabase(&CreateDatabaseRequest{Name: "test-db", Size: "g3.db.small", Software: "MySQL", Nodes: 1})
+	g.Expect(err).To(BeNil())
+	g.Expect(db.Name).To(Equal("test-db"))
+
+	found, err := client.FindDatabase("test")
+	g.Expect(err).To(BeNil())
+	g.Expect(found.ID).To(Equal(db.ID))
+
+	nodes := 3
+	db, err = client.UpdateDatabase(db.ID, &UpdateDatabaseRequest{Nodes: &nodes})
+	g.Expect(err).To(BeNil())
+	g.Expect(db.Nodes).To(Equal(3))
+
+	backup, err := client.CreateDatabaseBackup(db.ID, &DatabaseBackupCreateRequest{Name: "nightly"})
+	g.Expect(err).To(BeNil())
+	g.Expect(backup.DatabaseID).To(Equal(db.ID))
+
+	backups, err := client.ListDatabaseBackup(db.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(backups.Items).To(HaveLen(1))
+
+	resp, err := client.DeleteDatabaseBackup(db.ID, backup.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+
+	resp, err = client.DeleteDatabase(db.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+
+	_, err = client.GetDatabase(db.ID)
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+func TestObjectStores(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	store, err := client.NewObjectStore(&CreateObjectStoreRequest{Name: "test-bucket", MaxSizeGB: 500})
+	g.Expect(err).To(BeNil())
+
+	store, err = client.UpdateObjectStore(store.ID, &UpdateObjectStoreRequest{MaxSizeGB: 1000})
+	g.Expect(err).To(BeNil())
+	g.Expect(store.MaxSize).To(Equal(1000))
+
+	stores, err := client.ListObjectStores()
+	g.Expect(err).To(BeNil())
+	g.Expect(stores.Items).To(HaveLen(1))
+
+	resp, err := client.DeleteObjectStore(store.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+}
+
+func TestSubnets(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	network, err := client.NewNetwork("test-network")
+	g.Expect(err).To(BeNil())
+
+	subnet, err := client.CreateSubnet(network.ID, SubnetConfig{Name: "test-subnet"})
+	g.Expect(err).To(BeNil())
+
+	found, err := client.FindSubnet("test", network.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(found.ID).To(Equal(subnet.ID))
+
+	subnets, err := client.ListSubnets(network.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(subnets).To(HaveLen(1))
+
+	resp, err := client.DeleteSubnet(network.ID, subnet.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(resp).To(Equal(&SimpleResponse{Result: "success"}))
+}
+
+func TestFakeFindSizeByResources(t *testing.T) {
+	client, _ := NewFakeClient()
+
+	size, err := client.FindSizeByResources(2, 2048)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if size.Name != "g3.small" {
+		t.Errorf("Expected g3.small, got %s", size.Name)
+	}
+}
+
+func TestKubernetesGPUPools(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+	client.InstanceSizes = []InstanceSize{
+		{Name: "g4s.kube.small", Selectable: true},
+		{Name: "an1.kube.a100.x2", GPUCount: 2, GPUType: "A100", Selectable: true},
+	}
+
+	cluster, err := client.NewKubernetesClusters(&KubernetesClusterConfig{
+		Name:  "ml",
+		Pools: []KubernetesClusterPoolConfig{{Count: 2, Size: "an1.kube.a100.x2", GPUType: "A100", GPUCount: 2}},
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(cluster.Pools[0].GPUCount).To(Equal(2))
+	g.Expect(cluster.Instances[0].GPUType).To(Equal("A100"))
+
+	_, err = client.CreateKubernetesClusterPool(cluster.ID, &KubernetesClusterPoolConfig{Count: 1, Size: "g4s.kube.small", GPUCount: 1})
+	g.Expect(errors.Is(err, KubernetesPoolInvalidError)).To(BeTrue())
+}
+
+func TestPlacementGroups(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	group, err := client.CreatePlacementGroup(&PlacementGroupConfig{Name: "db-ha"})
+	g.Expect(err).To(BeNil())
+	g.Expect(group.Policy).To(Equal(PlacementGroupPolicyAntiAffinity))
+
+	instance, err := client.CreateInstance(&InstanceConfig{Hostname: "db-1", PlacementGroupID: group.ID})
+	g.Expect(err).To(BeNil())
+	g.Expect(instance.PlacementGroupID).To(Equal(group.ID))
+
+	group, err = client.GetPlacementGroup(group.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(group.InstanceIDs).To(Equal([]string{instance.ID}))
+
+	_, err = client.CreateInstance(&InstanceConfig{Hostname: "db-2", PlacementGroupID: "missing"})
+	g.Expect(errors.Is(err, ZeroMatchesError)).To(BeTrue())
+}
+
+func TestFakeAddAccountToOrganisation(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+	client.Account = Account{ID: "account-1", Label: "staging"}
+
+	accounts, err := client.AddAccountToOrganisation("org-1", "token")
+	g.Expect(err).To(BeNil())
+	g.Expect(accounts).To(HaveLen(1))
+	g.Expect(accounts[0].ID).To(Equal("account-1"))
+	g.Expect(accounts[0].Label).To(Equal("staging"))
+
+	accounts, err = client.AddAccountToOrganisation("org-1", "token")
+	g.Expect(err).To(BeNil())
+	g.Expect(accounts).To(HaveLen(1))
+}
+
+func TestFakeCreateFirewallValidatesRules(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	_, err = client.CreateFirewall(&FirewallConfig{
+		Name:  "web",
+		Rules: []FirewallRule{{Protocol: "tcp", Ports: "80,70000", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress"}},
+	})
+	g.Expect(errors.Is(err, FirewallRuleInvalidError)).To(BeTrue())
+	g.Expect(client.Firewalls).To(BeEmpty())
+
+	_, err = client.CreateFirewall(&FirewallConfig{
+		Name:  "web",
+		Rules: []FirewallRule{{Protocol: "tcp", Ports: "80,443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress"}},
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(client.Firewalls).To(HaveLen(1))
+}
+
+func TestFakeCreateSnapshotScheduleValidates(t *testing.T) {
+	g := NewWithT(t)
+
+	client, err := NewFakeClient()
+	g.Expect(err).To(BeNil())
+
+	_, err = client.CreateSnapshotSchedule(&SnapshotScheduleConfig{Name: "nightly", CronExpression: "0 2 * * *", VolumeIDs: []string{"vol-1"}})
+	g.Expect(errors.Is(err, SnapshotScheduleInvalidError)).To(BeTrue())
+
+	retention := SnapshotScheduleRetentionPolicy{MaxSnapshots: 7}
+	_, err = client.CreateSnapshotSchedule(&SnapshotScheduleConfig{Name: "nightly", CronExpression: "0 2 * * *", RetentionPolicy: retention})
+	g.Expect(errors.Is(err, SnapshotScheduleInvalidError)).To(BeTrue())
+	g.Expect(client.SnapshotSchedules).To(BeEmpty())
+
+	_, err = client.CreateSnapshotSchedule(&SnapshotScheduleConfig{Name: "nightly", CronExpression: "0 2 * * *", RetentionPolicy: retention, VolumeIDs: []string{"vol-1"}})
+	g.Expect(err).To(BeNil())
+	g.Expect(client.SnapshotSchedules).To(HaveLen(1))
+}
@@ -0,0 +1,325 @@
+package civogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fakeServerResource is a collection of the API FakeCivoServer keeps state for
+type fakeServerResource struct {
+	// path of the collection after /v2/, a * stands for the ID of the parent, e.g. "dns/*/records"
+	path string
+	// paginated lists are wrapped in a page, like PaginatedInstanceList
+	paginated bool
+	// notFound is the error code answered for missing items
+	notFound string
+	// parentField is set to the ID of the parent on the items of nested collections
+	parentField string
+	// defaults are set on new items which don't have them
+	defaults map[string]interface{}
+}
+
+// fakeServerResources are the collections FakeCivoServer implements
+var fakeServerResources = []fakeServerResource{
+	{path: "instances", paginated: true, notFound: "database_instance_find", defaults: map[string]interface{}{"status": string(InstanceStatusActive)}},
+	{path: "kubernetes/clusters", paginated: true, notFound: "database_kubernetes_cluster_not_found", defaults: map[string]interface{}{"status": string(ClusterStatusActive), "ready": true}},
+	{path: "networks", notFound: "database_network_not_found", defaults: map[string]interface{}{"status": "Active"}},
+	{path: "firewalls", notFound: "database_firewall_not_found"},
+	{path: "firewalls/*/rules", notFound: "database_firewall_rules_find", parentField: "firewall_id"},
+	{path: "volumes", notFound: "database_volume_not_found", defaults: map[string]interface{}{"status": VolumeStatusAvailable}},
+	{path: "sshkeys", notFound: "database_ssh_key_not_found"},
+	{path: "dns", notFound: "database_dns_domain_not_found"},
+	{path: "dns/*/records", notFound: "database_dns_record_not_found", parentField: "domain_id"},
+}
+
+// fakeServerCollection holds the items of a collection in the order they were created
+type fakeServerCollection struct {
+	ids   []string
+	items map[string]map[string]interface{}
+}
+
+// FakeCivoServer is an in-memory API with stateful CRUD for instances, Kubernetes clusters, networks,
+// firewalls and their rules, volumes, SSH keys and DNS domains and their records: creating returns an ID
+// and later lists, gets, updates and deletes reflect it, so controllers can be tested end to end without
+// the real API. Every other endpoint answers 501 Not Implemented. A default network is created with the server,
+// and faults can be injected with InjectFaults
+type FakeCivoServer struct {
+	*httptest.Server
+
+	faults      *FaultInjector
+	mu          sync.Mutex
+	lastID      int
+	collections map[string]*fakeServerCollection
+}
+
+// NewFakeCivoServer starts a FakeCivoServer, close it when done
+func NewFakeCivoServer() *FakeCivoServer {
+	s := &FakeCivoServer{collections: map[string]*fakeServerCollection{}}
+	s.faults = NewFaultInjector(http.HandlerFunc(s.serveHTTP))
+	s.Server = httptest.NewServer(s.faults)
+	s.Add("networks", Network{Name: "Default", Label: "Default", Default: true, Status: "Active"})
+	return s
+}
+
+// NewClientForTestingWithFakeServer returns a client connected to a new FakeCivoServer, close the server when done
+func NewClientForTestingWithFakeServer() (*Client, *FakeCivoServer, error) {
+	server := NewFakeCivoServer()
+	client, err := NewClientForTestingWithServer(server.Server)
+	if err != nil {
+		server.Close()
+		return nil, nil, err
+	}
+	return client, server, nil
+}
+
+// InjectFaults makes the server misbehave as rules say, replacing the rules given before; none restores it
+func (s *FakeCivoServer) InjectFaults(rules ...FaultRule) {
+	s.faults.SetRules(rules...)
+}
+
+// Add stores object, e.g. an Instance, in the collection at path (e.g. "instances" or "dns/<domain ID>/records")
+// as if it was created through the API and returns its ID, generated if the object has none
+func (s *FakeCivoServer) Add(path string, object interface{}) (string, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	item := map[string]interface{}{}
+	if err := json.Unmarshal(data, &item); err != nil {
+		return "", err
+	}
+
+	resource, parentID, ok := s.resource(strings.Split(strings.Trim(path, "/"), "/"))
+	if !ok {
+		return "", fmt.Errorf("the fake server has no collection %s", path)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.create(path, resource, parentID, item), nil
+}
+
+// serveHTTP routes a request to its collection or item
+func (s *FakeCivoServer) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/v2/"), "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if resource, parentID, ok := s.resource(segments); ok {
+		path := strings.Join(segments, "/")
+		if !s.parentExists(resource, parentID) {
+			s.notFound(rw, resource.parent())
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			s.list(rw, path, resource)
+		case http.MethodPost:
+			item, ok := s.decode(rw, req)
+			if ok {
+				id := s.create(path, resource, parentID, item)
+				s.write(rw, http.StatusOK, withResult(s.collections[path].items[id]))
+			}
+		default:
+			s.notImplemented(rw, req)
+		}
+		return
+	}
+
+	if len(segments) < 2 {
+		s.notImplemented(rw, req)
+		return
+	}
+	resource, parentID, ok := s.resource(segments[:len(segments)-1])
+	if !ok {
+		s.notImplemented(rw, req)
+		return
+	}
+	path, id := strings.Join(segments[:len(segments)-1], "/"), segments[len(segments)-1]
+	collection := s.collections[path]
+	if !s.parentExists(resource, parentID) || collection == nil || collection.items[id] == nil {
+		s.notFound(rw, resource)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		s.write(rw, http.StatusOK, collection.items[id])
+	case http.MethodPut, http.MethodPatch:
+		update, ok := s.decode(rw, req)
+		if ok {
+			delete(update, "id")
+			fakeServerItem(update)
+			for field, value := range update {
+				collection.items[id][field] = value
+			}
+			s.write(rw, http.StatusOK, withResult(collection.items[id]))
+		}
+	case http.MethodDelete:
+		delete(collection.items, id)
+		for i, existing := range collection.ids {
+			if existing == id {
+				collection.ids = append(collection.ids[:i], collection.ids[i+1:]...)
+				break
+			}
+		}
+		for nested := range s.collections {
+			if strings.HasPrefix(nested, path+"/"+id+"/") {
+				delete(s.collections, nested)
+			}
+		}
+		s.write(rw, http.StatusOK, map[string]interface{}{"id": id, "result": "success"})
+	default:
+		s.notImplemented(rw, req)
+	}
+}
+
+// resource returns the collection the path segments point at, with the ID of its parent for nested ones
+func (s *FakeCivoServer) resource(segments []string) (fakeServerResource, string, bool) {
+	for _, resource := range fakeServerResources {
+		pattern := strings.Split(resource.path, "/")
+		if len(pattern) != len(segments) {
+			continue
+		}
+
+		parentID, matched := "", true
+		for i, part := range pattern {
+			if part == "*" {
+				parentID = segments[i]
+			} else if part != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return resource, parentID, true
+		}
+	}
+	return fakeServerResource{}, "", false
+}
+
+// parent returns the collection a nested collection belongs to
+func (r fakeServerResource) parent() fakeServerResource {
+	path, _, _ := strings.Cut(r.path, "/*/")
+	for _, resource := range fakeServerResources {
+		if resource.path == path {
+			return resource
+		}
+	}
+	return r
+}
+
+// parentExists reports whether the parent item of a nested collection exists, collections at the top always do
+func (s *FakeCivoServer) parentExists(resource fakeServerResource, parentID string) bool {
+	if resource.parentField == "" {
+		return true
+	}
+	parents := s.collections[resource.parent().path]
+	return parents != nil && parents.items[parentID] != nil
+}
+
+// create stores item in the collection at path and returns its ID
+func (s *FakeCivoServer) create(path string, resource fakeServerResource, parentID string, item map[string]interface{}) string {
+	collection := s.collections[path]
+	if collection == nil {
+		collection = &fakeServerCollection{items: map[string]map[string]interface{}{}}
+		s.collections[path] = collection
+	}
+
+	id, _ := item["id"].(string)
+	if id == "" {
+		s.lastID++
+		id = fmt.Sprintf("fake-%d", s.lastID)
+	}
+	item["id"] = id
+	delete(item, "result")
+	if resource.parentField != "" {
+		item[resource.parentField] = parentID
+	}
+	for field, value := range resource.defaults {
+		if _, ok := item[field]; !ok {
+			item[field] = value
+		}
+	}
+	if _, ok := item["created_at"]; !ok {
+		item["created_at"] = time.Now().UTC().Format(time.RFC3339)
+	}
+	fakeServerItem(item)
+
+	if collection.items[id] == nil {
+		collection.ids = append(collection.ids, id)
+	}
+	collection.items[id] = item
+	return id
+}
+
+// fakeServerItem turns the fields of a config which differ from those of the resource into the latter,
+// e.g. tags are sent as a space separated string and returned as a list
+func fakeServerItem(item map[string]interface{}) {
+	if tags, ok := item["tags"].(string); ok {
+		item["tags"] = strings.Fields(tags)
+	}
+	if label, ok := item["label"].(string); ok && item["name"] == nil {
+		item["name"] = label
+	}
+	if publicIP, ok := item["public_ip"].(string); ok && (publicIP == "create" || publicIP == "none") {
+		delete(item, "public_ip")
+	}
+}
+
+// list writes the items of the collection at path, in a page for paginated collections
+func (s *FakeCivoServer) list(rw http.ResponseWriter, path string, resource fakeServerResource) {
+	items := []map[string]interface{}{}
+	if collection := s.collections[path]; collection != nil {
+		for _, id := range collection.ids {
+			items = append(items, collection.items[id])
+		}
+	}
+
+	if !resource.paginated {
+		s.write(rw, http.StatusOK, items)
+		return
+	}
+	s.write(rw, http.StatusOK, map[string]interface{}{"page": 1, "per_page": len(items), "pages": 1, "items": items})
+}
+
+// decode reads the JSON body of req, answering a 400 if it isn't an object
+func (s *FakeCivoServer) decode(rw http.ResponseWriter, req *http.Request) (map[string]interface{}, bool) {
+	item := map[string]interface{}{}
+	if err := json.NewDecoder(req.Body).Decode(&item); err != nil {
+		s.write(rw, http.StatusBadRequest, map[string]string{"code": "parameter_invalid", "reason": err.Error()})
+		return nil, false
+	}
+	return item, true
+}
+
+// notFound answers a 404 with the error code of the resource
+func (s *FakeCivoServer) notFound(rw http.ResponseWriter, resource fakeServerResource) {
+	s.write(rw, http.StatusNotFound, map[string]string{"code": resource.notFound, "reason": "The resource could not be found"})
+}
+
+// notImplemented answers a 501 for the endpoints the fake server doesn't keep state for
+func (s *FakeCivoServer) notImplemented(rw http.ResponseWriter, req *http.Request) {
+	reason := fmt.Sprintf("%s %s is not implemented by the fake server", req.Method, req.URL.Path)
+	s.write(rw, http.StatusNotImplemented, map[string]string{"code": "not_implemented", "reason": reason})
+}
+
+// write answers with value as JSON
+func (s *FakeCivoServer) write(rw http.ResponseWriter, status int, value interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(value)
+}
+
+// withResult returns a copy of item with a successful result, as create and update responses have
+func withResult(item map[string]interface{}) map[string]interface{} {
+	response := cloneMap(item)
+	response["result"] = "success"
+	return response
+}
@@ -0,0 +1,97 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFakeCivoServerInstances(t *testing.T) {
+	g := NewWithT(t)
+
+	client, server, err := NewClientForTestingWithFakeServer()
+	g.Expect(err).To(BeNil())
+	defer server.Close()
+
+	network, err := client.GetDefaultNetwork()
+	g.Expect(err).To(BeNil())
+	g.Expect(network.Default).To(BeTrue())
+
+	created, err := client.CreateInstance(&InstanceConfig{Hostname: "web-1", Size: "g3.small", NetworkID: network.ID, Tags: []string{"web", "prod"}, PublicIPRequired: "create"})
+	g.Expect(err).To(BeNil())
+	g.Expect(created.ID).NotTo(BeEmpty())
+
+	instance, err := client.GetInstance(created.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(instance.Hostname).To(Equal("web-1"))
+	g.Expect(instance.Tags).To(Equal([]string{"web", "prod"}))
+	g.Expect(instance.Status).To(Equal("ACTIVE"))
+	g.Expect(instance.CreatedAt.IsZero()).To(BeFalse())
+
+	instances, err := client.ListAllInstances()
+	g.Expect(err).To(BeNil())
+	g.Expect(instances).To(HaveLen(1))
+
+	_, err = client.DeleteInstance(created.ID)
+	g.Expect(err).To(BeNil())
+
+	_, err = client.GetInstance(created.ID)
+	g.Expect(errors.Is(err, ErrResourceNotFound)).To(BeTrue())
+	instances, err = client.ListAllInstances()
+	g.Expect(err).To(BeNil())
+	g.Expect(instances).To(BeEmpty())
+}
+
+func TestFakeCivoServerNestedCollections(t *testing.T) {
+	g := NewWithT(t)
+
+	client, server, err := NewClientForTestingWithFakeServer()
+	g.Expect(err).To(BeNil())
+	defer server.Close()
+
+	domain, err := client.CreateDNSDomain("example.com")
+	g.Expect(err).To(BeNil())
+
+	_, err = client.CreateDNSRecord(domain.ID, &DNSRecordConfig{Type: DNSRecordTypeA, Name: "www", Value: "10.0.0.1", TTL: 600})
+	g.Expect(err).To(BeNil())
+
+	records, err := client.ListDNSRecords(domain.ID)
+	g.Expect(err).To(BeNil())
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0].DNSDomainID).To(Equal(domain.ID))
+	g.Expect(records[0].TTL).To(Equal(600))
+
+	_, err = client.DeleteDNSDomain(domain)
+	g.Expect(err).To(BeNil())
+
+	_, err = client.ListDNSRecords(domain.ID)
+	g.Expect(errors.Is(err, ErrResourceNotFound)).To(BeTrue())
+}
+
+func TestFakeCivoServerAdd(t *testing.T) {
+	g := NewWithT(t)
+
+	client, server, err := NewClientForTestingWithFakeServer()
+	g.Expect(err).To(BeNil())
+	defer server.Close()
+
+	id, err := server.Add("firewalls", Firewall{Name: "web"})
+	g.Expect(err).To(BeNil())
+	_, err = server.Add("firewalls/"+id+"/rules", FirewallRule{Protocol: "tcp", StartPort: "443", Cidr: []string{"0.0.0.0/0"}})
+	g.Expect(err).To(BeNil())
+
+	rules, err := client.ListFirewallRules(id)
+	g.Expect(err).To(BeNil())
+	g.Expect(rules).To(HaveLen(1))
+	g.Expect(rules[0].FirewallID).To(Equal(id))
+
+	_, err = server.Add("unknown", Firewall{})
+	g.Expect(err).NotTo(BeNil())
+
+	_, err = client.RebootInstance("fake-1")
+	var apiErr *APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.StatusCode).To(Equal(http.StatusNotImplemented))
+}
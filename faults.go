@@ -0,0 +1,135 @@
+package civogo
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FaultRule makes a test server misbehave for the requests it matches, to test how retries and backoff cope
+type FaultRule struct {
+	// Method and PathPrefix select the requests, e.g. "GET" and "/v2/instances", empty ones match any
+	Method     string
+	PathPrefix string
+
+	// Latency delays every matching response
+	Latency time.Duration
+
+	// RateLimitBurst answers that many matching requests in a row with 429 Too Many Requests, the ones after go through.
+	// RetryAfter is sent as their Retry-After header, in whole seconds
+	RateLimitBurst int
+	RetryAfter     time.Duration
+
+	// ErrorRate is the share of matching requests, from 0 to 1, answered with ErrorStatus (500 if not set)
+	ErrorRate   float64
+	ErrorStatus int
+
+	// Malformed answers with the JSON of the response cut in half
+	Malformed bool
+}
+
+// matches reports whether the rule applies to req
+func (r FaultRule) matches(req *http.Request) bool {
+	return (r.Method == "" || strings.EqualFold(r.Method, req.Method)) && strings.HasPrefix(req.URL.Path, r.PathPrefix)
+}
+
+// FaultInjector wraps the handler of a test server, injecting the faults of the first rule each request matches.
+// The error rate is drawn from a fixed seed so tests are reproducible, see Seed
+type FaultInjector struct {
+	next http.Handler
+
+	mu     sync.Mutex
+	rules  []FaultRule
+	bursts []int
+	rand   *rand.Rand
+}
+
+// NewFaultInjector returns a handler injecting the faults of rules into the responses of next
+func NewFaultInjector(next http.Handler, rules ...FaultRule) *FaultInjector {
+	f := &FaultInjector{next: next, rand: rand.New(rand.NewSource(1))}
+	f.SetRules(rules...)
+	return f
+}
+
+// SetRules replaces the rules, restarting their 429 bursts
+func (f *FaultInjector) SetRules(rules ...FaultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rules = cloneSlice(rules)
+	f.bursts = make([]int, len(rules))
+	for i, rule := range rules {
+		f.bursts[i] = rule.RateLimitBurst
+	}
+}
+
+// Seed changes the seed the error rate is drawn from
+func (f *FaultInjector) Seed(seed int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rand = rand.New(rand.NewSource(seed))
+}
+
+// ServeHTTP answers req, with the faults of the first rule it matches
+func (f *FaultInjector) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rule, rateLimited, failed, ok := f.faultsFor(req)
+	if !ok {
+		f.next.ServeHTTP(rw, req)
+		return
+	}
+
+	if rule.Latency > 0 {
+		select {
+		case <-time.After(rule.Latency):
+		case <-req.Context().Done():
+			return
+		}
+	}
+
+	switch {
+	case rateLimited:
+		rw.Header().Set("Retry-After", strconv.Itoa(int(rule.RetryAfter/time.Second)))
+		rw.WriteHeader(http.StatusTooManyRequests)
+		rw.Write([]byte(`{"code": "too_many_requests", "reason": "Rate limit exceeded (injected)"}`))
+	case failed:
+		status := rule.ErrorStatus
+		if status == 0 {
+			status = http.StatusInternalServerError
+		}
+		rw.WriteHeader(status)
+		rw.Write([]byte(`{"code": "internal_error", "reason": "Injected failure"}`))
+	case rule.Malformed:
+		recorder := httptest.NewRecorder()
+		f.next.ServeHTTP(recorder, req)
+		for name, values := range recorder.Header() {
+			rw.Header()[name] = values
+		}
+		rw.WriteHeader(recorder.Code)
+		body := recorder.Body.Bytes()
+		rw.Write(body[:len(body)/2])
+	default:
+		f.next.ServeHTTP(rw, req)
+	}
+}
+
+// faultsFor returns the rule req matches and whether it is rate limited or fails
+func (f *FaultInjector) faultsFor(req *http.Request) (rule FaultRule, rateLimited, failed, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, rule := range f.rules {
+		if !rule.matches(req) {
+			continue
+		}
+		if f.bursts[i] > 0 {
+			f.bursts[i]--
+			return rule, true, false, true
+		}
+		return rule, false, rule.ErrorRate > 0 && f.rand.Float64() < rule.ErrorRate, true
+	}
+	return FaultRule{}, false, false, false
+}
@@ -0,0 +1,99 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFaultsRateLimitBurst(t *testing.T) {
+	client, server, _ := NewClientForTestingWithFaults(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "foo.example.com"}`,
+	}, FaultRule{Method: http.MethodGet, PathPrefix: "/v2/instances", RateLimitBurst: 2})
+	defer server.Close()
+
+	var apiErr *APIError
+	if _, err := client.GetInstance("12345"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected a 429 without retries, got %v", err)
+	}
+
+	WithRetries(3, time.Millisecond)(client)
+	instance, err := client.GetInstance("12345")
+	if err != nil {
+		t.Errorf("Expected the retries to get past the burst, got %s", err)
+		return
+	}
+	if instance.Hostname != "foo.example.com" {
+		t.Errorf("Expected the instance after the burst, got %+v", instance)
+	}
+}
+
+func TestFaultsErrorRate(t *testing.T) {
+	client, server, _ := NewClientForTestingWithFaults(map[string]string{
+		"/v2/instances/12345": `{"id": "12345"}`,
+	}, FaultRule{ErrorRate: 0.5, ErrorStatus: http.StatusServiceUnavailable})
+	defer server.Close()
+
+	failed := 0
+	for i := 0; i < 100; i++ {
+		_, err := client.GetInstance("12345")
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusServiceUnavailable {
+			failed++
+		} else if err != nil {
+			t.Errorf("Expected a 503 or a success, got %v", err)
+			return
+		}
+	}
+	if failed < 30 || failed > 70 {
+		t.Errorf("Expected about half of the requests to fail, got %d", failed)
+	}
+}
+
+func TestFaultsLatencyAndMalformed(t *testing.T) {
+	client, server, _ := NewClientForTestingWithFaults(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "foo.example.com"}`,
+		"/v2/networks":        `[{"id": "1", "label": "default", "default": true}]`,
+	},
+		FaultRule{PathPrefix: "/v2/instances", Latency: 50 * time.Millisecond},
+		FaultRule{PathPrefix: "/v2/networks", Malformed: true},
+	)
+	defer server.Close()
+
+	start := time.Now()
+	if _, err := client.GetInstance("12345"); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected the response to be delayed, it took %s", elapsed)
+	}
+
+	var apiErr *APIError
+	if _, err := client.ListNetworks(); err == nil || errors.As(err, &apiErr) {
+		t.Errorf("Expected the malformed JSON to fail to decode, got %v", err)
+	}
+}
+
+func TestFakeCivoServerFaults(t *testing.T) {
+	client, server, _ := NewClientForTestingWithFakeServer()
+	defer server.Close()
+	WithRetries(2, time.Millisecond)(client)
+
+	server.InjectFaults(FaultRule{Method: http.MethodPost, PathPrefix: "/v2/instances", RateLimitBurst: 1})
+	created, err := client.CreateInstance(&InstanceConfig{Hostname: "web-1"})
+	if err != nil {
+		t.Errorf("Expected the retry to create the instance, got %s", err)
+		return
+	}
+
+	server.InjectFaults(FaultRule{ErrorRate: 1})
+	if _, err := client.GetInstance(created.ID); err == nil {
+		t.Errorf("Expected every request to fail")
+	}
+
+	server.InjectFaults()
+	if _, err := client.GetInstance(created.ID); err != nil {
+		t.Errorf("Expected the faults to be cleared, got %s", err)
+	}
+}
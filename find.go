@@ -0,0 +1,96 @@
+package civogo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindCandidate is one of the resources a search matched, as listed by ErrMultipleMatchesFound
+type FindCandidate struct {
+	ID   string
+	Name string
+}
+
+// ErrMultipleMatchesFound is returned by the Find functions when a search matches several resources
+// equally well, Candidates lists them so the caller can ask which was meant.
+// errors.Is(err, MultipleMatchesError) holds for it
+type ErrMultipleMatchesFound struct {
+	Search     string
+	Candidates []FindCandidate
+}
+
+// Error returns the same message MultipleMatchesError always had
+func (e *ErrMultipleMatchesFound) Error() string {
+	return fmt.Sprintf("%s: unable to find %s because there were multiple matches", MultipleMatchesError, e.Search)
+}
+
+// Is makes errors.Is(err, MultipleMatchesError) hold
+func (e *ErrMultipleMatchesFound) Is(target error) bool {
+	return target == MultipleMatchesError
+}
+
+// findMatch picks the item search refers to. It tries in turn an exact ID, an exact name, a prefix of
+// the ID or a name, then any part of them, stopping at the first of these matching anything. keys returns
+// the ID and the names of an item, names are compared ignoring case when foldCase is set
+func findMatch[T any](items []T, search string, foldCase bool, keys func(T) (string, []string)) (*T, error) {
+	normalise := func(s string) string {
+		if foldCase {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+	nameSearch := normalise(search)
+
+	tiers := []func(id string, names []string) bool{
+		func(id string, names []string) bool { return id == search },
+		func(id string, names []string) bool {
+			return anyName(names, func(n string) bool { return n == nameSearch })
+		},
+		func(id string, names []string) bool {
+			return strings.HasPrefix(id, search) || anyName(names, func(n string) bool { return strings.HasPrefix(n, nameSearch) })
+		},
+		func(id string, names []string) bool {
+			return strings.Contains(id, search) || anyName(names, func(n string) bool { return strings.Contains(n, nameSearch) })
+		},
+	}
+
+	for _, matches := range tiers {
+		found := []int{}
+		for i, item := range items {
+			id, names := keys(item)
+			for j := range names {
+				names[j] = normalise(names[j])
+			}
+			if matches(id, names) {
+				found = append(found, i)
+			}
+		}
+
+		switch {
+		case len(found) == 1:
+			match := items[found[0]]
+			return &match, nil
+		case len(found) > 1:
+			err := &ErrMultipleMatchesFound{Search: search}
+			for _, i := range found {
+				id, names := keys(items[i])
+				err.Candidates = append(err.Candidates, FindCandidate{ID: id, Name: names[0]})
+			}
+			return nil, err
+		}
+	}
+
+	err := fmt.Errorf("unable to find %s, zero matches", search)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// anyName reports whether match holds for one of the non-empty names
+func anyName(names []string, match func(string) bool) bool {
+	for _, name := range names {
+		if name != "" && match(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFindMatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	volumes := []Volume{
+		{ID: "abc-111", Name: "data"},
+		{ID: "abc-222", Name: "data-backup"},
+		{ID: "def-333", Name: "logs"},
+		{ID: "def-444", Name: "old-logs"},
+	}
+	keys := func(v Volume) (string, []string) { return v.ID, []string{v.Name} }
+
+	// an exact name wins over the longer names it prefixes
+	got, err := findMatch(volumes, "data", false, keys)
+	g.Expect(err).To(BeNil())
+	g.Expect(got.ID).To(Equal("abc-111"))
+
+	// a prefix wins over a match in the middle
+	got, err = findMatch(volumes, "log", false, keys)
+	g.Expect(err).To(BeNil())
+	g.Expect(got.ID).To(Equal("def-333"))
+
+	got, err = findMatch(volumes, "444", false, keys)
+	g.Expect(err).To(BeNil())
+	g.Expect(got.Name).To(Equal("old-logs"))
+
+	_, err = findMatch(volumes, "abc", false, keys)
+	g.Expect(err).To(MatchError(MultipleMatchesError))
+
+	var multiple *ErrMultipleMatchesFound
+	g.Expect(errors.As(err, &multiple)).To(BeTrue())
+	g.Expect(multiple.Search).To(Equal("abc"))
+	g.Expect(multiple.Candidates).To(Equal([]FindCandidate{{ID: "abc-111", Name: "data"}, {ID: "abc-222", Name: "data-backup"}}))
+
+	_, err = findMatch(volumes, "DATA", false, keys)
+	g.Expect(err).To(MatchError(ZeroMatchesError))
+
+	got, err = findMatch(volumes, "DATA-b", true, keys)
+	g.Expect(err).To(BeNil())
+	g.Expect(got.ID).To(Equal("abc-222"))
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -19,6 +22,17 @@ type Firewall struct {
 	Rules             []FirewallRule `json:"rules,omitempty"`
 }
 
+// Clone returns a deep copy of the firewall, sharing none of its slices and maps
+func (f *Firewall) Clone() *Firewall {
+	if f == nil {
+		return nil
+	}
+
+	c := *f
+	c.Rules = cloneEach(f.Rules, (*FirewallRule).Clone)
+	return &c
+}
+
 // FirewallResult is the response from the Civo Firewall APIs
 type FirewallResult struct {
 	ID     string `json:"id"`
@@ -41,6 +55,17 @@ type FirewallRule struct {
 	Ports      string   `json:"ports,omitempty"`
 }
 
+// Clone returns a deep copy of the rule, sharing none of its slices and maps
+func (r *FirewallRule) Clone() *FirewallRule {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	c.Cidr = cloneSlice(r.Cidr)
+	return &c
+}
+
 // FirewallRuleConfig is how you specify the details when creating a new rule
 type FirewallRuleConfig struct {
 	FirewallID string   `json:"firewall_id"`
@@ -56,6 +81,84 @@ type FirewallRuleConfig struct {
 	Ports string `json:"ports,omitempty"`
 }
 
+// Directions, protocols and actions of a firewall rule
+const (
+	FirewallRuleDirectionIngress = "ingress"
+	FirewallRuleDirectionEgress  = "egress"
+
+	FirewallRuleProtocolTCP  = "tcp"
+	FirewallRuleProtocolUDP  = "udp"
+	FirewallRuleProtocolICMP = "icmp"
+
+	FirewallRuleActionAllow = "allow"
+	FirewallRuleActionDeny  = "deny"
+)
+
+// Validate checks the rule before it is sent to the API: the direction, protocol and action
+// must be known values when set, every CIDR must parse and the ports must be in range,
+// either as StartPort/EndPort or as a Ports list like "80,443,8000-8100".
+// The rejected fields are listed in the ValidationErrors it wraps
+func (r *FirewallRuleConfig) Validate() error {
+	var errs ValidationErrors
+	errs.addErr("direction", r.Direction, validateOneOf("direction", r.Direction, FirewallRuleDirectionIngress, FirewallRuleDirectionEgress))
+	errs.addErr("protocol", r.Protocol, validateOneOf("protocol", r.Protocol, FirewallRuleProtocolTCP, FirewallRuleProtocolUDP, FirewallRuleProtocolICMP))
+	errs.addErr("action", r.Action, validateOneOf("action", r.Action, FirewallRuleActionAllow, FirewallRuleActionDeny))
+
+	for i, cidr := range r.Cidr {
+		if _, _, err := net.ParseCIDR(cidr); err != nil && net.ParseIP(cidr) == nil {
+			errs.add(fmt.Sprintf("cidr[%d]", i), cidr, "%q is not a valid CIDR", cidr)
+		}
+	}
+
+	if r.Ports != "" {
+		for _, part := range strings.Split(r.Ports, ",") {
+			start, end, _ := strings.Cut(strings.TrimSpace(part), "-")
+			if err := validatePortRange(start, end); err != nil {
+				errs.addErr("ports", r.Ports, err)
+				break
+			}
+		}
+	} else if r.StartPort != "" {
+		errs.addErr("start_port", r.StartPort, validatePortRange(r.StartPort, r.EndPort))
+	}
+
+	return errs.wrap(FirewallRuleInvalidError)
+}
+
+// validateOneOf checks value is empty or one of allowed, ignoring case
+func validateOneOf(field, value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must be one of %s, got %q", field, strings.Join(allowed, ", "), value)
+}
+
+// validatePortRange checks start, and end when set, are ports and end is not before start
+func validatePortRange(start, end string) error {
+	first, err := strconv.Atoi(strings.TrimSpace(start))
+	if err != nil || first < 1 || first > 65535 {
+		return fmt.Errorf("%q is not a valid port", start)
+	}
+	if end == "" {
+		return nil
+	}
+
+	last, err := strconv.Atoi(strings.TrimSpace(end))
+	if err != nil || last < 1 || last > 65535 {
+		return fmt.Errorf("%q is not a valid port", end)
+	}
+	if last < first {
+		return fmt.Errorf("port range %d-%d ends before it starts", first, last)
+	}
+
+	return nil
+}
+
 // FirewallConfig is how you specify the details when creating a new firewall
 type FirewallConfig struct {
 	Name      string `json:"name"`
@@ -81,38 +184,15 @@ func (c *Client) ListFirewalls() ([]Firewall, error) {
 	return firewall, nil
 }
 
-// FindFirewall finds a firewall by either part of the ID or part of the name
+// FindFirewall finds a firewall by its ID or name, exactly, by prefix or by any part of them in that order.
+// A search matching several firewalls returns an *ErrMultipleMatchesFound
 func (c *Client) FindFirewall(search string) (*Firewall, error) {
 	firewalls, err := c.ListFirewalls()
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := Firewall{}
-
-	for _, value := range firewalls {
-		if value.Name == search || value.ID == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
-			}
-		}
-	}
-
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
-	}
+	return findMatch(firewalls, search, false, func(f Firewall) (string, []string) { return f.ID, []string{f.Name} })
 }
 
 // NewFirewall creates a new firewall record
@@ -130,6 +210,45 @@ func (c *Client) NewFirewall(firewall *FirewallConfig) (*FirewallResult, error)
 	return result, nil
 }
 
+// CreateFirewall checks the name and rules of a new firewall before creating it with NewFirewall,
+// so a mistyped CIDR or port range is reported before anything is sent to the API
+func (c *Client) CreateFirewall(firewall *FirewallConfig) (*FirewallResult, error) {
+	if err := validateFirewallConfig(firewall); err != nil {
+		return nil, err
+	}
+
+	if firewall.Region == "" {
+		firewall.Region = c.Region
+	}
+
+	return c.NewFirewall(firewall)
+}
+
+// validateFirewallConfig checks a new firewall has a name and that every one of its rules is valid
+func validateFirewallConfig(firewall *FirewallConfig) error {
+	if firewall.Name == "" {
+		err := fmt.Errorf("the firewall name is empty")
+		return FirewallRuleInvalidError.wrap(err)
+	}
+
+	for _, rule := range firewall.Rules {
+		config := FirewallRuleConfig{
+			Protocol:  rule.Protocol,
+			StartPort: rule.StartPort,
+			EndPort:   rule.EndPort,
+			Cidr:      rule.Cidr,
+			Direction: rule.Direction,
+			Action:    rule.Action,
+			Ports:     rule.Ports,
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RenameFirewall rename firewall
 func (c *Client) RenameFirewall(id string, f *FirewallConfig) (*SimpleResponse, error) {
 	f.Region = c.Region
@@ -158,6 +277,10 @@ func (c *Client) NewFirewallRule(r *FirewallRuleConfig) (*FirewallRule, error) {
 		return nil, IDisEmptyError.wrap(err)
 	}
 
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	r.Region = c.Region
 
 	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/firewalls/%s/rules", r.FirewallID), r)
@@ -224,3 +347,113 @@ func (c *Client) DeleteFirewallRule(id string, ruleID string) (*SimpleResponse,
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// FirewallRuleSyncResult reports what SyncFirewallRules changed
+type FirewallRuleSyncResult struct {
+	Created   []FirewallRule
+	Deleted   []FirewallRule
+	Unchanged []FirewallRule
+}
+
+// SyncFirewallRules makes the rules of a firewall match desired, creating the missing rules
+// and then deleting the ones not wanted anymore, so rules already in place are left alone.
+// Rules are compared by direction, action, protocol, ports, CIDRs and label. On error the
+// result lists the changes made so far
+func (c *Client) SyncFirewallRules(firewallID string, desired []FirewallRuleConfig) (*FirewallRuleSyncResult, error) {
+	for i := range desired {
+		if err := desired[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := c.ListFirewallRules(firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	toCreate, toDelete, unchanged := diffFirewallRules(existing, desired)
+	result := &FirewallRuleSyncResult{Unchanged: unchanged}
+
+	for _, config := range toCreate {
+		config.FirewallID = firewallID
+		rule, err := c.NewFirewallRule(&config)
+		if err != nil {
+			return result, err
+		}
+		result.Created = append(result.Created, *rule)
+	}
+
+	for _, rule := range toDelete {
+		if _, err := c.DeleteFirewallRule(firewallID, rule.ID); err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, rule)
+	}
+
+	return result, nil
+}
+
+// diffFirewallRules splits the rules into the desired ones to create, the existing ones to delete
+// and the existing ones matching a desired rule
+func diffFirewallRules(existing []FirewallRule, desired []FirewallRuleConfig) ([]FirewallRuleConfig, []FirewallRule, []FirewallRule) {
+	keyOf := func(r FirewallRule) string {
+		return firewallRuleKey(r.Direction, r.Action, r.Protocol, r.StartPort, r.EndPort, r.Ports, r.Label, r.Cidr)
+	}
+	matched := make([]bool, len(existing))
+
+	toCreate := []FirewallRuleConfig{}
+	unchanged := []FirewallRule{}
+	for _, config := range desired {
+		key := firewallRuleKey(config.Direction, config.Action, config.Protocol, config.StartPort, config.EndPort, config.Ports, config.Label, config.Cidr)
+		found := false
+		for i, rule := range existing {
+			if !matched[i] && keyOf(rule) == key {
+				matched[i], found = true, true
+				unchanged = append(unchanged, rule)
+				break
+			}
+		}
+		if !found {
+			toCreate = append(toCreate, config)
+		}
+	}
+
+	toDelete := []FirewallRule{}
+	for i, rule := range existing {
+		if !matched[i] {
+			toDelete = append(toDelete, rule)
+		}
+	}
+
+	return toCreate, toDelete, unchanged
+}
+
+// firewallRuleKey builds a comparable form of a rule, so "443" and 443-443, a rule without a direction
+// or action and one with the API defaults (ingress and allow), or CIDRs listed in a different order are the same rule
+func firewallRuleKey(direction, action, protocol, startPort, endPort, ports, label string, cidr []string) string {
+	if direction == "" {
+		direction = FirewallRuleDirectionIngress
+	}
+	if action == "" {
+		action = FirewallRuleActionAllow
+	}
+	if ports == "" {
+		ports = startPort
+		if endPort != "" && endPort != startPort {
+			ports = startPort + "-" + endPort
+		}
+	}
+	ranges := strings.Split(strings.ReplaceAll(ports, " ", ""), ",")
+	for i, r := range ranges {
+		if start, end, ok := strings.Cut(r, "-"); ok && start == end {
+			ranges[i] = start
+		}
+	}
+	ports = strings.Join(ranges, ",")
+
+	cidrs := make([]string, len(cidr))
+	copy(cidrs, cidr)
+	sort.Strings(cidrs)
+
+	return strings.ToLower(strings.Join([]string{direction, action, protocol, ports, strings.Join(cidrs, ","), label}, "|"))
+}
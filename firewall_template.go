@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FirewallRulesWebServer returns rules allowing HTTP and HTTPS from anywhere, to compose with others, e.g.
+//
+//	rules := append(civogo.FirewallRulesWebServer(), civogo.FirewallRulesKubernetesAPI()...)
+//	_, err := client.ApplyFirewallRules(firewallID, rules)
+func FirewallRulesWebServer() []FirewallRuleConfig {
+	return []FirewallRuleConfig{
+		allowIngressTCP("80", "HTTP"),
+		allowIngressTCP("443", "HTTPS"),
+	}
+}
+
+// FirewallRulesKubernetesAPI returns rules allowing the Kubernetes API port from anywhere, narrow their
+// Cidr to restrict who can reach the API
+func FirewallRulesKubernetesAPI() []FirewallRuleConfig {
+	return []FirewallRuleConfig{
+		allowIngressTCP("6443", "Kubernetes API"),
+	}
+}
+
+// FirewallRulesDenyAll returns rules denying all incoming TCP, UDP and ICMP traffic, outgoing traffic
+// is left alone
+func FirewallRulesDenyAll() []FirewallRuleConfig {
+	rules := []FirewallRuleConfig{}
+	for _, protocol := range []string{FirewallRuleProtocolTCP, FirewallRuleProtocolUDP} {
+		rules = append(rules, FirewallRuleConfig{
+			Protocol:  protocol,
+			StartPort: "1",
+			EndPort:   "65535",
+			Cidr:      []string{"0.0.0.0/0"},
+			Direction: FirewallRuleDirectionIngress,
+			Action:    FirewallRuleActionDeny,
+			Label:     "Deny all " + protocol,
+		})
+	}
+
+	return append(rules, FirewallRuleConfig{
+		Protocol:  FirewallRuleProtocolICMP,
+		Cidr:      []string{"0.0.0.0/0"},
+		Direction: FirewallRuleDirectionIngress,
+		Action:    FirewallRuleActionDeny,
+		Label:     "Deny all icmp",
+	})
+}
+
+// allowIngressTCP returns a rule allowing TCP traffic to port from anywhere
+func allowIngressTCP(port, label string) FirewallRuleConfig {
+	return FirewallRuleConfig{
+		Protocol:  FirewallRuleProtocolTCP,
+		StartPort: port,
+		EndPort:   port,
+		Cidr:      []string{"0.0.0.0/0"},
+		Direction: FirewallRuleDirectionIngress,
+		Action:    FirewallRuleActionAllow,
+		Label:     label,
+	}
+}
+
+// ApplyFirewallRules adds rules, e.g. from the FirewallRules templates, to a firewall. Every rule is
+// validated before any is created, then every rule is attempted even if some fail, the rules created
+// are returned along with an error joining the failures
+func (c *Client) ApplyFirewallRules(firewallID string, rules []FirewallRuleConfig) ([]FirewallRule, error) {
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+
+	created := []FirewallRule{}
+	var errs []error
+	for _, rule := range rules {
+		rule := rule
+		rule.FirewallID = firewallID
+		rule.Cidr = cloneSlice(rule.Cidr)
+		result, err := c.NewFirewallRule(&rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", rule.Label, err))
+			continue
+		}
+		created = append(created, *result)
+	}
+
+	return created, errors.Join(errs...)
+}
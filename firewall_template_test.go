@@ -0,0 +1,62 @@
+package civogo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFirewallRuleTemplates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for _, rules := range [][]FirewallRuleConfig{FirewallRulesWebServer(), FirewallRulesKubernetesAPI(), FirewallRulesDenyAll()} {
+		for _, rule := range rules {
+			g.Expect(rule.Validate()).To(Succeed(), rule.Label)
+		}
+	}
+
+	web := FirewallRulesWebServer()
+	g.Expect(web).To(HaveLen(2))
+	g.Expect(web[1].StartPort).To(Equal("443"))
+
+	web[0].Cidr[0] = "10.0.0.0/8"
+	g.Expect(FirewallRulesWebServer()[0].Cidr).To(Equal([]string{"0.0.0.0/0"}))
+
+	deny := FirewallRulesDenyAll()
+	g.Expect(deny).To(HaveLen(3))
+	for _, rule := range deny {
+		g.Expect(rule.Action).To(Equal(FirewallRuleActionDeny))
+	}
+}
+
+func TestApplyFirewallRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	received := []FirewallRuleConfig{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rule := FirewallRuleConfig{}
+		_ = json.Unmarshal(body, &rule)
+		received = append(received, rule)
+		w.Write([]byte(`{"id": "rule-` + rule.StartPort + `", "start_port": "` + rule.StartPort + `"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	rules := append(FirewallRulesWebServer(), FirewallRulesKubernetesAPI()...)
+	created, err := client.ApplyFirewallRules("fw-1", rules)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created).To(HaveLen(3))
+	g.Expect(created[2].ID).To(Equal("rule-6443"))
+	g.Expect(received[0].FirewallID).To(Equal("fw-1"))
+	g.Expect(rules[0].FirewallID).To(BeEmpty())
+
+	_, err = client.ApplyFirewallRules("fw-1", []FirewallRuleConfig{{Protocol: "sctp"}})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(received).To(HaveLen(3))
+}
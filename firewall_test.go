@@ -1,6 +1,9 @@
 package civogo
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -159,6 +162,65 @@ func TestNewFirewallWithRules(t *testing.T) {
 	}
 }
 
+func TestCreateFirewallInvalidRule(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/firewalls": `{"result": "success", "id": "12345", "name": "test"}`,
+	})
+	defer server.Close()
+
+	_, err := client.CreateFirewall(&FirewallConfig{
+		Name:  "test",
+		Rules: []FirewallRule{{Protocol: "tcp", StartPort: "80", Cidr: []string{"10.0.0.300/8"}, Direction: "ingress"}},
+	})
+	if !errors.Is(err, FirewallRuleInvalidError) {
+		t.Errorf("Expected FirewallRuleInvalidError, got %v", err)
+	}
+
+	got, err := client.CreateFirewall(&FirewallConfig{
+		Name:  "test",
+		Rules: []FirewallRule{{Protocol: "tcp", StartPort: "80", Cidr: []string{"10.0.0.0/8"}, Direction: "ingress"}},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.ID)
+	}
+}
+
+func TestFirewallRuleConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config FirewallRuleConfig
+		valid  bool
+	}{
+		{"single port", FirewallRuleConfig{Protocol: "tcp", StartPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress"}, true},
+		{"port range", FirewallRuleConfig{Protocol: "udp", StartPort: "1000", EndPort: "2000", Direction: "egress"}, true},
+		{"ports list", FirewallRuleConfig{Protocol: "tcp", Ports: "80, 443,8000-8100", Action: "allow"}, true},
+		{"ipv6 and bare ip", FirewallRuleConfig{Protocol: "icmp", Cidr: []string{"2001:db8::/32", "192.168.1.1"}}, true},
+		{"unknown direction", FirewallRuleConfig{Protocol: "tcp", StartPort: "22", Direction: "inbound"}, false},
+		{"unknown protocol", FirewallRuleConfig{Protocol: "sctp", StartPort: "22"}, false},
+		{"unknown action", FirewallRuleConfig{Protocol: "tcp", StartPort: "22", Action: "drop"}, false},
+		{"bad cidr", FirewallRuleConfig{Protocol: "tcp", StartPort: "22", Cidr: []string{"10.0.0.0/33"}}, false},
+		{"port out of range", FirewallRuleConfig{Protocol: "tcp", StartPort: "0"}, false},
+		{"reversed range", FirewallRuleConfig{Protocol: "tcp", StartPort: "2000", EndPort: "1000"}, false},
+		{"bad ports list", FirewallRuleConfig{Protocol: "tcp", Ports: "80,http"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, FirewallRuleInvalidError) {
+				t.Errorf("Expected FirewallRuleInvalidError, got %v", err)
+			}
+		})
+	}
+}
+
 func TestRenameFirewall(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/firewalls/12346": `{"result": "success"}`,
@@ -215,7 +277,7 @@ func TestNewFirewallRule(t *testing.T) {
 	})
 	defer server.Close()
 
-	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "443", EndPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "inbound", Label: "", Action: "allow"}
+	cfg := &FirewallRuleConfig{FirewallID: "78901", Protocol: "tcp", StartPort: "443", EndPort: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Label: "", Action: "allow"}
 	got, err := client.NewFirewallRule(cfg)
 	if err != nil {
 		t.Errorf("Request returned an error: %s", err)
@@ -379,3 +441,83 @@ func TestDeleteFirewallRule(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestSyncFirewallRules(t *testing.T) {
+	var created, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/v2/firewalls/fw/rules":
+			rw.Write([]byte(`[
+				{"id":"keep","firewall_id":"fw","protocol":"tcp","start_port":"443","end_port":"443","cidr":["10.0.0.0/8","0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"443"},
+				{"id":"stale","firewall_id":"fw","protocol":"tcp","start_port":"22","end_port":"22","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"22"}
+			]`))
+		case req.Method == "POST" && req.URL.Path == "/v2/firewalls/fw/rules":
+			created = append(created, req.URL.Path)
+			rw.Write([]byte(`{"id":"new","firewall_id":"fw","protocol":"udp","start_port":"53","end_port":"53","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow"}`))
+		case req.Method == "DELETE":
+			deleted = append(deleted, req.URL.Path)
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.SyncFirewallRules("fw", []FirewallRuleConfig{
+		{Protocol: "tcp", StartPort: "443", Cidr: []string{"0.0.0.0/0", "10.0.0.0/8"}, Direction: "ingress", Action: "allow"},
+		{Protocol: "udp", StartPort: "53", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Unchanged) != 1 || got.Unchanged[0].ID != "keep" {
+		t.Errorf("Expected rule keep to be unchanged, got %+v", got.Unchanged)
+	}
+	if len(got.Created) != 1 || got.Created[0].ID != "new" || len(created) != 1 {
+		t.Errorf("Expected one rule to be created, got %+v", got.Created)
+	}
+	if len(got.Deleted) != 1 || got.Deleted[0].ID != "stale" || !reflect.DeepEqual(deleted, []string{"/v2/firewalls/fw/rules/stale"}) {
+		t.Errorf("Expected rule stale to be deleted, got %+v and requests %v", got.Deleted, deleted)
+	}
+}
+
+func TestSyncFirewallRulesTwice(t *testing.T) {
+	var changes int
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/v2/firewalls/fw/rules":
+			rw.Write([]byte(`[
+				{"id":"https","firewall_id":"fw","protocol":"tcp","start_port":"443","end_port":"443","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"443"},
+				{"id":"http","firewall_id":"fw","protocol":"tcp","start_port":"80","end_port":"80","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"80-80"}
+			]`))
+		default:
+			changes++
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.SyncFirewallRules("fw", []FirewallRuleConfig{
+		{Protocol: "tcp", StartPort: "443", Cidr: []string{"0.0.0.0/0"}},
+		{Protocol: "tcp", Ports: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress"},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.Unchanged) != 2 || len(got.Created) != 0 || len(got.Deleted) != 0 || changes != 0 {
+		t.Errorf("Expected the synced rules to be left alone, got %+v", got)
+	}
+}
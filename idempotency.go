@@ -0,0 +1,54 @@
+package civogo
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the header carrying the idempotency key of a POST request, so the API
+// can recognise a create request retried after a network failure and not run it twice
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyContextKey is the context key of the idempotency key set by ContextWithIdempotencyKey
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx making the POST requests bound to it use key as their
+// idempotency key instead of a generated one, e.g. to make a create call idempotent across restarts:
+//
+//	client.WithContext(civogo.ContextWithIdempotencyKey(ctx, "create-web-1")).CreateInstance(config)
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// setIdempotencyKey sets the idempotency key of a POST request, from its context or a new random UUID.
+// It is set once per call, the retries of the request keeping the same key
+func setIdempotencyKey(req *http.Request) error {
+	if req.Method != http.MethodPost || req.Header.Get(IdempotencyKeyHeader) != "" {
+		return nil
+	}
+
+	key, _ := req.Context().Value(idempotencyKeyContextKey{}).(string)
+	if key == "" {
+		var err error
+		if key, err = newUUID(); err != nil {
+			return err
+		}
+	}
+	req.Header.Set(IdempotencyKeyHeader, key)
+
+	return nil
+}
+
+// newUUID returns a random (version 4) UUID
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("unable to generate an idempotency key: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
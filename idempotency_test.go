@@ -0,0 +1,47 @@
+package civogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	keys := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": "12345", "name": "example.com"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(1, time.Millisecond)(client)
+
+	_, err := client.CreateDNSDomain("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(keys).To(HaveLen(2))
+	g.Expect(keys[0]).To(MatchRegexp(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`))
+	g.Expect(keys[1]).To(Equal(keys[0]))
+
+	_, err = client.CreateDNSDomain("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(keys[2]).ToNot(Equal(keys[0]))
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "create-example")
+	_, err = client.WithContext(ctx).CreateDNSDomain("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(keys[3]).To(Equal("create-example"))
+
+	_, _ = client.GetDNSDomain("example.com")
+	g.Expect(keys[len(keys)-1]).To(BeEmpty())
+}
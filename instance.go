@@ -2,10 +2,16 @@ package civogo
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
-	"time"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/civo/civogo/utils"
 )
@@ -48,7 +54,7 @@ type Instance struct {
 	GPUCount                 int              `json:"gpu_count,omitempty"`
 	GPUType                  string           `json:"gpu_type,omitempty"`
 	Script                   string           `json:"script,omitempty"`
-	CreatedAt                time.Time        `json:"created_at,omitempty"`
+	CreatedAt                Time             `json:"created_at,omitempty"`
 	ReservedIPID             string           `json:"reserved_ip_id,omitempty"`
 	ReservedIPName           string           `json:"reserved_ip_name,omitempty"`
 	ReservedIP               string           `json:"reserved_ip,omitempty"`
@@ -56,6 +62,34 @@ type Instance struct {
 	Subnets                  []Subnet         `json:"subnets,omitempty"`
 	AttachedVolumes          []AttachedVolume `json:"attached_volumes,omitempty"`
 	PlacementRule            PlacementRule    `json:"placement_rule,omitempty"`
+	// PoolID is the Kubernetes node pool of the instance, for the instances of ListKubernetesClusterInstances
+	PoolID string `json:"pool_id,omitempty"`
+	// PlacementGroupID is the placement group the instance was created in, see PlacementGroup
+	PlacementGroupID string `json:"placement_group_id,omitempty"`
+	// Labels are key/value pairs attached to the instance, see MergeLabels
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Clone returns a deep copy of the instance, sharing none of its slices and maps
+func (i *Instance) Clone() *Instance {
+	if i == nil {
+		return nil
+	}
+
+	c := *i
+	c.Tags = cloneSlice(i.Tags)
+	c.CivostatsdStatsPerMinute = cloneSlice(i.CivostatsdStatsPerMinute)
+	c.CivostatsdStatsPerHour = cloneSlice(i.CivostatsdStatsPerHour)
+	c.Subnets = cloneSlice(i.Subnets)
+	c.AttachedVolumes = cloneSlice(i.AttachedVolumes)
+	c.PlacementRule.AffinityRules = cloneEach(i.PlacementRule.AffinityRules, func(r *AffinityRule) *AffinityRule {
+		rule := *r
+		rule.Tags = cloneSlice(r.Tags)
+		return &rule
+	})
+	c.PlacementRule.NodeSelector = cloneMap(i.PlacementRule.NodeSelector)
+	c.Labels = cloneMap(i.Labels)
+	return &c
 }
 
 //"cpu_cores":1,"ram_mb":2048,"disk_gb":25
@@ -65,6 +99,12 @@ type InstanceConsole struct {
 	URL string `json:"url"`
 }
 
+// InstanceCredentials is the login of the default user of an instance, the API reveals the password only once
+type InstanceCredentials struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+}
+
 // InstanceVnc represents VNC information for an instances
 type InstanceVnc struct {
 	URI    string `json:"uri"`
@@ -81,6 +121,11 @@ type PaginatedInstanceList struct {
 	Items   []Instance `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedInstanceList) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // AttachedVolume disk information
 type AttachedVolume struct {
 	// ID of the volume to attach
@@ -89,7 +134,8 @@ type AttachedVolume struct {
 
 // InstanceConfig describes the parameters for a new instance
 // none of the fields are mandatory and will be automatically
-// set with default values
+// set with default values. Script is the cloud-init user data or script
+// run on first boot, either plaintext or base64 encoded
 type InstanceConfig struct {
 	Count            int              `json:"count"`
 	Hostname         string           `json:"hostname"`
@@ -114,6 +160,14 @@ type InstanceConfig struct {
 	VolumeType       string           `json:"volume_type,omitempty"`
 	AttachedVolumes  []AttachedVolume `json:"attached_volumes"`
 	PlacementRule    PlacementRule    `json:"placement_rule"`
+	// PlacementGroupID creates the instance in a placement group, e.g. an anti-affinity one to keep the
+	// instances of an HA workload on different hosts
+	PlacementGroupID string `json:"placement_group_id,omitempty"`
+	// GPUCount and GPUType ask for GPUs, they must match a GPU size, see GPUSizes
+	GPUCount int    `json:"gpu_count,omitempty"`
+	GPUType  string `json:"gpu_type,omitempty"`
+	// Labels are key/value pairs to attach to the instance
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // AffinityRule represents a affinity rule
@@ -131,12 +185,18 @@ type PlacementRule struct {
 
 // ListInstances returns a page of Instances owned by the calling API account
 func (c *Client) ListInstances(page int, perPage int) (*PaginatedInstanceList, error) {
-	url := "/v2/instances"
+	opts := ListOptions{}
 	if page != 0 && perPage != 0 {
-		url = url + fmt.Sprintf("?page=%d&per_page=%d", page, perPage)
+		opts.Page, opts.PerPage = page, perPage
 	}
 
-	resp, err := c.SendGetRequest(url)
+	return c.ListInstancesWithOptions(opts)
+}
+
+// ListInstancesWithOptions returns a page of Instances owned by the calling API account, opts.Search
+// and opts.Tag are applied by the API so only the matching instances are sent back
+func (c *Client) ListInstancesWithOptions(opts ListOptions) (*PaginatedInstanceList, error) {
+	resp, err := c.sendListRequest("/v2/instances", []ListOptions{opts})
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -146,9 +206,16 @@ func (c *Client) ListInstances(page int, perPage int) (*PaginatedInstanceList, e
 	return &PaginatedInstances, err
 }
 
-// ListAllInstances returns all (well, upto 99,999,999 instances) Instances owned by the calling API account
-func (c *Client) ListAllInstances() ([]Instance, error) {
-	instances, err := c.ListInstances(1, 99999999)
+// ListAllInstances returns all (well, upto 99,999,999 instances) Instances owned by the calling API account,
+// narrowed down on the API side by the Search, Tag and Region of opts when given
+func (c *Client) ListAllInstances(opts ...ListOptions) ([]Instance, error) {
+	all := ListOptions{}
+	if len(opts) > 0 {
+		all = opts[len(opts)-1]
+	}
+	all.Page, all.PerPage = 1, 99999999
+
+	instances, err := c.ListInstancesWithOptions(all)
 	if err != nil {
 		return []Instance{}, decodeError(err)
 	}
@@ -156,38 +223,57 @@ func (c *Client) ListAllInstances() ([]Instance, error) {
 	return instances.Items, nil
 }
 
-// FindInstance finds a instance by either part of the ID or part of the hostname
+// ListInstancesAllRegions returns the instances of every region, ordered by region, with the
+// Region of each instance set. When some regions fail, the instances of the others are still
+// returned along with the error
+func (c *Client) ListInstancesAllRegions() ([]Instance, error) {
+	var mu sync.Mutex
+	byRegion := map[string][]Instance{}
+	err := c.ForEachRegion(func(regionClient *Client) error {
+		instances, err := regionClient.ListAllInstances()
+		if err != nil {
+			return err
+		}
+		for i := range instances {
+			if instances[i].Region == "" {
+				instances[i].Region = regionClient.Region
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		byRegion[regionClient.Region] = instances
+		return nil
+	})
+
+	regions := make([]string, 0, len(byRegion))
+	for region := range byRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	all := []Instance{}
+	for _, region := range regions {
+		all = append(all, byRegion[region]...)
+	}
+
+	return all, err
+}
+
+// InstancesPaginator returns a Paginator over all instances, perPage at a time
+func (c *Client) InstancesPaginator(perPage int) *Paginator[Instance] {
+	return newPathPaginator[Instance](c, "/v2/instances", perPage)
+}
+
+// FindInstance finds an instance by its ID or hostname, exactly, by prefix or by any part of them in that order.
+// A search matching several instances returns an *ErrMultipleMatchesFound
 func (c *Client) FindInstance(search string) (*Instance, error) {
 	instances, err := c.ListAllInstances()
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := Instance{}
-
-	for _, value := range instances {
-		if value.Hostname == search || value.ID == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(value.Hostname, search) || strings.Contains(value.ID, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
-			}
-		}
-	}
-
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
-	}
+	return findMatch(instances, search, false, func(i Instance) (string, []string) { return i.ID, []string{i.Hostname} })
 }
 
 // GetInstance returns a single Instance by its full ID
@@ -235,6 +321,7 @@ func (c *Client) NewInstanceConfig() (*InstanceConfig, error) {
 // CreateInstance creates a new instance in the account
 func (c *Client) CreateInstance(config *InstanceConfig) (*Instance, error) {
 	config.TagsList = strings.Join(config.Tags, " ")
+	config.Script = decodeUserData(config.Script)
 	body, err := c.SendPostRequest("/v2/instances", config)
 	if err != nil {
 		return nil, decodeError(err)
@@ -262,6 +349,56 @@ func (c *Client) SetInstanceTags(i *Instance, tags string) (*SimpleResponse, err
 	return response, err
 }
 
+// SetInstanceUserData replaces the cloud-init user data or script of an instance, which takes
+// effect the next time the instance is provisioned. The script can be plaintext or base64 encoded
+func (c *Client) SetInstanceUserData(instanceID string, script string) (*SimpleResponse, error) {
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/script", instanceID), map[string]string{
+		"script": decodeUserData(script),
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	response, err := c.DecodeSimpleResponse(resp)
+	return response, err
+}
+
+// GetInstanceUserData returns the plaintext cloud-init user data or script of an instance
+func (c *Client) GetInstanceUserData(instanceID string) (string, error) {
+	instance, err := c.GetInstance(instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	return instance.Script, nil
+}
+
+// decodeUserData returns script in plaintext, decoding it if it is base64 encoded. Only content
+// cloud-init understands is decoded, i.e. starting with a "#" directive such as #cloud-config or #!
+// or a MIME multipart header, so a plaintext script that happens to be valid base64 is left alone
+func decodeUserData(script string) string {
+	encoded := strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(script))
+	if encoded == "" {
+		return script
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || !utf8.Valid(decoded) {
+		return script
+	}
+	if !strings.HasPrefix(string(decoded), "#") && !strings.HasPrefix(string(decoded), "Content-Type:") {
+		return script
+	}
+
+	return string(decoded)
+}
+
 // UpdateInstance updates an Instance's hostname, reverse DNS or notes
 func (c *Client) UpdateInstance(i *Instance) (*SimpleResponse, error) {
 	params := map[string]interface{}{
@@ -381,6 +518,77 @@ func (c *Client) GetInstanceConsoleURL(id string) (string, error) {
 	return console.URL, err
 }
 
+// GetInstanceInitialPassword returns the login of the default user of an instance. The API reveals
+// the password only once, later calls fail with an InstancePasswordUnavailableError, after which
+// ResetInstancePassword gives a new one
+func (c *Client) GetInstanceInitialPassword(id string) (*InstanceCredentials, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/initial_password", id))
+	if err != nil {
+		return nil, decodePasswordError(err)
+	}
+
+	credentials := &InstanceCredentials{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(credentials); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return credentials, nil
+}
+
+// ResetInstancePassword sets a new random password for the default user of an instance and returns it,
+// it is revealed only once like the initial one
+func (c *Client) ResetInstancePassword(id string) (*InstanceCredentials, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/password_resets", id), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	credentials := &InstanceCredentials{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(credentials); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return credentials, nil
+}
+
+// decodePasswordError decodes err, a 410 Gone meaning the password was already revealed
+func decodePasswordError(err error) error {
+	decoded := decodeError(err)
+
+	var apiErr *APIError
+	if errors.As(decoded, &apiErr) && apiErr.StatusCode == http.StatusGone && !errors.Is(decoded, InstancePasswordUnavailableError) {
+		return InstancePasswordUnavailableError.wrap(decoded)
+	}
+
+	return decoded
+}
+
+// EnableRecoveryMode boots the instance into recovery mode, from a rescue image with its disk attached
+func (c *Client) EnableRecoveryMode(id string) (*SimpleResponse, error) {
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/recovery", id), map[string]string{
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	response, err := c.DecodeSimpleResponse(resp)
+	return response, err
+}
+
+// DisableRecoveryMode takes the instance out of recovery mode and boots it normally
+func (c *Client) DisableRecoveryMode(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instances/%s/recovery", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	response, err := c.DecodeSimpleResponse(resp)
+	return response, err
+}
+
 // UpgradeInstance resizes the instance up to the new specification
 // it's not possible to resize the instance to a smaller size
 func (c *Client) UpgradeInstance(id, newSize string) (*SimpleResponse, error) {
@@ -396,7 +604,71 @@ func (c *Client) UpgradeInstance(id, newSize string) (*SimpleResponse, error) {
 	return response, err
 }
 
-// MovePublicIPToInstance moves a public IP to the specified instance
+// ResizeInstance checks newSize against ListInstanceSizes before calling UpgradeInstance,
+// refusing unknown sizes, sizes that are not for instances and sizes whose disk is smaller
+// than the disk the instance has now, as a disk cannot be shrunk
+func (c *Client) ResizeInstance(id, newSize string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *InstanceSize
+	for i, size := range sizes {
+		if strings.EqualFold(size.Name, newSize) {
+			target = &sizes[i]
+			break
+		}
+	}
+
+	switch {
+	case target == nil:
+		err := fmt.Errorf("size %s does not exist", newSize)
+		return nil, InstanceResizeInvalidError.wrap(err)
+	case target.Type != "" && !strings.EqualFold(target.Type, "instance"):
+		err := fmt.Errorf("size %s is a %s size, not an instance size", target.Name, target.Type)
+		return nil, InstanceResizeInvalidError.wrap(err)
+	case strings.EqualFold(instance.Size, target.Name):
+		err := fmt.Errorf("instance %s is already %s", instance.Hostname, target.Name)
+		return nil, InstanceResizeInvalidError.wrap(err)
+	case target.DiskGigabytes < instance.DiskGigabytes:
+		err := fmt.Errorf("size %s has a %dGB disk, instance %s already uses %dGB", target.Name, target.DiskGigabytes, instance.Hostname, instance.DiskGigabytes)
+		return nil, InstanceResizeInvalidError.wrap(err)
+	}
+
+	return c.UpgradeInstance(id, target.Name)
+}
+
+// ResizeInstanceAndWait resizes the instance like ResizeInstance, then waits until it is
+// ACTIVE again with the new size and returns it
+func (c *Client) ResizeInstanceAndWait(ctx context.Context, id, newSize string, opts ...WaitOption) (*Instance, error) {
+	if _, err := c.WithContext(ctx).ResizeInstance(id, newSize); err != nil {
+		return nil, err
+	}
+
+	var instance *Instance
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		i, err := c.WithContext(ctx).GetInstance(id)
+		if err != nil {
+			return false, "", err
+		}
+		instance = i
+		return strings.EqualFold(i.Size, newSize) && InstanceStatusActive.Is(i.Status), i.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// MovePublicIPToInstance moves a public IP to the specified instance, see MovePublicIP to move
+// a reserved IP from one instance to another
 func (c *Client) MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/instances/%s/ip/%s", id, ipAddress), "")
 	if err != nil {
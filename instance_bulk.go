@@ -0,0 +1,162 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// instanceBulkConcurrency is how many requests the bulk instance operations keep in flight
+const instanceBulkConcurrency = 5
+
+// InstanceResult is the outcome for a single instance of a bulk instance operation,
+// results are returned in the same order as the input
+type InstanceResult struct {
+	// ID is the ID of the instance, for creates it is only set on success
+	ID string
+	// Hostname is the name of the instance, only set for creates
+	Hostname string
+	// Instance is the created instance, it is nil on failure
+	Instance *Instance
+	// Error is the reason this instance failed, nil on success
+	Error error
+}
+
+// CreateInstances creates count instances from template, a few at a time, naming them with namePattern
+// formatted with their number from 1, e.g. "web-%02d" for web-01, web-02...
+// Every instance is attempted even if some fail; the returned error reports how many failed
+// and each InstanceResult says which
+func (c *Client) CreateInstances(template InstanceConfig, count int, namePattern string) ([]InstanceResult, error) {
+	if err := validateBulkInstances(&template, count, namePattern); err != nil {
+		return nil, err
+	}
+
+	results := make([]InstanceResult, count)
+	c.forEachConcurrently(count, instanceBulkConcurrency, func(client *Client, i int) {
+		config := template
+		config.Count = 1
+		config.Hostname = fmt.Sprintf(namePattern, i+1)
+		config.Tags = cloneSlice(template.Tags)
+		config.Subnets = cloneSlice(template.Subnets)
+		config.AttachedVolumes = cloneSlice(template.AttachedVolumes)
+		config.Labels = cloneMap(template.Labels)
+
+		instance, err := client.CreateInstance(&config)
+		results[i] = InstanceResult{Hostname: config.Hostname, Instance: instance, Error: err}
+		if err == nil {
+			results[i].ID = instance.ID
+		}
+	})
+
+	return results, bulkInstancesError(results)
+}
+
+// CreateInstancesAndWait creates the instances like CreateInstances, then waits until all of those created
+// are ACTIVE and returns them. An instance failing to become ACTIVE is reported in its InstanceResult
+func (c *Client) CreateInstancesAndWait(ctx context.Context, template InstanceConfig, count int, namePattern string, opts ...WaitOption) ([]InstanceResult, error) {
+	results, err := c.WithContext(ctx).CreateInstances(template, count, namePattern)
+	if results == nil {
+		return nil, err
+	}
+
+	c.WithContext(ctx).forEachConcurrently(len(results), instanceBulkConcurrency, func(client *Client, i int) {
+		if results[i].Error != nil {
+			return
+		}
+		instance, err := client.WaitForInstanceState(ctx, results[i].Instance.ID, string(InstanceStatusActive), opts...)
+		if err != nil {
+			results[i].Error = err
+			return
+		}
+		results[i].Instance = instance
+	})
+
+	return results, bulkInstancesError(results)
+}
+
+// StopInstances shuts down several instances, a few at a time, e.g. for nightly cost savings.
+// Every instance is attempted even if some fail; the returned error reports how many failed
+// and each InstanceResult says which
+func (c *Client) StopInstances(ids []string) ([]InstanceResult, error) {
+	return c.bulkInstances(ids, func(client *Client, id string) error {
+		_, err := client.StopInstance(id)
+		return err
+	})
+}
+
+// StartInstances starts several shut down instances, a few at a time.
+// Every instance is attempted even if some fail; the returned error reports how many failed
+// and each InstanceResult says which
+func (c *Client) StartInstances(ids []string) ([]InstanceResult, error) {
+	return c.bulkInstances(ids, func(client *Client, id string) error {
+		_, err := client.StartInstance(id)
+		return err
+	})
+}
+
+// StopInstancesByTag shuts down the instances tagged with tag, like StopInstances.
+// Instances already SHUTOFF are left alone and not part of the results
+func (c *Client) StopInstancesByTag(tag string) ([]InstanceResult, error) {
+	instances, err := c.ListInstancesByTag(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.StopInstances(runningInstanceIDs(instances))
+}
+
+// bulkInstances runs op for each of the ids with at most instanceBulkConcurrency in flight
+func (c *Client) bulkInstances(ids []string, op func(client *Client, id string) error) ([]InstanceResult, error) {
+	results := make([]InstanceResult, len(ids))
+	c.forEachConcurrently(len(ids), instanceBulkConcurrency, func(client *Client, i int) {
+		results[i] = InstanceResult{ID: ids[i], Error: op(client, ids[i])}
+	})
+
+	return results, bulkInstancesError(results)
+}
+
+// runningInstanceIDs returns the IDs of the instances which are not SHUTOFF
+func runningInstanceIDs(instances []Instance) []string {
+	ids := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		if !InstanceStatusShutoff.Is(instance.Status) {
+			ids = append(ids, instance.ID)
+		}
+	}
+	return ids
+}
+
+// validateBulkInstances checks the arguments of CreateInstances before any instance is created,
+// the rejected fields are listed in the ValidationErrors it wraps
+func validateBulkInstances(template *InstanceConfig, count int, namePattern string) error {
+	var errs ValidationErrors
+	if count < 1 {
+		errs.add("count", count, "unable to create %d instances", count)
+	}
+	if name := fmt.Sprintf(namePattern, 1); strings.Contains(name, "%!") || name == fmt.Sprintf(namePattern, 2) {
+		errs.add("hostname", namePattern, "the name pattern %q must format the number of each instance once, e.g. web-%%02d", namePattern)
+	}
+	if count > 1 && template.ReservedIPv4 != "" {
+		errs.add("reserved_ipv4", template.ReservedIPv4, "the same reserved IP can't be given to several instances")
+	}
+	if count > 1 && template.PrivateIPv4 != "" {
+		errs.add("private_ipv4", template.PrivateIPv4, "the same private IP can't be given to several instances")
+	}
+	return errs.wrap(InstanceConfigInvalidError)
+}
+
+// bulkInstancesError returns an InstanceBulkOperationFailedError counting the failed results, or nil if there are none
+func bulkInstancesError(results []InstanceResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d instances failed", failed, len(results))
+		return InstanceBulkOperationFailedError.wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package civogo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func bulkInstancesServer(t *testing.T, failing string) (*httptest.Server, *sync.Map) {
+	var created sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/instances":
+			config := InstanceConfig{}
+			json.NewDecoder(req.Body).Decode(&config)
+			if config.Hostname == failing {
+				rw.WriteHeader(http.StatusBadRequest)
+				rw.Write([]byte(`{"code": "quota_limit_reached", "reason": "The quota has been reached"}`))
+				return
+			}
+			created.Store(config.Hostname, config)
+			fmt.Fprintf(rw, `{"id": "id-%s", "hostname": "%s", "tags": [%q], "status": "BUILDING"}`, config.Hostname, config.Hostname, config.TagsList)
+		case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/v2/instances/id-"):
+			hostname := strings.TrimPrefix(req.URL.Path, "/v2/instances/id-")
+			fmt.Fprintf(rw, `{"id": "id-%s", "hostname": "%s", "status": "ACTIVE"}`, hostname, hostname)
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	return server, &created
+}
+
+func TestCreateInstances(t *testing.T) {
+	server, created := bulkInstancesServer(t, "web-02")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	template := InstanceConfig{Size: "g3.small", Tags: []string{"web"}, Count: 3}
+	results, err := client.CreateInstances(template, 3, "web-%02d")
+	if !errors.Is(err, InstanceBulkOperationFailedError) {
+		t.Errorf("Expected an InstanceBulkOperationFailedError, got %v", err)
+	}
+	if len(results) != 3 {
+		t.Errorf("Expected 3 results, got %d", len(results))
+		return
+	}
+
+	for i, hostname := range []string{"web-01", "web-02", "web-03"} {
+		if results[i].Hostname != hostname {
+			t.Errorf("Expected result %d to be %s, got %s", i, hostname, results[i].Hostname)
+		}
+	}
+	if results[0].Error != nil || results[0].Instance.ID != "id-web-01" {
+		t.Errorf("Expected web-01 to be created, got %+v", results[0])
+	}
+	if results[1].Error == nil || results[1].Instance != nil {
+		t.Errorf("Expected web-02 to fail, got %+v", results[1])
+	}
+
+	config, _ := created.Load("web-03")
+	if config.(InstanceConfig).Count != 1 || config.(InstanceConfig).TagsList != "web" {
+		t.Errorf("Expected a single instance tagged web per request, got %+v", config)
+	}
+	if template.TagsList != "" {
+		t.Errorf("Expected the template to be left alone, got %+v", template)
+	}
+}
+
+func TestCreateInstancesAndWait(t *testing.T) {
+	server, _ := bulkInstancesServer(t, "")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	results, err := client.CreateInstancesAndWait(context.Background(), InstanceConfig{Size: "g3.small"}, 2, "web-%d", WithWaitInterval(time.Millisecond))
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	for _, result := range results {
+		if result.Instance == nil || result.Instance.Status != "ACTIVE" {
+			t.Errorf("Expected %s to be ACTIVE, got %+v", result.Hostname, result.Instance)
+		}
+	}
+}
+
+func TestCreateInstancesInvalid(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	tests := []struct {
+		name        string
+		template    InstanceConfig
+		count       int
+		namePattern string
+	}{
+		{"no instances", InstanceConfig{}, 0, "web-%d"},
+		{"pattern without number", InstanceConfig{}, 2, "web"},
+		{"pattern with two numbers", InstanceConfig{}, 2, "web-%d-%d"},
+		{"shared reserved IP", InstanceConfig{ReservedIPv4: "10.0.0.1"}, 2, "web-%d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.CreateInstances(tt.template, tt.count, tt.namePattern); !errors.Is(err, InstanceConfigInvalidError) {
+				t.Errorf("Expected an InstanceConfigInvalidError, got %v", err)
+			}
+		})
+	}
+
+	_, err := client.CreateInstances(InstanceConfig{ReservedIPv4: "10.0.0.1", PrivateIPv4: "192.168.1.2"}, 2, "web")
+	var errs ValidationErrors
+	expected := []string{"hostname", "reserved_ipv4", "private_ipv4"}
+	if !errors.As(err, &errs) || !reflect.DeepEqual(errs.Fields(), expected) {
+		t.Errorf("Expected %v to be rejected, got %v", expected, err)
+	}
+}
+
+func TestStopInstancesByTag(t *testing.T) {
+	var mu sync.Mutex
+	stopped := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [
+				{"id": "web-1", "tags": ["nightly"], "status": "ACTIVE"},
+				{"id": "web-2", "tags": ["nightly"], "status": "SHUTOFF"},
+				{"id": "web-3", "tags": ["nightly"], "status": "ACTIVE"},
+				{"id": "db-1", "tags": ["prod"], "status": "ACTIVE"}
+			]}`))
+		case req.Method == http.MethodPut && strings.HasSuffix(req.URL.Path, "/stop"):
+			id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/v2/instances/"), "/stop")
+			if id == "web-3" {
+				rw.WriteHeader(http.StatusInternalServerError)
+				rw.Write([]byte(`{"code": "internal_error", "reason": "Something went wrong"}`))
+				return
+			}
+			mu.Lock()
+			stopped = append(stopped, id)
+			mu.Unlock()
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	results, err := client.StopInstancesByTag("nightly")
+	if !errors.Is(err, InstanceBulkOperationFailedError) {
+		t.Errorf("Expected an InstanceBulkOperationFailedError, got %v", err)
+	}
+	if len(results) != 2 || results[0].ID != "web-1" || results[0].Error != nil || results[1].ID != "web-3" || results[1].Error == nil {
+		t.Errorf("Expected web-1 to be stopped and web-3 to fail, got %+v", results)
+	}
+	if len(stopped) != 1 || stopped[0] != "web-1" {
+		t.Errorf("Expected only web-1 to be stopped, got %v", stopped)
+	}
+}
+
+func TestStartInstances(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/start": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	results, err := client.StartInstances([]string{"web-1", "web-2"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(results) != 2 || results[1].ID != "web-2" {
+		t.Errorf("Expected a result for each instance, got %+v", results)
+	}
+}
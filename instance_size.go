@@ -4,28 +4,54 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
 // InstanceSize represents an available size for instances to launch
 type InstanceSize struct {
-	Type              string `json:"type,omitempty"`
-	Name              string `json:"name,omitempty"`
-	NiceName          string `json:"nice_name,omitempty"`
-	CPUCores          int    `json:"cpu_cores,omitempty"`
-	GPUCount          int    `json:"gpu_count,omitempty"`
-	GPUType           string `json:"gpu_type,omitempty"`
-	RAMMegabytes      int    `json:"ram_mb,omitempty"`
-	DiskGigabytes     int    `json:"disk_gb,omitempty"`
-	TransferTerabytes int    `json:"transfer_tb,omitempty"`
-	Description       string `json:"description,omitempty"`
-	Selectable        bool   `json:"selectable,omitempty"`
+	Type              string  `json:"type,omitempty"`
+	Name              string  `json:"name,omitempty"`
+	NiceName          string  `json:"nice_name,omitempty"`
+	CPUCores          int     `json:"cpu_cores,omitempty"`
+	GPUCount          int     `json:"gpu_count,omitempty"`
+	GPUType           string  `json:"gpu_type,omitempty"`
+	RAMMegabytes      int     `json:"ram_mb,omitempty"`
+	DiskGigabytes     int     `json:"disk_gb,omitempty"`
+	TransferTerabytes int     `json:"transfer_tb,omitempty"`
+	Description       string  `json:"description,omitempty"`
+	Selectable        bool    `json:"selectable,omitempty"`
+	PriceHourly       float64 `json:"price_hourly,omitempty"`
+	PriceMonthly      float64 `json:"price_monthly,omitempty"`
 }
 
+// HasGPU reports whether the size comes with GPUs
+func (s *InstanceSize) HasGPU() bool {
+	return s.GPUCount > 0
+}
+
+// GPUType is a model of GPU offered by the sizes catalogue, as listed by ListGPUTypes
+type GPUType struct {
+	// Name is the model, as in InstanceSize.GPUType
+	Name string `json:"name"`
+	// MaxCount is the most GPUs of this model a size offers
+	MaxCount int `json:"max_count"`
+	// Sizes are the names of the selectable sizes offering this model, with the fewest GPUs first
+	Sizes []string `json:"sizes"`
+}
+
+// Types of size returned by ListInstanceSizes
+const (
+	InstanceSizeTypeInstance   = "instance"
+	InstanceSizeTypeKubernetes = "kubernetes"
+	InstanceSizeTypeDatabase   = "database"
+	InstanceSizeTypeKfaaS      = "kfaas"
+)
+
 // ListInstanceSizes returns all availble sizes of instances
 // TODO: Rename to Size because this return all size (k8s, vm, database, kfaas)
 func (c *Client) ListInstanceSizes() ([]InstanceSize, error) {
-	resp, err := c.SendGetRequest("/v2/sizes")
+	resp, err := c.sendCachedGetRequest(CacheInstanceSizes, "/v2/sizes")
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -71,3 +97,128 @@ func (c *Client) FindInstanceSizes(search string) (*InstanceSize, error) {
 		return nil, ZeroMatchesError.wrap(err)
 	}
 }
+
+// SizesForType returns the sizes of one type, e.g. InstanceSizeTypeKubernetes
+func (c *Client) SizesForType(sizeType string) ([]InstanceSize, error) {
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSizesByType(sizes, sizeType), nil
+}
+
+// FindSizeByResources returns the smallest selectable instance size with at least minCPU cores
+// and minRAMMB megabytes of RAM
+func (c *Client) FindSizeByResources(minCPU, minRAMMB int) (*InstanceSize, error) {
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	return smallestSizeWith(sizes, minCPU, minRAMMB)
+}
+
+// ListGPUTypes returns the models of GPU offered by the selectable sizes, e.g. to let users pick one for ML workloads
+func (c *Client) ListGPUTypes() ([]GPUType, error) {
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	return gpuTypes(sizes), nil
+}
+
+// GPUSizes returns the selectable sizes with at least minCount GPUs of gpuType, any model when gpuType is empty,
+// with the fewest GPUs first
+func (c *Client) GPUSizes(gpuType string, minCount int) ([]InstanceSize, error) {
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterGPUSizes(sizes, gpuType, minCount), nil
+}
+
+// filterGPUSizes returns the selectable sizes with at least minCount GPUs of gpuType, sorted by GPU count then CPU cores
+func filterGPUSizes(sizes []InstanceSize, gpuType string, minCount int) []InstanceSize {
+	filtered := make([]InstanceSize, 0)
+	for _, size := range sizes {
+		if size.Selectable && size.HasGPU() && size.GPUCount >= minCount && (gpuType == "" || strings.EqualFold(size.GPUType, gpuType)) {
+			filtered = append(filtered, size)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].GPUCount != filtered[j].GPUCount {
+			return filtered[i].GPUCount < filtered[j].GPUCount
+		}
+		return filtered[i].CPUCores < filtered[j].CPUCores
+	})
+	return filtered
+}
+
+// gpuTypes groups the selectable GPU sizes by model, sorted by name
+func gpuTypes(sizes []InstanceSize) []GPUType {
+	byName := map[string]*GPUType{}
+	names := []string{}
+	for _, size := range filterGPUSizes(sizes, "", 1) {
+		gpu, ok := byName[size.GPUType]
+		if !ok {
+			gpu = &GPUType{Name: size.GPUType}
+			byName[size.GPUType] = gpu
+			names = append(names, size.GPUType)
+		}
+		gpu.Sizes = append(gpu.Sizes, size.Name)
+		if size.GPUCount > gpu.MaxCount {
+			gpu.MaxCount = size.GPUCount
+		}
+	}
+	sort.Strings(names)
+
+	types := make([]GPUType, 0, len(names))
+	for _, name := range names {
+		types = append(types, *byName[name])
+	}
+	return types
+}
+
+// filterSizesByType returns the sizes of sizeType
+func filterSizesByType(sizes []InstanceSize, sizeType string) []InstanceSize {
+	filtered := make([]InstanceSize, 0)
+	for _, size := range sizes {
+		if strings.EqualFold(size.Type, sizeType) {
+			filtered = append(filtered, size)
+		}
+	}
+	return filtered
+}
+
+// smallestSizeWith returns the smallest selectable instance size with at least minCPU cores and minRAMMB of RAM,
+// sizes are compared by CPU, then RAM, then disk
+func smallestSizeWith(sizes []InstanceSize, minCPU, minRAMMB int) (*InstanceSize, error) {
+	candidates := make([]InstanceSize, 0)
+	for _, size := range filterSizesByType(sizes, InstanceSizeTypeInstance) {
+		if size.Selectable && size.CPUCores >= minCPU && size.RAMMegabytes >= minRAMMB {
+			candidates = append(candidates, size)
+		}
+	}
+
+	if len(candidates) == 0 {
+		err := fmt.Errorf("unable to find an instance size with at least %d CPU cores and %dMB of RAM, zero matches", minCPU, minRAMMB)
+		return nil, ZeroMatchesError.wrap(err)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.CPUCores != b.CPUCores {
+			return a.CPUCores < b.CPUCores
+		}
+		if a.RAMMegabytes != b.RAMMegabytes {
+			return a.RAMMegabytes < b.RAMMegabytes
+		}
+		return a.DiskGigabytes < b.DiskGigabytes
+	})
+
+	return &candidates[0], nil
+}
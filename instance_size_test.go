@@ -1,6 +1,8 @@
 package civogo
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -100,3 +102,76 @@ func TestFindInstanceSizes(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "g3.xsmall", got.Name)
 	}
 }
+
+func TestFindSizeByResources(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sizes": `[
+			{"type": "Instance", "name": "g3.large", "cpu_cores": 4, "ram_mb": 8192, "disk_gb": 60, "selectable": true, "price_monthly": 43.5},
+			{"type": "Instance", "name": "g3.medium", "cpu_cores": 2, "ram_mb": 4096, "disk_gb": 50, "selectable": true, "price_monthly": 21.75},
+			{"type": "Instance", "name": "g3.legacy", "cpu_cores": 2, "ram_mb": 4096, "disk_gb": 40, "selectable": false},
+			{"type": "Kubernetes", "name": "g4s.kube.medium", "cpu_cores": 2, "ram_mb": 4096, "disk_gb": 50, "selectable": true}
+		]`,
+	})
+	defer server.Close()
+
+	size, err := client.FindSizeByResources(2, 3000)
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if size.Name != "g3.medium" || size.PriceMonthly != 21.75 {
+		t.Errorf("Expected g3.medium at 21.75 a month, got %s at %v", size.Name, size.PriceMonthly)
+	}
+
+	if _, err := client.FindSizeByResources(8, 0); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+
+	sizes, err := client.SizesForType(InstanceSizeTypeKubernetes)
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if len(sizes) != 1 || sizes[0].Name != "g4s.kube.medium" {
+		t.Errorf("Expected only g4s.kube.medium, got %+v", sizes)
+	}
+}
+
+func TestGPUSizes(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sizes": `[
+			{"type": "Instance", "name": "g3.medium", "cpu_cores": 2, "ram_mb": 4096, "selectable": true},
+			{"type": "Instance", "name": "an1.a100.x2", "cpu_cores": 24, "gpu_count": 2, "gpu_type": "A100", "selectable": true},
+			{"type": "Instance", "name": "an1.a100.x1", "cpu_cores": 12, "gpu_count": 1, "gpu_type": "A100", "selectable": true},
+			{"type": "Instance", "name": "an1.l40s.x1", "cpu_cores": 8, "gpu_count": 1, "gpu_type": "L40S", "selectable": true},
+			{"type": "Instance", "name": "an1.h100.x8", "cpu_cores": 96, "gpu_count": 8, "gpu_type": "H100", "selectable": false}
+		]`,
+	})
+	defer server.Close()
+
+	types, err := client.ListGPUTypes()
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	expected := []GPUType{
+		{Name: "A100", MaxCount: 2, Sizes: []string{"an1.a100.x1", "an1.a100.x2"}},
+		{Name: "L40S", MaxCount: 1, Sizes: []string{"an1.l40s.x1"}},
+	}
+	if !reflect.DeepEqual(types, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, types)
+	}
+
+	sizes, err := client.GPUSizes("a100", 2)
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if len(sizes) != 1 || sizes[0].Name != "an1.a100.x2" {
+		t.Errorf("Expected only an1.a100.x2, got %+v", sizes)
+	}
+
+	sizes, err = client.GPUSizes("", 0)
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	if len(sizes) != 3 || sizes[0].Name != "an1.l40s.x1" {
+		t.Errorf("Expected the 3 selectable GPU sizes, smallest first, got %+v", sizes)
+	}
+}
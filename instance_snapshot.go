@@ -0,0 +1,108 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Instance snapshot statuses reported by the API
+const (
+	InstanceSnapshotStatusPending   = "pending"
+	InstanceSnapshotStatusAvailable = "available"
+	InstanceSnapshotStatusFailed    = "failed"
+)
+
+// InstanceSnapshot is a copy of the disk of an instance, it can be promoted to a disk image
+// to launch new instances from
+type InstanceSnapshot struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	InstanceID    string `json:"instance_id"`
+	SizeGigabytes int    `json:"size_gb,omitempty"`
+	Status        string `json:"status"`
+	DiskImageID   string `json:"disk_image_id,omitempty"`
+	CreatedAt     Time   `json:"created_at,omitempty"`
+}
+
+// instanceSnapshotRequest is the body of the requests creating and promoting instance snapshots
+type instanceSnapshotRequest struct {
+	Name   string `json:"name,omitempty"`
+	Region string `json:"region"`
+}
+
+// CreateInstanceSnapshot takes a snapshot of the disk of an instance
+func (c *Client) CreateInstanceSnapshot(instanceID, name string) (*InstanceSnapshot, error) {
+	if name == "" {
+		err := fmt.Errorf("a name is required to snapshot instance %s", instanceID)
+		return nil, InstanceSnapshotInvalidError.wrap(err)
+	}
+
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/snapshots", instanceID), instanceSnapshotRequest{Name: name, Region: c.Region})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var snapshot = &InstanceSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ListInstanceSnapshots returns the snapshots of an instance
+func (c *Client) ListInstanceSnapshots(instanceID string) ([]InstanceSnapshot, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/snapshots", instanceID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	snapshots := make([]InstanceSnapshot, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&snapshots); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// GetInstanceSnapshot returns an instance snapshot
+func (c *Client) GetInstanceSnapshot(id string) (*InstanceSnapshot, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instancesnapshots/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var snapshot = &InstanceSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// DeleteInstanceSnapshot deletes an instance snapshot, disk images promoted from it are kept
+func (c *Client) DeleteInstanceSnapshot(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instancesnapshots/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// PromoteSnapshotToDiskImage turns an available instance snapshot into a disk image new instances
+// can be created from, use WaitForDiskImageAvailable to know when the image is ready
+func (c *Client) PromoteSnapshotToDiskImage(snapshotID string) (*DiskImage, error) {
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/instancesnapshots/%s/promote", snapshotID), instanceSnapshotRequest{Region: c.Region})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var image = &DiskImage{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(image); err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCreateInstanceSnapshot(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/snapshots": `{"id": "snap-1", "name": "golden", "instance_id": "12345", "status": "pending"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateInstanceSnapshot("12345", "golden")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &InstanceSnapshot{ID: "snap-1", Name: "golden", InstanceID: "12345", Status: InstanceSnapshotStatusPending}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.CreateInstanceSnapshot("12345", ""); !errors.Is(err, InstanceSnapshotInvalidError) {
+		t.Errorf("Expected an InstanceSnapshotInvalidError for a snapshot without a name, got %v", err)
+	}
+}
+
+func TestListInstanceSnapshots(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/snapshots": `[{"id": "snap-1", "name": "golden", "instance_id": "12345", "status": "available"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListInstanceSnapshots("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []InstanceSnapshot{{ID: "snap-1", Name: "golden", InstanceID: "12345", Status: InstanceSnapshotStatusAvailable}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestPromoteSnapshotToDiskImage(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instancesnapshots/snap-1/promote": `{"id": "image-1", "name": "golden", "state": "uploading"}`,
+	})
+	defer server.Close()
+
+	got, err := client.PromoteSnapshotToDiskImage("snap-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &DiskImage{ID: "image-1", Name: "golden", State: DiskImageStateUploading}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestFakePromoteSnapshotToDiskImage(t *testing.T) {
+	client, _ := NewFakeClient()
+	client.InstanceList = []Instance{{ID: "12345", Hostname: "builder", DiskGigabytes: 25}}
+
+	snapshot, err := client.CreateInstanceSnapshot("12345", "golden")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	image, err := client.PromoteSnapshotToDiskImage(snapshot.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := client.GetDiskImage(image.ID)
+	if err != nil || got.Name != "golden" {
+		t.Errorf("Expected the promoted image to be listed, got %+v, %v", got, err)
+	}
+
+	snapshot, _ = client.GetInstanceSnapshot(snapshot.ID)
+	if snapshot.DiskImageID != image.ID {
+		t.Errorf("Expected the snapshot to reference disk image %s, got %s", image.ID, snapshot.DiskImageID)
+	}
+}
@@ -1,7 +1,15 @@
 package civogo
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListInstances(t *testing.T) {
@@ -219,6 +227,59 @@ func TestSetInstanceTags(t *testing.T) {
 	EnsureSuccessfulSimpleResponse(t, got, err)
 }
 
+func TestSetInstanceUserData(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","script":"#cloud-config\npackages:\n  - nginx\n"}`,
+					URL:          "/v2/instances/12345/script",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	// base64 encoded, as it comes out of most templating tools
+	got, err := client.SetInstanceUserData("12345", "I2Nsb3VkLWNvbmZpZwpwYWNrYWdlczoKICAtIG5naW54Cg==")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestGetInstanceUserData(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "foo.example.com", "script": "#!/bin/sh\necho hello"}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceUserData("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got != "#!/bin/sh\necho hello" {
+		t.Errorf("Expected the script of the instance, got %q", got)
+	}
+}
+
+func TestDecodeUserData(t *testing.T) {
+	tests := map[string]string{
+		"#!/bin/sh\necho hello":            "#!/bin/sh\necho hello",
+		"IyEvYmluL3NoCmVjaG8gaGVsbG8=":     "#!/bin/sh\necho hello",
+		"IyEvYmluL3NoCmVj\naG8gaGVsbG8=\n": "#!/bin/sh\necho hello",
+		"aGVsbG8gd29ybGQ=":                 "aGVsbG8gd29ybGQ=",
+		"abcd":                             "abcd",
+		"":                                 "",
+	}
+
+	for script, expected := range tests {
+		if got := decodeUserData(script); got != expected {
+			t.Errorf("decodeUserData(%q) = %q, expected %q", script, got, expected)
+		}
+	}
+}
+
 func TestUpdateInstance(t *testing.T) {
 	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
 		{
@@ -320,6 +381,44 @@ func TestSoftRebootInstance(t *testing.T) {
 	EnsureSuccessfulSimpleResponse(t, got, err)
 }
 
+func TestEnableRecoveryMode(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/instances/12345/recovery",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.EnableRecoveryMode("12345")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestDisableRecoveryMode(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "DELETE",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  "",
+					URL:          "/v2/instances/12345/recovery",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.DisableRecoveryMode("12345")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
 func TestStopInstance(t *testing.T) {
 	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
 		{
@@ -418,6 +517,61 @@ func TestGetInstanceConsoleURL(t *testing.T) {
 	}
 }
 
+func TestGetInstanceInitialPassword(t *testing.T) {
+	revealed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if revealed {
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"code": "gone", "reason": "the password was already revealed"}`))
+			return
+		}
+		revealed = true
+		w.Write([]byte(`{"user": "civo", "password": "s3cret"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	got, err := client.GetInstanceInitialPassword("12345")
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	expected := &InstanceCredentials{User: "civo", Password: "s3cret"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	_, err = client.GetInstanceInitialPassword("12345")
+	if !errors.Is(err, InstancePasswordUnavailableError) {
+		t.Errorf("Expected an InstancePasswordUnavailableError, got %v", err)
+	}
+}
+
+func TestResetInstancePassword(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST"}`,
+					URL:          "/v2/instances/12345/password_resets",
+					ResponseBody: `{"user": "civo", "password": "n3w"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ResetInstancePassword("12345")
+	if err != nil {
+		t.Fatalf("Request returned an error: %s", err)
+	}
+	expected := &InstanceCredentials{User: "civo", Password: "n3w"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
 func TestSetInstanceFirewall(t *testing.T) {
 	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
 		{
@@ -436,3 +590,93 @@ func TestSetInstanceFirewall(t *testing.T) {
 	got, err := client.SetInstanceFirewall("12345", "67890")
 	EnsureSuccessfulSimpleResponse(t, got, err)
 }
+
+func newResizeTestServer(resized *bool) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/instances/12345", func(rw http.ResponseWriter, req *http.Request) {
+		size := "g3.small"
+		if *resized {
+			size = "g3.large"
+		}
+		fmt.Fprintf(rw, `{"id": "12345", "hostname": "foo.example.com", "size": "%s", "disk_gb": 50, "status": "ACTIVE"}`, size)
+	})
+	mux.HandleFunc("/v2/sizes", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`[
+			{"type": "Instance", "name": "g3.xsmall", "disk_gb": 25},
+			{"type": "Instance", "name": "g3.small", "disk_gb": 50},
+			{"type": "Instance", "name": "g3.large", "disk_gb": 100},
+			{"type": "Kubernetes", "name": "g4s.kube.large", "disk_gb": 100}
+		]`))
+	})
+	mux.HandleFunc("/v2/instances/12345/resize", func(rw http.ResponseWriter, req *http.Request) {
+		*resized = true
+		rw.Write([]byte(`{"result": "success"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestResizeInstance(t *testing.T) {
+	resized := false
+	server := newResizeTestServer(&resized)
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	for _, size := range []string{"g3.xsmall", "g4s.kube.large", "g3.small", "g9.missing"} {
+		if _, err := client.ResizeInstance("12345", size); !errors.Is(err, InstanceResizeInvalidError) {
+			t.Errorf("Expected InstanceResizeInvalidError for %s, got %v", size, err)
+		}
+	}
+	if resized {
+		t.Errorf("Expected no resize request to be sent")
+	}
+
+	got, err := client.ResizeInstance("12345", "g3.large")
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestResizeInstanceAndWait(t *testing.T) {
+	resized := false
+	server := newResizeTestServer(&resized)
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	instance, err := client.ResizeInstanceAndWait(context.Background(), "12345", "g3.large", WithWaitInterval(time.Millisecond))
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if instance.Size != "g3.large" {
+		t.Errorf("Expected %s, got %s", "g3.large", instance.Size)
+	}
+}
+
+func TestListInstancesWithOptions(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query()
+		rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "12345", "hostname": "web-1"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	got, err := client.ListInstancesWithOptions(ListOptions{Search: "web-"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.Items) != 1 || got.Items[0].Hostname != "web-1" {
+		t.Errorf("Expected web-1, got %+v", got.Items)
+	}
+	if !reflect.DeepEqual(query, url.Values{"search": {"web-"}, "region": {"TEST"}}) {
+		t.Errorf("Expected the search to be sent, got %v", query)
+	}
+
+	if _, err := client.ListAllInstances(ListOptions{Search: "web-", Tag: "prod", Page: 3}); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	expected := url.Values{"search": {"web-"}, "tags": {"prod"}, "page": {"1"}, "per_page": {"99999999"}, "region": {"TEST"}}
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("Expected %v, got %v", expected, query)
+	}
+}
@@ -0,0 +1,228 @@
+package civogo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Inventory is a snapshot of the resources of an account in one region, as returned by ExportInventory.
+// It marshals to JSON and YAML with the field names of the API
+type Inventory struct {
+	Region             string                 `json:"region"`
+	ExportedAt         Time                   `json:"exported_at"`
+	Instances          []Instance             `json:"instances"`
+	KubernetesClusters []KubernetesCluster    `json:"kubernetes_clusters"`
+	Networks           []Network              `json:"networks"`
+	Firewalls          []Firewall             `json:"firewalls"`
+	Volumes            []Volume               `json:"volumes"`
+	LoadBalancers      []LoadBalancer         `json:"loadbalancers"`
+	Databases          []Database             `json:"databases"`
+	ObjectStores       []ObjectStore          `json:"objectstores"`
+	ReservedIPs        []IP                   `json:"reserved_ips"`
+	SSHKeys            []SSHKey               `json:"ssh_keys"`
+	DNSDomains         []DNSDomain            `json:"dns_domains"`
+	DNSRecords         map[string][]DNSRecord `json:"dns_records"`
+}
+
+// InventoryOptions configures ExportInventory
+type InventoryOptions struct {
+	// IncludeSecrets keeps the passwords, tokens and kubeconfigs of the resources in the inventory
+	IncludeSecrets bool
+}
+
+// InventoryOption changes one of the InventoryOptions
+type InventoryOption func(*InventoryOptions)
+
+// WithInventorySecrets keeps the secrets of the resources in the inventory, which is then as sensitive
+// as the credentials it holds
+func WithInventorySecrets() InventoryOption {
+	return func(o *InventoryOptions) {
+		o.IncludeSecrets = true
+	}
+}
+
+// ExportInventory lists every type of resource of the region of the client concurrently, for backups,
+// audits or migrations. Every type is attempted even if some fail, the returned inventory holds the
+// ones listed along with an error joining the failures. The passwords, tokens and kubeconfigs of the
+// resources are cleared unless WithInventorySecrets is given
+func (c *Client) ExportInventory(ctx context.Context, opts ...InventoryOption) (*Inventory, error) {
+	options := InventoryOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	inventory := &Inventory{
+		Region:     c.Region,
+		ExportedAt: NewTime(time.Now().UTC()),
+		DNSRecords: map[string][]DNSRecord{},
+	}
+
+	listers := map[string]func(client *Client) error{
+		"instances": func(client *Client) (err error) {
+			inventory.Instances, err = client.ListAllInstances()
+			return err
+		},
+		"kubernetes clusters": func(client *Client) (err error) {
+			inventory.KubernetesClusters, err = client.ListAllKubernetesClusters()
+			return err
+		},
+		"networks": func(client *Client) (err error) {
+			inventory.Networks, err = client.ListNetworks()
+			return err
+		},
+		"firewalls": func(client *Client) (err error) {
+			inventory.Firewalls, err = client.ListFirewalls()
+			return err
+		},
+		"volumes": func(client *Client) (err error) {
+			inventory.Volumes, err = client.ListVolumes()
+			return err
+		},
+		"load balancers": func(client *Client) (err error) {
+			inventory.LoadBalancers, err = client.ListLoadBalancers()
+			return err
+		},
+		"databases": func(client *Client) (err error) {
+			inventory.Databases, err = client.ListAllDatabases()
+			return err
+		},
+		"object stores": func(client *Client) (err error) {
+			inventory.ObjectStores, err = newPathPaginator[ObjectStore](client, "/v2/objectstores", defaultPerPage).All()
+			return err
+		},
+		"reserved IPs": func(client *Client) (err error) {
+			inventory.ReservedIPs, err = client.ListAllIPs()
+			return err
+		},
+		"SSH keys": func(client *Client) (err error) {
+			inventory.SSHKeys, err = client.ListSSHKeys()
+			return err
+		},
+		"DNS domains": func(client *Client) (err error) {
+			inventory.DNSDomains, err = client.ListDNSDomains()
+			if err != nil {
+				return err
+			}
+
+			var errs []error
+			for _, domain := range inventory.DNSDomains {
+				records, err := client.ListDNSRecords(domain.ID)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("records of %s: %w", domain.Name, err))
+					continue
+				}
+				inventory.DNSRecords[domain.ID] = records
+			}
+			return errors.Join(errs...)
+		},
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	for name, list := range listers {
+		wg.Add(1)
+		go func(name string, list func(client *Client) error) {
+			defer wg.Done()
+			if err := list(c.WithContext(ctx)); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, fmt.Errorf("unable to list the %s: %w", name, err))
+			}
+		}(name, list)
+	}
+	wg.Wait()
+
+	if !options.IncludeSecrets {
+		inventory.clearSecrets()
+	}
+
+	return inventory, errors.Join(errs...)
+}
+
+// clearSecrets empties the fields of the resources holding passwords, tokens or kubeconfigs
+func (inv *Inventory) clearSecrets() {
+	for i := range inv.Instances {
+		inv.Instances[i].InitialPassword = ""
+		inv.Instances[i].RescuePassword = ""
+		inv.Instances[i].CivostatsdToken = ""
+	}
+
+	clearNodes := func(instances []KubernetesInstance) {
+		for i := range instances {
+			instances[i].InitialPassword = ""
+			instances[i].CivoStatsdToken = ""
+		}
+	}
+	for i := range inv.KubernetesClusters {
+		cluster := &inv.KubernetesClusters[i]
+		cluster.KubeConfig = ""
+		clearNodes(cluster.Instances)
+		for j := range cluster.Pools {
+			clearNodes(cluster.Pools[j].Instances)
+		}
+	}
+
+	for i := range inv.Databases {
+		inv.Databases[i].Password = ""
+		for j := range inv.Databases[i].DatabaseUserInfo {
+			inv.Databases[i].DatabaseUserInfo[j].Password = ""
+		}
+	}
+}
+
+// MarshalYAML marshals the inventory with the same field names as its JSON
+func (inv Inventory) MarshalYAML() (interface{}, error) {
+	// an alias without the method, so marshaling to JSON doesn't recurse
+	type inventory Inventory
+	data, err := json.Marshal(inventory(inv))
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// UnmarshalYAML reads an inventory written by MarshalYAML
+func (inv *Inventory) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(jsonCompatible(value))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, inv)
+}
+
+// jsonCompatible converts the map[interface{}]interface{} values yaml.v2 decodes into the
+// map[string]interface{} encoding/json can marshal
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = jsonCompatible(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
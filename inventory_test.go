@@ -0,0 +1,174 @@
+package civogo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func inventoryServer(t *testing.T, failing string) *httptest.Server {
+	responses := map[string]string{
+		"/v2/instances":           `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "12345", "hostname": "foo.example.com", "tags": ["web"], "initial_password": "instance-secret", "rescue_password": "rescue-secret", "civostatsd_token": "statsd-secret"}]}`,
+		"/v2/kubernetes/clusters": `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "69a23478", "name": "production", "kubeconfig": "kubeconfig-secret", "instances": [{"id": "node-1", "initial_password": "node-secret"}], "pools": [{"id": "pool-1", "instances": [{"id": "node-1", "civostatsd_token": "node-statsd-secret"}]}]}]}`,
+		"/v2/networks":            `[{"id": "net-1", "label": "default", "default": true}]`,
+		"/v2/firewalls":           `[{"id": "fw-1", "name": "web"}]`,
+		"/v2/volumes":             `[{"id": "vol-1", "name": "data", "size_gigabytes": 20}]`,
+		"/v2/loadbalancers":       `[{"id": "lb-1", "name": "web"}]`,
+		"/v2/databases":           `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "db-1", "name": "orders", "password": "db-secret", "database_user_info": [{"username": "root", "password": "db-user-secret"}]}]}`,
+		"/v2/objectstores":        `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "os-1", "name": "backups"}]}`,
+		"/v2/ips":                 `{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "ip-1", "name": "web", "ip": "10.0.0.1"}]}`,
+		"/v2/sshkeys":             `[{"id": "key-1", "name": "laptop"}]`,
+		"/v2/dns":                 `[{"id": "dom-1", "account_id": "1", "name": "example.com"}]`,
+		"/v2/dns/dom-1/records":   `[{"id": "rec-1", "domain_id": "dom-1", "name": "www", "type": "A", "value": "10.0.0.1", "ttl": 600}]`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == failing {
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"code": "internal_error", "reason": "Something went wrong"}`))
+			return
+		}
+		response, ok := responses[req.URL.Path]
+		if !ok {
+			t.Errorf("Unexpected request to %s", req.URL.Path)
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(response))
+	}))
+}
+
+func TestExportInventory(t *testing.T) {
+	server := inventoryServer(t, "")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	inventory, err := client.ExportInventory(context.Background())
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if inventory.Region != "TEST" || inventory.ExportedAt.IsZero() {
+		t.Errorf("Expected the region and export time to be set, got %q and %s", inventory.Region, inventory.ExportedAt)
+	}
+	counts := map[string]int{
+		"instances":           len(inventory.Instances),
+		"kubernetes clusters": len(inventory.KubernetesClusters),
+		"networks":            len(inventory.Networks),
+		"firewalls":           len(inventory.Firewalls),
+		"volumes":             len(inventory.Volumes),
+		"load balancers":      len(inventory.LoadBalancers),
+		"databases":           len(inventory.Databases),
+		"object stores":       len(inventory.ObjectStores),
+		"reserved IPs":        len(inventory.ReservedIPs),
+		"SSH keys":            len(inventory.SSHKeys),
+		"DNS domains":         len(inventory.DNSDomains),
+		"DNS records":         len(inventory.DNSRecords["dom-1"]),
+	}
+	for name, count := range counts {
+		if count != 1 {
+			t.Errorf("Expected one of the %s, got %d", name, count)
+		}
+	}
+}
+
+func TestExportInventoryPartialFailure(t *testing.T) {
+	server := inventoryServer(t, "/v2/volumes")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	inventory, err := client.ExportInventory(context.Background())
+	if err == nil {
+		t.Errorf("Expected an error for the volumes")
+		return
+	}
+	if inventory == nil || len(inventory.Instances) != 1 || inventory.Volumes != nil {
+		t.Errorf("Expected every other resource in the inventory, got %+v", inventory)
+	}
+}
+
+func TestInventoryMarshaling(t *testing.T) {
+	server := inventoryServer(t, "")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	inventory, err := client.ExportInventory(context.Background())
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	data, err := yaml.Marshal(inventory)
+	if err != nil {
+		t.Errorf("Marshaling to YAML returned an error: %s", err)
+		return
+	}
+	var fields map[string]interface{}
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		t.Errorf("Unmarshaling YAML returned an error: %s", err)
+		return
+	}
+	if _, ok := fields["kubernetes_clusters"]; !ok {
+		t.Errorf("Expected the YAML to use the JSON field names, got %s", data)
+	}
+
+	fromYAML := &Inventory{}
+	if err := yaml.Unmarshal(data, fromYAML); err != nil {
+		t.Errorf("Unmarshaling the inventory from YAML returned an error: %s", err)
+		return
+	}
+
+	data, err = json.Marshal(inventory)
+	if err != nil {
+		t.Errorf("Marshaling to JSON returned an error: %s", err)
+		return
+	}
+	fromJSON := &Inventory{}
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Errorf("Unmarshaling JSON returned an error: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(fromYAML, fromJSON) {
+		t.Errorf("Expected the YAML and JSON round trips to match, got %+v and %+v", fromYAML, fromJSON)
+	}
+	if fromJSON.DNSRecords["dom-1"][0].TTL != 600 || fromJSON.Instances[0].Hostname != "foo.example.com" {
+		t.Errorf("Expected the resources to survive the round trip, got %+v", fromJSON)
+	}
+}
+
+func TestExportInventorySecrets(t *testing.T) {
+	server := inventoryServer(t, "")
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	inventory, err := client.ExportInventory(context.Background())
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		t.Errorf("Marshaling to JSON returned an error: %s", err)
+		return
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("Expected no secrets in the inventory, got %s", data)
+	}
+
+	inventory, err = client.ExportInventory(context.Background(), WithInventorySecrets())
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if inventory.KubernetesClusters[0].KubeConfig != "kubeconfig-secret" || inventory.Databases[0].Password != "db-secret" {
+		t.Errorf("Expected the secrets to be kept with WithInventorySecrets, got %+v", inventory)
+	}
+}
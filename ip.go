@@ -2,7 +2,9 @@ package civogo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -15,6 +17,16 @@ type IP struct {
 	AssignedTo AssignedTo `json:"assigned_to,omitempty"`
 }
 
+// Clone returns a copy of the reserved IP
+func (ip *IP) Clone() *IP {
+	if ip == nil {
+		return nil
+	}
+
+	c := *ip
+	return &c
+}
+
 // AssignedTo represents IP assigned to resource
 type AssignedTo struct {
 	ID string `json:"id"`
@@ -25,6 +37,17 @@ type AssignedTo struct {
 	Name string `json:"name"`
 }
 
+// Types of resource a reserved IP can be assigned to
+const (
+	IPResourceTypeInstance     = "instance"
+	IPResourceTypeLoadBalancer = "loadbalancer"
+)
+
+// Assigned reports whether the IP is assigned to a resource
+func (ip *IP) Assigned() bool {
+	return ip.AssignedTo.ID != ""
+}
+
 // CreateIPRequest is a struct for creating an IP
 type CreateIPRequest struct {
 	// Name is an optional parameter. If not provided, name will be the IP address
@@ -42,6 +65,11 @@ type PaginatedIPs struct {
 	Items   []IP `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedIPs) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // UpdateIPRequest is a struct for creating an IP
 type UpdateIPRequest struct {
 	Name string `json:"name" validate:"required"`
@@ -59,9 +87,9 @@ type Actions struct {
 	Region string `json:"region"`
 }
 
-// ListIPs returns all reserved IPs in that specific region
-func (c *Client) ListIPs() (*PaginatedIPs, error) {
-	resp, err := c.SendGetRequest("/v2/ips")
+// ListIPs returns all reserved IPs in that specific region, a page at a time, see ListOptions
+func (c *Client) ListIPs(opts ...ListOptions) (*PaginatedIPs, error) {
+	resp, err := c.sendListRequest("/v2/ips", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -74,6 +102,16 @@ func (c *Client) ListIPs() (*PaginatedIPs, error) {
 	return ips, nil
 }
 
+// IPsPaginator returns a Paginator over all reserved IPs, perPage at a time
+func (c *Client) IPsPaginator(perPage int) *Paginator[IP] {
+	return newPathPaginator[IP](c, "/v2/ips", perPage)
+}
+
+// ListAllIPs returns every reserved IP, walking all the pages
+func (c *Client) ListAllIPs() ([]IP, error) {
+	return c.IPsPaginator(defaultPerPage).All()
+}
+
 // GetIP finds an reserved IP by the full ID
 func (c *Client) GetIP(id string) (*IP, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/ips/%s", id))
@@ -138,6 +176,11 @@ func (c *Client) NewIP(v *CreateIPRequest) (*IP, error) {
 	return result, nil
 }
 
+// CreateIP reserves a new IP in the client's region, name is optional and defaults to the address
+func (c *Client) CreateIP(name string) (*IP, error) {
+	return c.NewIP(&CreateIPRequest{Name: name, Region: c.Region})
+}
+
 // UpdateIP updates an IP
 func (c *Client) UpdateIP(id string, v *UpdateIPRequest) (*IP, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/ips/%s", id), v)
@@ -191,6 +234,111 @@ func (c *Client) UnassignIP(id, region string) (*SimpleResponse, error) {
 	return c.DecodeSimpleResponse(resp)
 }
 
+// ReassignIP moves a reserved IP to a resource, unassigning it first from whatever it is assigned to,
+// which is what a failover script needs. Nothing is sent when the IP is already on the resource
+func (c *Client) ReassignIP(id, resourceID, resourceType string) (*SimpleResponse, error) {
+	if resourceType != IPResourceTypeInstance && resourceType != IPResourceTypeLoadBalancer {
+		err := fmt.Errorf("resource type must be %s or %s, got %q", IPResourceTypeInstance, IPResourceTypeLoadBalancer, resourceType)
+		return nil, IPResourceTypeInvalidError.wrap(err)
+	}
+
+	ip, err := c.GetIP(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ip.AssignedTo.ID == resourceID && ip.AssignedTo.Type == resourceType {
+		return &SimpleResponse{Result: "success"}, nil
+	}
+
+	if ip.Assigned() {
+		if _, err := c.UnassignIP(id, c.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.AssignIP(id, resourceID, resourceType, c.Region)
+}
+
+// MovePublicIP moves a reserved IP to targetInstanceID for a blue/green failover and waits until the
+// instance reports it. source is the reserved IP (its ID, name or address) or the instance currently
+// holding it. Moving an instance's own public IP isn't supported, the API can't turn it into a reserved
+// IP, so for an instance without one an IPNotReservedError is returned, reserve an IP with NewIP and
+// assign it to the instance before it's needed for a failover. When assigning the IP to
+// the target fails it is assigned back to where it was. This is not the same as MovePublicIPToInstance,
+// which moves an address within the public IPs of a single instance
+func (c *Client) MovePublicIP(source, targetInstanceID string, opts ...WaitOption) (*IP, error) {
+	ip, err := c.findMovableIP(source)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := ip.AssignedTo
+	if previous.ID != targetInstanceID || previous.Type != IPResourceTypeInstance {
+		if ip.Assigned() {
+			if _, err := c.UnassignIP(ip.ID, c.Region); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := c.AssignIP(ip.ID, targetInstanceID, IPResourceTypeInstance, c.Region); err != nil {
+			if previous.ID == "" {
+				return nil, err
+			}
+			if _, rollbackErr := c.AssignIP(ip.ID, previous.ID, previous.Type, c.Region); rollbackErr != nil {
+				return nil, errors.Join(err, fmt.Errorf("assigning %s back to %s: %w", ip.IP, previous.ID, rollbackErr))
+			}
+			return nil, err
+		}
+	}
+
+	var moved *IP
+	err = c.WaitFor(c.requestContext(), func(ctx context.Context) (bool, string, error) {
+		client := c.WithContext(ctx)
+		current, err := client.GetIP(ip.ID)
+		if err != nil {
+			return false, "", err
+		}
+		moved = current
+		if current.AssignedTo.ID != targetInstanceID {
+			return false, "assigning", nil
+		}
+
+		instance, err := client.GetInstance(targetInstanceID)
+		if err != nil {
+			return false, "", err
+		}
+		if instance.PublicIP != current.IP && instance.ReservedIP != current.IP {
+			return false, "attaching", nil
+		}
+		return true, "assigned", nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+// findMovableIP returns the reserved IP matching search, or the one of the instance matching search
+func (c *Client) findMovableIP(search string) (*IP, error) {
+	ip, err := c.FindIP(search)
+	if err == nil || !errors.Is(err, ZeroMatchesError) {
+		return ip, err
+	}
+
+	instance, err := c.FindInstance(search)
+	if err != nil {
+		return nil, err
+	}
+	if instance.ReservedIPID == "" {
+		err := fmt.Errorf("the public IP of instance %s is not a reserved IP, only reserved IPs can be moved", instance.Hostname)
+		return nil, IPNotReservedError.wrap(err)
+	}
+
+	return c.GetIP(instance.ReservedIPID)
+}
+
 // DeleteIP deletes an IP
 func (c *Client) DeleteIP(id string) (*SimpleResponse, error) {
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/ips/%s", id))
@@ -1,8 +1,14 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListIPs(t *testing.T) {
@@ -187,3 +193,124 @@ func TestUnAssignIP(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestReassignIP(t *testing.T) {
+	actions := []Actions{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/ips/ip-1":
+			rw.Write([]byte(`{"id": "ip-1", "ip": "74.220.1.1", "assigned_to": {"id": "inst-1", "type": "instance", "name": "web-1"}}`))
+		case "/v2/ips/ip-1/actions":
+			action := Actions{}
+			if err := json.NewDecoder(req.Body).Decode(&action); err != nil {
+				t.Error(err)
+			}
+			actions = append(actions, action)
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	if _, err := client.ReassignIP("ip-1", "inst-1", IPResourceTypeInstance); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	if len(actions) != 0 {
+		t.Errorf("Expected no actions for an IP already assigned, got %+v", actions)
+	}
+
+	if _, err := client.ReassignIP("ip-1", "inst-2", IPResourceTypeInstance); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	expected := []Actions{
+		{Action: "unassign", Region: client.Region},
+		{Action: "assign", AssignToID: "inst-2", AssignToType: "instance", Region: client.Region},
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, actions)
+	}
+
+	if _, err := client.ReassignIP("ip-1", "inst-2", "volume"); !errors.Is(err, IPResourceTypeInvalidError) {
+		t.Errorf("Expected an IPResourceTypeInvalidError, got %v", err)
+	}
+}
+
+func TestMovePublicIP(t *testing.T) {
+	assignedTo := "blue"
+	failAssign := false
+	actions := []Actions{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/ips":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "ip-1", "name": "failover", "ip": "74.220.1.1"}]}`))
+		case "/v2/ips/ip-1":
+			fmt.Fprintf(rw, `{"id": "ip-1", "name": "failover", "ip": "74.220.1.1", "assigned_to": {"id": %q, "type": "instance"}}`, assignedTo)
+		case "/v2/ips/ip-1/actions":
+			action := Actions{}
+			if err := json.NewDecoder(req.Body).Decode(&action); err != nil {
+				t.Error(err)
+			}
+			actions = append(actions, action)
+			if action.Action == "assign" && action.AssignToID == "green" && failAssign {
+				rw.WriteHeader(http.StatusUnprocessableEntity)
+				rw.Write([]byte(`{"code": "database_cannot_move_ip", "reason": "the instance is not active"}`))
+				return
+			}
+			assignedTo = action.AssignToID
+			rw.Write([]byte(`{"result": "success"}`))
+		case "/v2/instances":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [
+				{"id": "blue", "hostname": "blue", "reserved_ip_id": "ip-1", "reserved_ip": "74.220.1.1"},
+				{"id": "green", "hostname": "green"}
+			]}`))
+		case "/v2/instances/green":
+			if assignedTo == "green" {
+				rw.Write([]byte(`{"id": "green", "hostname": "green", "public_ip": "74.220.1.1"}`))
+			} else {
+				rw.Write([]byte(`{"id": "green", "hostname": "green", "public_ip": "74.220.9.9"}`))
+			}
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	if _, err := client.MovePublicIP("green", "blue", WithWaitInterval(time.Millisecond)); !errors.Is(err, IPNotReservedError) {
+		t.Errorf("Expected an IPNotReservedError moving the public IP of green, got %v", err)
+	}
+	if len(actions) != 0 {
+		t.Errorf("Expected no IP actions for an unreserved IP, got %+v", actions)
+	}
+
+	failAssign = true
+	if _, err := client.MovePublicIP("blue", "green", WithWaitInterval(time.Millisecond)); !errors.Is(err, DatabaseCannotMoveIPError) {
+		t.Errorf("Expected a DatabaseCannotMoveIPError, got %v", err)
+	}
+	if assignedTo != "blue" {
+		t.Errorf("Expected the IP to be assigned back to blue, it is on %q", assignedTo)
+	}
+
+	failAssign = false
+	actions = actions[:0]
+	ip, err := client.MovePublicIP("blue", "green", WithWaitInterval(time.Millisecond))
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if ip.AssignedTo.ID != "green" {
+		t.Errorf("Expected the IP to be assigned to green, got %+v", ip)
+	}
+
+	expected := []Actions{
+		{Action: "unassign", Region: client.Region},
+		{Action: "assign", AssignToID: "green", AssignToType: "instance", Region: client.Region},
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, actions)
+	}
+}
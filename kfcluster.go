@@ -5,19 +5,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 )
 
 // KfCluster represents a cluster with Kubeflow installed.
 type KfCluster struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	NetworkID     string    `json:"network_id"`
-	FirewallID    string    `json:"firewall_id,omitempty"`
-	Size          string    `json:"size,omitempty"`
-	KubeflowReady string    `json:"kubeflow_ready,omitempty"`
-	DashboardURL  string    `json:"dashboard_url,omitempty"`
-	CreatedAt     time.Time `json:"created_at,omitempty"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	NetworkID     string `json:"network_id"`
+	FirewallID    string `json:"firewall_id,omitempty"`
+	Size          string `json:"size,omitempty"`
+	KubeflowReady string `json:"kubeflow_ready,omitempty"`
+	DashboardURL  string `json:"dashboard_url,omitempty"`
+	CreatedAt     Time   `json:"created_at,omitempty"`
+}
+
+// Clone returns a copy of the Kubeflow cluster
+func (k *KfCluster) Clone() *KfCluster {
+	if k == nil {
+		return nil
+	}
+
+	c := *k
+	return &c
 }
 
 // CreateKfClusterReq is the request for creating a KfCluster.
@@ -44,9 +53,14 @@ type PaginatedKfClusters struct {
 	Items   []KfCluster `json:"items"`
 }
 
-// ListKfClusters returns all applications in that specific region
-func (c *Client) ListKfClusters() (*PaginatedKfClusters, error) {
-	resp, err := c.SendGetRequest("/v2/kfclusters")
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedKfClusters) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
+// ListKfClusters returns all applications in that specific region, a page at a time, see ListOptions
+func (c *Client) ListKfClusters(opts ...ListOptions) (*PaginatedKfClusters, error) {
+	resp, err := c.sendListRequest("/v2/kfclusters", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
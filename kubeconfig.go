@@ -0,0 +1,213 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+// kubeconfig is the subset of a kubeconfig file needed to merge one into another,
+// anything else in the file is kept as is through the inline maps
+type kubeconfig struct {
+	APIVersion     string                 `yaml:"apiVersion,omitempty"`
+	Kind           string                 `yaml:"kind,omitempty"`
+	Clusters       []kubeconfigEntry      `yaml:"clusters"`
+	Contexts       []kubeconfigContext    `yaml:"contexts"`
+	Users          []kubeconfigEntry      `yaml:"users"`
+	CurrentContext string                 `yaml:"current-context"`
+	Extra          map[string]interface{} `yaml:",inline"`
+}
+
+// kubeconfigEntry is a named cluster or user of a kubeconfig
+type kubeconfigEntry struct {
+	Name  string                 `yaml:"name"`
+	Extra map[string]interface{} `yaml:",inline"`
+}
+
+// kubeconfigContext is a named context of a kubeconfig
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string                 `yaml:"cluster"`
+		User    string                 `yaml:"user"`
+		Extra   map[string]interface{} `yaml:",inline"`
+	} `yaml:"context"`
+}
+
+// GetKubernetesKubeconfig returns the kubeconfig YAML of a kubernetes cluster
+func (c *Client) GetKubernetesKubeconfig(clusterID string) (string, error) {
+	cluster, err := c.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	if cluster.KubeConfig == "" {
+		err := fmt.Errorf("cluster %s has no kubeconfig yet, it is %s", cluster.Name, cluster.Status)
+		return "", KubernetesKubeconfigUnavailableError.wrap(err)
+	}
+
+	return cluster.KubeConfig, nil
+}
+
+// MergeKubeconfig merges the credentials of a kubernetes cluster into the kubeconfig file at path,
+// creating the file if needed, and makes the cluster the current context. It returns the name
+// of the context, see MergeKubeconfigs for how clashing names are handled
+func (c *Client) MergeKubeconfig(clusterID, path string) (string, error) {
+	incoming, err := c.GetKubernetesKubeconfig(clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	return mergeKubeconfigFile(path, incoming)
+}
+
+// mergeKubeconfigFile merges incoming into the kubeconfig file at path
+func mergeKubeconfigFile(path, incoming string) (string, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	merged, contextName, err := MergeKubeconfigs(existing, []byte(incoming))
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+
+	return contextName, os.WriteFile(path, merged, 0600)
+}
+
+// MergeKubeconfigs merges the current context of incoming, with its cluster and user, into existing
+// and makes it the current context, returning the result and the name of the context.
+// When existing already has a context pointing at the same API server its entries are replaced,
+// so credentials get refreshed; otherwise clashing names get a numeric suffix
+func MergeKubeconfigs(existing, incoming []byte) ([]byte, string, error) {
+	in := kubeconfig{}
+	if err := yaml.Unmarshal(incoming, &in); err != nil {
+		return nil, "", fmt.Errorf("unable to parse the cluster kubeconfig: %v", err)
+	}
+
+	ctx, ok := in.context(in.CurrentContext)
+	if !ok && len(in.Contexts) > 0 {
+		ctx = in.Contexts[0]
+		ok = true
+	}
+	if !ok {
+		return nil, "", errors.New("the cluster kubeconfig has no context")
+	}
+	cluster, found := findKubeconfigEntry(in.Clusters, ctx.Context.Cluster)
+	if !found {
+		return nil, "", fmt.Errorf("the cluster kubeconfig has no cluster %q for context %q", ctx.Context.Cluster, ctx.Name)
+	}
+	user, found := findKubeconfigEntry(in.Users, ctx.Context.User)
+	if !found {
+		return nil, "", fmt.Errorf("the cluster kubeconfig has no user %q for context %q", ctx.Context.User, ctx.Name)
+	}
+
+	out := kubeconfig{APIVersion: "v1", Kind: "Config"}
+	if err := yaml.Unmarshal(existing, &out); err != nil {
+		return nil, "", fmt.Errorf("unable to parse the existing kubeconfig: %v", err)
+	}
+
+	if current, found := out.contextForServer(cluster); found {
+		ctx.Name, cluster.Name, user.Name = current.Name, current.Context.Cluster, current.Context.User
+	} else {
+		ctx.Name = uniqueKubeconfigName(ctx.Name, func(n string) bool { _, ok := out.context(n); return ok })
+		cluster.Name = uniqueKubeconfigName(cluster.Name, func(n string) bool { _, ok := findKubeconfigEntry(out.Clusters, n); return ok })
+		user.Name = uniqueKubeconfigName(user.Name, func(n string) bool { _, ok := findKubeconfigEntry(out.Users, n); return ok })
+	}
+	ctx.Context.Cluster, ctx.Context.User = cluster.Name, user.Name
+
+	out.Clusters = replaceKubeconfigEntry(out.Clusters, cluster)
+	out.Users = replaceKubeconfigEntry(out.Users, user)
+	out.Contexts = out.replaceContext(ctx)
+	out.CurrentContext = ctx.Name
+
+	merged, err := yaml.Marshal(&out)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return merged, ctx.Name, nil
+}
+
+// context returns the context called name
+func (k *kubeconfig) context(name string) (kubeconfigContext, bool) {
+	for _, ctx := range k.Contexts {
+		if ctx.Name == name {
+			return ctx, true
+		}
+	}
+	return kubeconfigContext{}, false
+}
+
+// contextForServer returns the context using a cluster with the same API server as cluster
+func (k *kubeconfig) contextForServer(cluster kubeconfigEntry) (kubeconfigContext, bool) {
+	for _, ctx := range k.Contexts {
+		if existing, ok := findKubeconfigEntry(k.Clusters, ctx.Context.Cluster); ok && sameKubeconfigServer(existing, cluster) {
+			return ctx, true
+		}
+	}
+	return kubeconfigContext{}, false
+}
+
+// replaceContext returns the contexts with ctx replacing the one of the same name, or appended
+func (k *kubeconfig) replaceContext(ctx kubeconfigContext) []kubeconfigContext {
+	for i := range k.Contexts {
+		if k.Contexts[i].Name == ctx.Name {
+			k.Contexts[i] = ctx
+			return k.Contexts
+		}
+	}
+	return append(k.Contexts, ctx)
+}
+
+// findKubeconfigEntry returns the entry called name
+func findKubeconfigEntry(entries []kubeconfigEntry, name string) (kubeconfigEntry, bool) {
+	for _, entry := range entries {
+		if entry.Name == name {
+			return entry, true
+		}
+	}
+	return kubeconfigEntry{Name: name}, false
+}
+
+// replaceKubeconfigEntry returns the entries with entry replacing the one of the same name, or appended
+func replaceKubeconfigEntry(entries []kubeconfigEntry, entry kubeconfigEntry) []kubeconfigEntry {
+	for i := range entries {
+		if entries[i].Name == entry.Name {
+			entries[i] = entry
+			return entries
+		}
+	}
+	return append(entries, entry)
+}
+
+// sameKubeconfigServer reports whether two cluster entries point at the same API server
+func sameKubeconfigServer(a, b kubeconfigEntry) bool {
+	serverOf := func(e kubeconfigEntry) interface{} {
+		if cluster, ok := e.Extra["cluster"].(map[interface{}]interface{}); ok {
+			return cluster["server"]
+		}
+		return nil
+	}
+	return serverOf(a) != nil && reflect.DeepEqual(serverOf(a), serverOf(b))
+}
+
+// uniqueKubeconfigName returns name, or name with the first numeric suffix that is not taken
+func uniqueKubeconfigName(name string, taken func(string) bool) string {
+	candidate := name
+	for i := 1; taken(candidate); i++ {
+		candidate = name + "-" + strconv.Itoa(i)
+	}
+	return candidate
+}
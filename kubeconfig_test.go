@@ -0,0 +1,100 @@
+package civogo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+const testClusterKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: my-cluster
+  cluster:
+    certificate-authority-data: Y2VydA==
+    server: https://10.0.0.1:6443
+contexts:
+- name: my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster
+current-context: my-cluster
+users:
+- name: my-cluster
+  user:
+    client-key-data: a2V5
+`
+
+func TestMergeKubeconfigsIntoEmpty(t *testing.T) {
+	g := NewWithT(t)
+
+	merged, name, err := MergeKubeconfigs(nil, []byte(testClusterKubeconfig))
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("my-cluster"))
+
+	out := kubeconfig{}
+	g.Expect(yaml.Unmarshal(merged, &out)).To(Succeed())
+	g.Expect(out.CurrentContext).To(Equal("my-cluster"))
+	g.Expect(out.Clusters).To(HaveLen(1))
+	g.Expect(out.Users).To(HaveLen(1))
+	g.Expect(string(merged)).To(ContainSubstring("client-key-data: a2V5"))
+}
+
+func TestMergeKubeconfigsCollision(t *testing.T) {
+	g := NewWithT(t)
+
+	other := strings.ReplaceAll(testClusterKubeconfig, "10.0.0.1", "10.9.9.9")
+	merged, name, err := MergeKubeconfigs([]byte(other), []byte(testClusterKubeconfig))
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("my-cluster-1"))
+
+	out := kubeconfig{}
+	g.Expect(yaml.Unmarshal(merged, &out)).To(Succeed())
+	g.Expect(out.Contexts).To(HaveLen(2))
+	g.Expect(out.Clusters).To(HaveLen(2))
+	ctx, _ := out.context("my-cluster-1")
+	g.Expect(ctx.Context.Cluster).To(Equal("my-cluster-1"))
+	g.Expect(ctx.Context.User).To(Equal("my-cluster-1"))
+
+	// merging the same cluster again refreshes it instead of adding another copy
+	refreshed := strings.ReplaceAll(testClusterKubeconfig, "a2V5", "bmV3")
+	merged, name, err = MergeKubeconfigs(merged, []byte(refreshed))
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("my-cluster-1"))
+	out = kubeconfig{}
+	g.Expect(yaml.Unmarshal(merged, &out)).To(Succeed())
+	g.Expect(out.Contexts).To(HaveLen(2))
+	g.Expect(string(merged)).To(ContainSubstring("client-key-data: bmV3"))
+}
+
+func TestMergeKubeconfigsMissingEntries(t *testing.T) {
+	g := NewWithT(t)
+
+	missingUser := strings.Replace(testClusterKubeconfig, "    user: my-cluster", "    user: missing", 1)
+	_, _, err := MergeKubeconfigs(nil, []byte(missingUser))
+	g.Expect(err).To(MatchError(ContainSubstring(`no user "missing"`)))
+
+	missingCluster := strings.Replace(testClusterKubeconfig, "    cluster: my-cluster", "    cluster: missing", 1)
+	_, _, err = MergeKubeconfigs(nil, []byte(missingCluster))
+	g.Expect(err).To(MatchError(ContainSubstring(`no cluster "missing"`)))
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+
+	client, _ := NewFakeClient()
+	client.Clusters = append(client.Clusters, KubernetesCluster{ID: "69a23478", Name: "my-cluster", KubeConfig: testClusterKubeconfig})
+
+	path := filepath.Join(t.TempDir(), ".kube", "config")
+	name, err := client.MergeKubeconfig("69a23478", path)
+	g.Expect(err).To(BeNil())
+	g.Expect(name).To(Equal("my-cluster"))
+
+	info, err := os.Stat(path)
+	g.Expect(err).To(BeNil())
+	g.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+}
@@ -5,31 +5,44 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
+	"golang.org/x/mod/semver"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // KubernetesInstance represents a single node/master within a Kubernetes cluster
 type KubernetesInstance struct {
-	ID              string    `json:"id"`
-	Hostname        string    `json:"hostname,omitempty"`
-	Size            string    `json:"size,omitempty"`
-	Region          string    `json:"region,omitempty"`
-	SourceType      string    `json:"source_type,omitempty"`
-	SourceID        string    `json:"source_id,omitempty"`
-	InitialUser     string    `json:"initial_user,omitempty"`
-	InitialPassword string    `json:"initial_password,omitempty"`
-	Status          string    `json:"status,omitempty"`
-	FirewallID      string    `json:"firewall_id,omitempty"`
-	PublicIP        string    `json:"public_ip,omitempty"`
-	CPUCores        int       `json:"cpu_cores,omitempty"`
-	RAMMegabytes    int       `json:"ram_mb,omitempty"`
-	DiskGigabytes   int       `json:"disk_gb,omitempty"`
-	Tags            []string  `json:"tags,omitempty"`
-	CreatedAt       time.Time `json:"created_at,omitempty"`
-	CivoStatsdToken string    `json:"civostatsd_token,omitempty"`
+	ID              string   `json:"id"`
+	Hostname        string   `json:"hostname,omitempty"`
+	Size            string   `json:"size,omitempty"`
+	Region          string   `json:"region,omitempty"`
+	SourceType      string   `json:"source_type,omitempty"`
+	SourceID        string   `json:"source_id,omitempty"`
+	InitialUser     string   `json:"initial_user,omitempty"`
+	InitialPassword string   `json:"initial_password,omitempty"`
+	Status          string   `json:"status,omitempty"`
+	FirewallID      string   `json:"firewall_id,omitempty"`
+	PublicIP        string   `json:"public_ip,omitempty"`
+	CPUCores        int      `json:"cpu_cores,omitempty"`
+	RAMMegabytes    int      `json:"ram_mb,omitempty"`
+	DiskGigabytes   int      `json:"disk_gb,omitempty"`
+	GPUCount        int      `json:"gpu_count,omitempty"`
+	GPUType         string   `json:"gpu_type,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	CreatedAt       Time     `json:"created_at,omitempty"`
+	CivoStatsdToken string   `json:"civostatsd_token,omitempty"`
+}
+
+// Clone returns a deep copy of the Kubernetes instance, sharing none of its slices and maps
+func (i *KubernetesInstance) Clone() *KubernetesInstance {
+	if i == nil {
+		return nil
+	}
+
+	c := *i
+	c.Tags = cloneSlice(i.Tags)
+	return &c
 }
 
 // KubernetesPool represents a single pool within a Kubernetes cluster
@@ -43,6 +56,31 @@ type KubernetesPool struct {
 	Annotations      map[string]string    `json:"annotations,omitempty"`
 	Taints           []corev1.Taint       `json:"taints,omitempty"`
 	PublicIPNodePool bool                 `json:"public_ip_node_pool,omitempty"`
+	MinCount         int                  `json:"min_count,omitempty"`
+	MaxCount         int                  `json:"max_count,omitempty"`
+	// GPUCount and GPUType are the GPUs of every node of the pool, GPUCount is zero for pools without GPUs
+	GPUCount int    `json:"gpu_count,omitempty"`
+	GPUType  string `json:"gpu_type,omitempty"`
+}
+
+// Clone returns a deep copy of the pool, sharing none of its slices and maps
+func (p *KubernetesPool) Clone() *KubernetesPool {
+	if p == nil {
+		return nil
+	}
+
+	c := *p
+	c.InstanceNames = cloneSlice(p.InstanceNames)
+	c.Instances = cloneEach(p.Instances, (*KubernetesInstance).Clone)
+	c.Labels = cloneMap(p.Labels)
+	c.Annotations = cloneMap(p.Annotations)
+	c.Taints = cloneTaints(p.Taints)
+	return &c
+}
+
+// cloneTaints returns a deep copy of taints
+func cloneTaints(taints []corev1.Taint) []corev1.Taint {
+	return cloneEach(taints, (*corev1.Taint).DeepCopy)
 }
 
 // KubernetesInstalledApplication is an application within our marketplace available for
@@ -58,12 +96,29 @@ type KubernetesInstalledApplication struct {
 	Installed     bool                                `json:"installed,omitempty"`
 	URL           string                              `json:"url,omitempty"`
 	Category      string                              `json:"category,omitempty"`
-	UpdatedAt     time.Time                           `json:"updated_at,omitempty"`
+	UpdatedAt     Time                                `json:"updated_at,omitempty"`
 	ImageURL      string                              `json:"image_url,omitempty"`
 	Plan          string                              `json:"plan,omitempty"`
 	Configuration map[string]ApplicationConfiguration `json:"configuration,omitempty"`
 }
 
+// Clone returns a deep copy of the installed application, sharing none of its slices and maps
+func (a *KubernetesInstalledApplication) Clone() *KubernetesInstalledApplication {
+	if a == nil {
+		return nil
+	}
+
+	c := *a
+	c.Dependencies = cloneSlice(a.Dependencies)
+	if a.Configuration != nil {
+		c.Configuration = make(map[string]ApplicationConfiguration, len(a.Configuration))
+		for name, config := range a.Configuration {
+			c.Configuration[name] = cloneMap(config)
+		}
+	}
+	return &c
+}
+
 // ApplicationConfiguration is a configuration for installed application
 type ApplicationConfiguration map[string]string
 
@@ -88,7 +143,7 @@ type KubernetesCluster struct {
 	ClusterType           string                           `json:"cluster_type,omitempty"`
 	NumTargetNode         int                              `json:"num_target_nodes,omitempty"`
 	TargetNodeSize        string                           `json:"target_nodes_size,omitempty"`
-	BuiltAt               time.Time                        `json:"built_at,omitempty"`
+	BuiltAt               Time                             `json:"built_at,omitempty"`
 	KubeConfig            string                           `json:"kubeconfig,omitempty"`
 	KubernetesVersion     string                           `json:"kubernetes_version,omitempty"`
 	APIEndPoint           string                           `json:"api_endpoint,omitempty"`
@@ -99,7 +154,8 @@ type KubernetesCluster struct {
 	NetworkID             string                           `json:"network_id,omitempty"`
 	NameSpace             string                           `json:"namespace,omitempty"`
 	Tags                  []string                         `json:"tags,omitempty"`
-	CreatedAt             time.Time                        `json:"created_at,omitempty"`
+	Labels                map[string]string                `json:"labels,omitempty"`
+	CreatedAt             Time                             `json:"created_at,omitempty"`
 	Instances             []KubernetesInstance             `json:"instances,omitempty"`
 	Pools                 []KubernetesPool                 `json:"pools,omitempty"`
 	RequiredPools         []RequiredPools                  `json:"required_pools,omitempty"`
@@ -110,6 +166,29 @@ type KubernetesCluster struct {
 	Conditions            []Condition                      `json:"conditions"`
 }
 
+// Clone returns a deep copy of the cluster, sharing none of its slices and maps
+func (k *KubernetesCluster) Clone() *KubernetesCluster {
+	if k == nil {
+		return nil
+	}
+
+	c := *k
+	c.Tags = cloneSlice(k.Tags)
+	c.Labels = cloneMap(k.Labels)
+	c.Instances = cloneEach(k.Instances, (*KubernetesInstance).Clone)
+	c.Pools = cloneEach(k.Pools, (*KubernetesPool).Clone)
+	c.RequiredPools = cloneEach(k.RequiredPools, func(p *RequiredPools) *RequiredPools {
+		pool := *p
+		pool.Labels = cloneMap(p.Labels)
+		pool.Annotations = cloneMap(p.Annotations)
+		pool.Taints = cloneTaints(p.Taints)
+		return &pool
+	})
+	c.InstalledApplications = cloneEach(k.InstalledApplications, (*KubernetesInstalledApplication).Clone)
+	c.Conditions = cloneSlice(k.Conditions)
+	return &c
+}
+
 // RequiredPools returns the required pools for a given Kubernetes cluster
 type RequiredPools struct {
 	ID               string            `json:"id"`
@@ -129,23 +208,98 @@ type PaginatedKubernetesClusters struct {
 	Items   []KubernetesCluster `json:"items"`
 }
 
-// KubernetesClusterConfig is used to create a new cluster
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedKubernetesClusters) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
+// KubernetesClusterConfig is used to create a new cluster, along with its node pools, network,
+// firewall, CNI plugin and applications in a single call
 type KubernetesClusterConfig struct {
-	Name              string                        `json:"name,omitempty"`
-	Region            string                        `json:"region,omitempty"`
-	ClusterType       string                        `json:"cluster_type,omitempty"`
-	NumTargetNodes    int                           `json:"num_target_nodes,omitempty"`
-	TargetNodesSize   string                        `json:"target_nodes_size,omitempty"`
-	KubernetesVersion string                        `json:"kubernetes_version,omitempty"`
-	NodeDestroy       string                        `json:"node_destroy,omitempty"`
-	NetworkID         string                        `json:"network_id,omitempty"`
-	Tags              string                        `json:"tags,omitempty"`
-	Pools             []KubernetesClusterPoolConfig `json:"pools,omitempty"`
-	Applications      string                        `json:"applications,omitempty"`
-	InstanceFirewall  string                        `json:"instance_firewall,omitempty"`
-	FirewallRule      string                        `json:"firewall_rule,omitempty"`
-	FirewallID        string                        `json:"firewall_id,omitempty"`
-	CNIPlugin         string                        `json:"cni_plugin,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Region      string `json:"region,omitempty"`
+	ClusterType string `json:"cluster_type,omitempty"`
+	// NumTargetNodes and TargetNodesSize describe the default pool, Pools can be given instead
+	NumTargetNodes    int    `json:"num_target_nodes,omitempty"`
+	TargetNodesSize   string `json:"target_nodes_size,omitempty"`
+	KubernetesVersion string `json:"kubernetes_version,omitempty"`
+	NodeDestroy       string `json:"node_destroy,omitempty"`
+	// NetworkID is the private network the cluster is created in, the default network when empty
+	NetworkID string                        `json:"network_id,omitempty"`
+	Tags      string                        `json:"tags,omitempty"`
+	Pools     []KubernetesClusterPoolConfig `json:"pools,omitempty"`
+	// Applications is a comma separated list of marketplace applications to install, each
+	// either a name or a name:plan, see AddApplications
+	Applications string `json:"applications,omitempty"`
+	// InstanceFirewall is the ID of an existing firewall for the nodes, FirewallRule the rule of the
+	// firewall created for them otherwise, e.g. "default" or "80,443,6443"
+	InstanceFirewall string `json:"instance_firewall,omitempty"`
+	FirewallRule     string `json:"firewall_rule,omitempty"`
+	FirewallID       string `json:"firewall_id,omitempty"`
+	// CNIPlugin is the network plugin of the cluster, KubernetesCNIPluginFlannel when empty
+	CNIPlugin string `json:"cni_plugin,omitempty"`
+	// UninstallApplications is a comma separated list of applications to remove from the cluster
+	UninstallApplications string `json:"uninstall_applications,omitempty"`
+	// ApplicationConfiguration holds the configuration of the applications being installed, by application name
+	ApplicationConfiguration map[string]ApplicationConfiguration `json:"application_configuration,omitempty"`
+	// Labels are key/value pairs to attach to the cluster, on creation or replacing the current ones on update
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Cluster types and CNI plugins of a KubernetesClusterConfig
+const (
+	KubernetesClusterTypeK3s   = "k3s"
+	KubernetesClusterTypeTalos = "talos"
+
+	KubernetesCNIPluginFlannel = "flannel"
+	KubernetesCNIPluginCilium  = "cilium"
+)
+
+// AddApplications adds marketplace applications, each either a name or a name:plan, to the ones
+// installed on the cluster
+func (kc *KubernetesClusterConfig) AddApplications(apps ...string) {
+	all := make([]string, 0, len(apps)+1)
+	if kc.Applications != "" {
+		all = append(all, kc.Applications)
+	}
+	kc.Applications = strings.Join(append(all, apps...), ",")
+}
+
+// ApplicationList returns the applications of the config, one name or name:plan per entry
+func (kc *KubernetesClusterConfig) ApplicationList() []string {
+	apps := []string{}
+	for _, app := range strings.Split(kc.Applications, ",") {
+		if app = strings.TrimSpace(app); app != "" {
+			apps = append(apps, app)
+		}
+	}
+	return apps
+}
+
+// Validate checks the config of a new cluster before it is sent to the API: it must have a name,
+// known cluster type and CNI plugin when set, and every pool a size and consistent node counts.
+// The rejected fields are listed in the ValidationErrors it wraps
+func (kc *KubernetesClusterConfig) Validate() error {
+	var errs ValidationErrors
+	if kc.Name == "" {
+		errs.add("name", kc.Name, "the cluster name is empty")
+	}
+	errs.addErr("cluster_type", kc.ClusterType, validateOneOf("cluster type", kc.ClusterType, KubernetesClusterTypeK3s, KubernetesClusterTypeTalos))
+	errs.addErr("cni_plugin", kc.CNIPlugin, validateOneOf("CNI plugin", kc.CNIPlugin, KubernetesCNIPluginFlannel, KubernetesCNIPluginCilium))
+
+	for i, pool := range kc.Pools {
+		if pool.Size == "" {
+			errs.add(fieldPath("pools", i, "size"), pool.Size, "pool %d has no size", i)
+		}
+		if err := validatePoolCounts(pool.Count, pool.MinCount, pool.MaxCount); err != nil {
+			errs.addErr(fieldPath("pools", i, "count"), pool.Count, fmt.Errorf("pool %d: %w", i, err))
+		}
+		if pool.GPUCount < 0 {
+			errs.add(fieldPath("pools", i, "gpu_count"), pool.GPUCount, "pool %d: the GPU count must not be negative, got %d", i, pool.GPUCount)
+		}
+	}
+
+	return errs.wrap(KubernetesClusterConfigInvalidError)
 }
 
 // KubernetesClusterPoolConfig is used to create a new cluster pool
@@ -155,10 +309,26 @@ type KubernetesClusterPoolConfig struct {
 	Count            int               `json:"count,omitempty"`
 	Size             string            `json:"size,omitempty"`
 	Labels           map[string]string `json:"labels,omitempty"`
+	Annotations      map[string]string `json:"annotations,omitempty"`
 	Taints           []corev1.Taint    `json:"taints"`
 	PublicIPNodePool bool              `json:"public_ip_node_pool,omitempty"`
+	// MinCount and MaxCount bound the node count the cluster autoscaler can scale the pool to
+	MinCount int `json:"min_count,omitempty"`
+	MaxCount int `json:"max_count,omitempty"`
+	// GPUCount and GPUType ask for GPUs on every node, the size must be a GPU size offering them, see
+	// GPUSizes and ValidateKubernetesGPUPools
+	GPUCount int    `json:"gpu_count,omitempty"`
+	GPUType  string `json:"gpu_type,omitempty"`
 }
 
+// RequestsGPU reports whether the pool asks for GPUs
+func (p *KubernetesClusterPoolConfig) RequestsGPU() bool {
+	return p.GPUCount > 0 || p.GPUType != ""
+}
+
+// KubernetesPoolConfig is the configuration of a node pool, see KubernetesClusterPoolConfig
+type KubernetesPoolConfig = KubernetesClusterPoolConfig
+
 // KubernetesPlanConfiguration is a value within a configuration for
 // an application's plan
 type KubernetesPlanConfiguration struct {
@@ -189,16 +359,25 @@ type KubernetesMarketplaceApplication struct {
 
 // KubernetesVersion represents an available version of k3s to install
 type KubernetesVersion struct {
-	Label       string `json:"label"`
-	Version     string `json:"version"`
+	Label   string `json:"label"`
+	Version string `json:"version"`
+	// Type is the release channel of the version, e.g. KubernetesVersionTypeStable
 	Type        string `json:"type"`
 	Default     bool   `json:"default,omitempty"`
 	ClusterType string `json:"clusterType,omitempty"`
 }
 
-// ListKubernetesClusters returns all cluster of kubernetes in the account
-func (c *Client) ListKubernetesClusters() (*PaginatedKubernetesClusters, error) {
-	resp, err := c.SendGetRequest("/v2/kubernetes/clusters")
+// Release channels of a KubernetesVersion
+const (
+	KubernetesVersionTypeStable      = "stable"
+	KubernetesVersionTypeDevelopment = "development"
+	KubernetesVersionTypeDeprecated  = "deprecated"
+	KubernetesVersionTypeLegacy      = "legacy"
+)
+
+// ListKubernetesClusters returns all cluster of kubernetes in the account, a page at a time, see ListOptions
+func (c *Client) ListKubernetesClusters(opts ...ListOptions) (*PaginatedKubernetesClusters, error) {
+	resp, err := c.sendListRequest("/v2/kubernetes/clusters", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -211,42 +390,44 @@ func (c *Client) ListKubernetesClusters() (*PaginatedKubernetesClusters, error)
 	return kubernetes, nil
 }
 
-// FindKubernetesCluster finds a Kubernetes cluster by either part of the ID or part of the name
+// KubernetesClustersPaginator returns a Paginator over all Kubernetes clusters, perPage at a time
+func (c *Client) KubernetesClustersPaginator(perPage int) *Paginator[KubernetesCluster] {
+	return newPathPaginator[KubernetesCluster](c, "/v2/kubernetes/clusters", perPage)
+}
+
+// ListAllKubernetesClusters returns every Kubernetes cluster, walking all the pages
+func (c *Client) ListAllKubernetesClusters() ([]KubernetesCluster, error) {
+	return c.KubernetesClustersPaginator(defaultPerPage).All()
+}
+
+// FindKubernetesCluster finds a Kubernetes cluster by its ID or name, ignoring case, exactly, by prefix or by any
+// part of them in that order. A search matching several clusters returns an *ErrMultipleMatchesFound
 func (c *Client) FindKubernetesCluster(search string) (*KubernetesCluster, error) {
 	clusters, err := c.ListKubernetesClusters()
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := KubernetesCluster{}
+	return findMatch(clusters.Items, search, true, func(k KubernetesCluster) (string, []string) { return k.ID, []string{k.Name} })
+}
 
-	for _, value := range clusters.Items {
-		if strings.EqualFold(value.Name, search) || value.ID == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(strings.ToUpper(value.Name), strings.ToUpper(search)) || strings.Contains(value.ID, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
+// NewKubernetesClusters create a new cluster of kubernetes, the config is validated first, along with the
+// GPU pools against the region when a pool asks for GPUs, see ValidateKubernetesGPUPools
+func (c *Client) NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
+	if err := kc.Validate(); err != nil {
+		return nil, err
+	}
+	for _, pool := range kc.Pools {
+		if pool.RequestsGPU() {
+			if region, sizes, ok := c.gpuPoolCatalogueIfAvailable(); ok {
+				if err := validateGPUPools(kc.Pools, region, sizes); err != nil {
+					return nil, err
+				}
 			}
+			break
 		}
 	}
 
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
-	}
-}
-
-// NewKubernetesClusters create a new cluster of kubernetes
-func (c *Client) NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error) {
 	kc.Region = c.Region
 	body, err := c.SendPostRequest("/v2/kubernetes/clusters", kc)
 	if err != nil {
@@ -305,6 +486,57 @@ func (c *Client) ListKubernetesMarketplaceApplications() ([]KubernetesMarketplac
 	return kubernetes, nil
 }
 
+// InstallKubernetesApplication installs an application from the marketplace on a cluster,
+// plan may be empty for applications without plans. The application and plan are checked
+// against ListKubernetesMarketplaceApplications first
+func (c *Client) InstallKubernetesApplication(clusterID, appName, plan string, config map[string]string) (*KubernetesCluster, error) {
+	apps, err := c.ListKubernetesMarketplaceApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	var app *KubernetesMarketplaceApplication
+	for i := range apps {
+		if strings.EqualFold(apps[i].Name, appName) {
+			app = &apps[i]
+			break
+		}
+	}
+	if app == nil {
+		err := fmt.Errorf("application %s is not in the marketplace", appName)
+		return nil, DatabaseKubernetesApplicationNotFoundError.wrap(err)
+	}
+
+	application := app.Name
+	if plan != "" {
+		found := false
+		for _, p := range app.Plans {
+			if strings.EqualFold(p.Label, plan) {
+				found = true
+				plan = p.Label
+				break
+			}
+		}
+		if !found {
+			err := fmt.Errorf("application %s has no plan %s", app.Name, plan)
+			return nil, DatabaseKubernetesApplicationInvalidPlanError.wrap(err)
+		}
+		application = fmt.Sprintf("%s:%s", app.Name, plan)
+	}
+
+	kc := &KubernetesClusterConfig{Applications: application}
+	if len(config) > 0 {
+		kc.ApplicationConfiguration = map[string]ApplicationConfiguration{app.Name: config}
+	}
+
+	return c.UpdateKubernetesCluster(clusterID, kc)
+}
+
+// RemoveKubernetesApplication uninstalls an application from a cluster
+func (c *Client) RemoveKubernetesApplication(clusterID, appName string) (*KubernetesCluster, error) {
+	return c.UpdateKubernetesCluster(clusterID, &KubernetesClusterConfig{UninstallApplications: appName})
+}
+
 // DeleteKubernetesCluster deletes a cluster
 func (c *Client) DeleteKubernetesCluster(id string) (*SimpleResponse, error) {
 	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s", id))
@@ -328,6 +560,22 @@ func (c *Client) RecycleKubernetesCluster(id string, hostname string) (*SimpleRe
 	return c.DecodeSimpleResponse(body)
 }
 
+// RecycleKubernetesNode replaces the node of a cluster found by its instance ID or hostname, as with
+// FindKubernetesClusterInstance, with a new one, e.g. when it is unhealthy. It returns the instance being
+// recycled, which can be given to WaitForKubernetesNodeRecycled
+func (c *Client) RecycleKubernetesNode(clusterID, search string) (*Instance, error) {
+	instance, err := c.FindKubernetesClusterInstance(clusterID, search)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.RecycleKubernetesCluster(clusterID, instance.Hostname); err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
 // ListAvailableKubernetesVersions returns all version of kubernetes available
 func (c *Client) ListAvailableKubernetesVersions() ([]KubernetesVersion, error) {
 	resp, err := c.SendGetRequest("/v2/kubernetes/versions")
@@ -343,7 +591,55 @@ func (c *Client) ListAvailableKubernetesVersions() ([]KubernetesVersion, error)
 	return kubernetes, nil
 }
 
-// ListKubernetesClusterInstances returns all cluster instances
+// UpgradeKubernetesCluster upgrades a cluster to version, which must be one of
+// ListAvailableKubernetesVersions (matched on version or label) and not older than
+// the version the cluster runs now. Use WaitForKubernetesClusterUpgrade to wait for it to finish
+func (c *Client) UpgradeKubernetesCluster(clusterID, version string) (*KubernetesCluster, error) {
+	cluster, err := c.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := c.ListAvailableKubernetesVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *KubernetesVersion
+	for i, v := range versions {
+		if v.Version == version || v.Label == version {
+			target = &versions[i]
+			break
+		}
+	}
+
+	switch {
+	case target == nil:
+		err := fmt.Errorf("version %s is not available", version)
+		return nil, KubernetesUpgradeInvalidError.wrap(err)
+	case target.ClusterType != "" && cluster.ClusterType != "" && !strings.EqualFold(target.ClusterType, cluster.ClusterType):
+		err := fmt.Errorf("version %s is for %s clusters, %s is a %s cluster", target.Version, target.ClusterType, cluster.Name, cluster.ClusterType)
+		return nil, KubernetesUpgradeInvalidError.wrap(err)
+	case compareKubernetesVersions(target.Version, cluster.Version) < 0:
+		err := fmt.Errorf("cluster %s runs %s, it cannot be downgraded to %s", cluster.Name, cluster.Version, target.Version)
+		return nil, KubernetesUpgradeInvalidError.wrap(err)
+	}
+
+	return c.UpdateKubernetesCluster(clusterID, &KubernetesClusterConfig{KubernetesVersion: target.Version})
+}
+
+// compareKubernetesVersions compares two versions like "1.26.4-k3s1" with semver rules,
+// versions that don't parse compare as equal
+func compareKubernetesVersions(a, b string) int {
+	a, b = "v"+strings.TrimPrefix(a, "v"), "v"+strings.TrimPrefix(b, "v")
+	if !semver.IsValid(a) || !semver.IsValid(b) {
+		return 0
+	}
+	return semver.Compare(a, b)
+}
+
+// ListKubernetesClusterInstances returns the instances underlying the nodes of a cluster, with
+// the PoolID of each set from the pools of the cluster when the API doesn't report it
 func (c *Client) ListKubernetesClusterInstances(id string) ([]Instance, error) {
 	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/instances", id))
 	if err != nil {
@@ -355,9 +651,45 @@ func (c *Client) ListKubernetesClusterInstances(id string) ([]Instance, error) {
 		return nil, err
 	}
 
+	for _, instance := range instances {
+		if instance.PoolID == "" {
+			pools, err := c.ListKubernetesClusterPools(id)
+			if err != nil {
+				return nil, err
+			}
+			setInstancePools(instances, pools)
+			break
+		}
+	}
+
 	return instances, nil
 }
 
+// setInstancePools sets the PoolID of the instances found in pools, by ID or hostname
+func setInstancePools(instances []Instance, pools []KubernetesPool) {
+	poolOf := map[string]string{}
+	for _, pool := range pools {
+		for _, name := range pool.InstanceNames {
+			poolOf[name] = pool.ID
+		}
+		for _, instance := range pool.Instances {
+			poolOf[instance.ID] = pool.ID
+			poolOf[instance.Hostname] = pool.ID
+		}
+	}
+
+	for i := range instances {
+		if instances[i].PoolID != "" {
+			continue
+		}
+		if poolID, ok := poolOf[instances[i].ID]; ok {
+			instances[i].PoolID = poolID
+		} else if poolID, ok := poolOf[instances[i].Hostname]; ok {
+			instances[i].PoolID = poolID
+		}
+	}
+}
+
 // FindKubernetesClusterInstance finds a Kubernetes cluster instance by either part of the ID or part of the name
 func (c *Client) FindKubernetesClusterInstance(clusterID, search string) (*Instance, error) {
 	instances, err := c.ListKubernetesClusterInstances(clusterID)
@@ -0,0 +1,117 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GitOps applications of the marketplace BootstrapCluster can install
+const (
+	BootstrapGitOpsArgoCD = "argocd"
+	BootstrapGitOpsFlux   = "flux"
+)
+
+// BootstrapOptions are the steps BootstrapCluster takes once the cluster is ready
+type BootstrapOptions struct {
+	// GitOps is a marketplace application to install, e.g. BootstrapGitOpsArgoCD, as a name or a name:plan
+	GitOps string
+	// GitOpsConfig is the configuration of the GitOps application, if any
+	GitOpsConfig map[string]string
+
+	// Manifests is the initial bundle of YAML manifests, applied after the GitOps application is installed
+	Manifests [][]byte
+	// Apply applies the manifests with the kubeconfig of the cluster. This package has no Kubernetes client,
+	// so this is where one is plugged in, e.g. client-go or kubectl; it is required when Manifests is set
+	Apply func(ctx context.Context, kubeconfig []byte, manifests [][]byte) error
+
+	// WaitOptions are used for each wait, for the cluster and for the GitOps application
+	WaitOptions []WaitOption
+}
+
+// BootstrapResult is the outcome of BootstrapCluster
+type BootstrapResult struct {
+	// Cluster is the cluster as it was after the last step, nil if it couldn't be created
+	Cluster *KubernetesCluster
+	// Kubeconfig is the kubeconfig YAML of the cluster, empty if the bootstrap failed before fetching it
+	Kubeconfig string
+}
+
+// BootstrapCluster creates a cluster, waits until it is ready, installs the GitOps application of opts and
+// waits for it, fetches the kubeconfig and applies the initial manifests, collapsing the whole workflow
+// into one call. If a step after the creation fails the cluster is kept and returned in the result,
+// along with an ClusterBootstrapFailedError saying which step, so it can be resumed or deleted
+func (c *Client) BootstrapCluster(ctx context.Context, config *KubernetesClusterConfig, opts BootstrapOptions) (*BootstrapResult, error) {
+	if len(opts.Manifests) > 0 && opts.Apply == nil {
+		return nil, KubernetesClusterConfigInvalidError.wrap(errors.New("manifests are given without an Apply function"))
+	}
+
+	client := c.WithContext(ctx)
+	created, err := client.NewKubernetesClusters(config)
+	if err != nil {
+		return nil, err
+	}
+	id := created.ID
+	result := &BootstrapResult{Cluster: created}
+
+	fail := func(step string, err error) (*BootstrapResult, error) {
+		return result, ClusterBootstrapFailedError.wrap(fmt.Errorf("%s of cluster %s: %w", step, id, err))
+	}
+
+	cluster, err := c.WaitForKubernetesClusterReady(ctx, id, opts.WaitOptions...)
+	if err != nil {
+		return fail("waiting for the readiness", err)
+	}
+	result.Cluster = cluster
+
+	if opts.GitOps != "" {
+		name, plan, _ := strings.Cut(opts.GitOps, ":")
+		if _, err := client.InstallKubernetesApplication(id, name, plan, opts.GitOpsConfig); err != nil {
+			return fail("installing "+name, err)
+		}
+		cluster, err := c.waitForKubernetesApplication(ctx, id, name, opts.WaitOptions...)
+		if err != nil {
+			return fail("waiting for "+name, err)
+		}
+		result.Cluster = cluster
+	}
+
+	if result.Kubeconfig, err = client.GetKubernetesKubeconfig(id); err != nil {
+		return fail("fetching the kubeconfig", err)
+	}
+
+	if len(opts.Manifests) > 0 {
+		if err := opts.Apply(ctx, []byte(result.Kubeconfig), opts.Manifests); err != nil {
+			return fail("applying the manifests", err)
+		}
+	}
+
+	return result, nil
+}
+
+// waitForKubernetesApplication waits until the marketplace application is installed on the cluster and returns the cluster
+func (c *Client) waitForKubernetesApplication(ctx context.Context, clusterID, name string, opts ...WaitOption) (*KubernetesCluster, error) {
+	var cluster *KubernetesCluster
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		kc, err := c.WithContext(ctx).GetKubernetesCluster(clusterID)
+		if err != nil {
+			return false, "", err
+		}
+		cluster = kc
+		for _, app := range kc.InstalledApplications {
+			if strings.EqualFold(app.Name, name) || strings.EqualFold(app.Application, name) {
+				if app.Installed {
+					return true, "installed", nil
+				}
+				return false, "installing", nil
+			}
+		}
+		return false, "pending", nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
@@ -0,0 +1,144 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func bootstrapServer(t *testing.T) *httptest.Server {
+	var polls int32
+	var installed int32
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/kubernetes/clusters":
+			rw.Write([]byte(`{"id": "69a23478", "name": "production", "status": "BUILDING"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/kubernetes/applications":
+			rw.Write([]byte(`[{"name": "argocd", "plans": []}]`))
+		case req.Method == http.MethodPut && req.URL.Path == "/v2/kubernetes/clusters/69a23478":
+			atomic.StoreInt32(&installed, 1)
+			rw.Write([]byte(`{"id": "69a23478", "name": "production", "status": "ACTIVE", "ready": true}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/kubernetes/clusters/69a23478":
+			ready := atomic.AddInt32(&polls, 1) > 1
+			apps := `[]`
+			if atomic.LoadInt32(&installed) == 1 {
+				apps = `[{"name": "argocd", "installed": true}]`
+			}
+			fmt.Fprintf(rw, `{"id": "69a23478", "name": "production", "status": "ACTIVE", "ready": %t, "kubeconfig": "apiVersion: v1", "installed_applications": %s}`, ready, apps)
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+}
+
+func TestBootstrapCluster(t *testing.T) {
+	server := bootstrapServer(t)
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	var applied [][]byte
+	var kubeconfig string
+	result, err := client.BootstrapCluster(context.Background(), &KubernetesClusterConfig{Name: "production"}, BootstrapOptions{
+		GitOps:    BootstrapGitOpsArgoCD,
+		Manifests: [][]byte{[]byte("kind: Namespace")},
+		Apply: func(ctx context.Context, config []byte, manifests [][]byte) error {
+			kubeconfig = string(config)
+			applied = manifests
+			return nil
+		},
+		WaitOptions: []WaitOption{WithWaitInterval(time.Millisecond)},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if !result.Cluster.Ready || len(result.Cluster.InstalledApplications) != 1 {
+		t.Errorf("Expected a ready cluster running argocd, got %+v", result.Cluster)
+	}
+	if result.Kubeconfig != "apiVersion: v1" || kubeconfig != result.Kubeconfig {
+		t.Errorf("Expected the kubeconfig to be fetched and used, got %q and %q", result.Kubeconfig, kubeconfig)
+	}
+	if len(applied) != 1 || string(applied[0]) != "kind: Namespace" {
+		t.Errorf("Expected the manifests to be applied, got %q", applied)
+	}
+}
+
+func TestBootstrapClusterFailedStep(t *testing.T) {
+	server := bootstrapServer(t)
+	defer server.Close()
+	client, _ := NewClientForTestingWithServer(server)
+
+	errApply := errors.New("connection refused")
+	result, err := client.BootstrapCluster(context.Background(), &KubernetesClusterConfig{Name: "production"}, BootstrapOptions{
+		Manifests:   [][]byte{[]byte("kind: Namespace")},
+		Apply:       func(context.Context, []byte, [][]byte) error { return errApply },
+		WaitOptions: []WaitOption{WithWaitInterval(time.Millisecond)},
+	})
+	if !errors.Is(err, ClusterBootstrapFailedError) || !errors.Is(err, errApply) {
+		t.Errorf("Expected an ClusterBootstrapFailedError caused by the apply, got %v", err)
+	}
+	if result == nil || result.Cluster.ID != "69a23478" {
+		t.Errorf("Expected the created cluster to be returned, got %+v", result)
+	}
+}
+
+func TestBootstrapClusterManifestsWithoutApply(t *testing.T) {
+	client, _ := NewClient("TEST-API-KEY", "TEST")
+
+	_, err := client.BootstrapCluster(context.Background(), &KubernetesClusterConfig{Name: "production"}, BootstrapOptions{
+		Manifests: [][]byte{[]byte("kind: Namespace")},
+	})
+	if !errors.Is(err, KubernetesClusterConfigInvalidError) {
+		t.Errorf("Expected a KubernetesClusterConfigInvalidError, got %v", err)
+	}
+}
+
+func TestBootstrapClusterFailedWaits(t *testing.T) {
+	tests := []struct {
+		name   string
+		ready  bool
+		gitOps string
+		step   string
+	}{
+		{"cluster never ready", false, "", "waiting for the readiness"},
+		{"gitops never installed", true, BootstrapGitOpsArgoCD, "waiting for argocd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				switch {
+				case req.Method == http.MethodPost:
+					rw.Write([]byte(`{"id": "69a23478", "name": "production", "status": "BUILDING"}`))
+				case req.URL.Path == "/v2/kubernetes/applications":
+					rw.Write([]byte(`[{"name": "argocd", "plans": []}]`))
+				default:
+					fmt.Fprintf(rw, `{"id": "69a23478", "name": "production", "status": "ACTIVE", "ready": %t}`, tt.ready)
+				}
+			}))
+			defer server.Close()
+			client, _ := NewClientForTestingWithServer(server)
+
+			result, err := client.BootstrapCluster(context.Background(), &KubernetesClusterConfig{Name: "production"}, BootstrapOptions{
+				GitOps:      tt.gitOps,
+				WaitOptions: []WaitOption{WithWaitInterval(time.Millisecond), WithWaitTimeout(20 * time.Millisecond)},
+			})
+			if !errors.Is(err, ClusterBootstrapFailedError) || !errors.Is(err, TimeoutError) {
+				t.Fatalf("Expected an ClusterBootstrapFailedError caused by a timeout, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.step+" of cluster 69a23478") {
+				t.Errorf("Expected the error to name the step and the cluster, got %v", err)
+			}
+			if result == nil || result.Cluster == nil || result.Cluster.ID != "69a23478" {
+				t.Errorf("Expected the created cluster to be returned, got %+v", result)
+			}
+		})
+	}
+}
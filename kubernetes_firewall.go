@@ -0,0 +1,135 @@
+package civogo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KubernetesAPIPort is the port the Kubernetes API of a cluster listens on
+const KubernetesAPIPort = 6443
+
+// SetKubernetesClusterFirewall moves the nodes of a cluster to another existing firewall
+func (c *Client) SetKubernetesClusterFirewall(clusterID, firewallID string) (*KubernetesCluster, error) {
+	if firewallID == "" {
+		err := fmt.Errorf("no firewall given for the kubernetes cluster %s", clusterID)
+		return nil, KubernetesClusterNoFirewallError.wrap(err)
+	}
+
+	return c.UpdateKubernetesCluster(clusterID, &KubernetesClusterConfig{FirewallID: firewallID})
+}
+
+// RestrictKubernetesAPIAccess changes the firewall of a cluster so the Kubernetes API is only reachable
+// from cidrs. Rules allowing the API port to other sources are narrowed to their other ports, or removed
+// when the API port was all they allowed, and the rule for cidrs is created before anything is removed
+func (c *Client) RestrictKubernetesAPIAccess(clusterID string, cidrs []string) (*FirewallRuleSyncResult, error) {
+	if len(cidrs) == 0 {
+		return nil, FirewallRuleInvalidError.wrap(fmt.Errorf("no CIDR given to allow access to the Kubernetes API"))
+	}
+	apiRule := allowIngressTCP(strconv.Itoa(KubernetesAPIPort), "Kubernetes API")
+	apiRule.Cidr = cloneSlice(cidrs)
+	if err := apiRule.Validate(); err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.FirewallID == "" {
+		err := fmt.Errorf("the kubernetes cluster %s has no firewall", cluster.Name)
+		return nil, KubernetesClusterNoFirewallError.wrap(err)
+	}
+
+	rules, err := c.ListFirewallRules(cluster.FirewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := []FirewallRuleConfig{}
+	for _, rule := range rules {
+		config := FirewallRuleConfig{
+			Protocol:  rule.Protocol,
+			StartPort: rule.StartPort,
+			EndPort:   rule.EndPort,
+			Cidr:      rule.Cidr,
+			Direction: rule.Direction,
+			Action:    rule.Action,
+			Label:     rule.Label,
+			Ports:     rule.Ports,
+		}
+		if opensKubernetesAPI(rule) {
+			remaining := portsWithout(rule, KubernetesAPIPort)
+			if remaining == "" {
+				continue
+			}
+			config.StartPort, config.EndPort, config.Ports = "", "", remaining
+		}
+		desired = append(desired, config)
+	}
+
+	return c.SyncFirewallRules(cluster.FirewallID, append(desired, apiRule))
+}
+
+// opensKubernetesAPI returns true if rule lets TCP traffic in to the Kubernetes API port
+func opensKubernetesAPI(rule FirewallRule) bool {
+	if !strings.EqualFold(rule.Direction, FirewallRuleDirectionIngress) ||
+		!strings.EqualFold(rule.Action, FirewallRuleActionAllow) ||
+		!strings.EqualFold(rule.Protocol, FirewallRuleProtocolTCP) {
+		return false
+	}
+
+	for _, r := range rulePortRanges(rule) {
+		if r[0] <= KubernetesAPIPort && KubernetesAPIPort <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// portsWithout returns the ports of rule as a Ports list like "80,443,8000-8100" leaving port out
+func portsWithout(rule FirewallRule, port int) string {
+	parts := []string{}
+	add := func(start, end int) {
+		switch {
+		case start > end:
+		case start == end:
+			parts = append(parts, strconv.Itoa(start))
+		default:
+			parts = append(parts, fmt.Sprintf("%d-%d", start, end))
+		}
+	}
+
+	for _, r := range rulePortRanges(rule) {
+		if r[0] <= port && port <= r[1] {
+			add(r[0], port-1)
+			add(port+1, r[1])
+		} else {
+			add(r[0], r[1])
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// rulePortRanges returns the ports of rule as inclusive ranges, from Ports when set and StartPort/EndPort otherwise
+func rulePortRanges(rule FirewallRule) [][2]int {
+	parts := []string{rule.StartPort + "-" + rule.EndPort}
+	if rule.Ports != "" {
+		parts = strings.Split(rule.Ports, ",")
+	}
+
+	ranges := [][2]int{}
+	for _, part := range parts {
+		start, end, _ := strings.Cut(strings.TrimSpace(part), "-")
+		first, err := strconv.Atoi(strings.TrimSpace(start))
+		if err != nil {
+			continue
+		}
+		last, err := strconv.Atoi(strings.TrimSpace(end))
+		if err != nil {
+			last = first
+		}
+		ranges = append(ranges, [2]int{first, last})
+	}
+	return ranges
+}
@@ -0,0 +1,122 @@
+package civogo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSetKubernetesClusterFirewall(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","firewall_id":"fw-2"}`,
+					URL:          "/v2/kubernetes/clusters/69a23478-a89e-41d2-97b1-6f4c341cee70",
+					ResponseBody: `{"id": "69a23478-a89e-41d2-97b1-6f4c341cee70", "name": "your-cluster-name", "firewall_id": "fw-2"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.SetKubernetesClusterFirewall("69a23478-a89e-41d2-97b1-6f4c341cee70", "fw-2")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.FirewallID != "fw-2" {
+		t.Errorf("Expected firewall fw-2, got %s", got.FirewallID)
+	}
+
+	if _, err := client.SetKubernetesClusterFirewall("69a23478-a89e-41d2-97b1-6f4c341cee70", ""); !errors.Is(err, KubernetesClusterNoFirewallError) {
+		t.Errorf("Expected a KubernetesClusterNoFirewallError, got %v", err)
+	}
+}
+
+func TestRestrictKubernetesAPIAccess(t *testing.T) {
+	var created []FirewallRuleConfig
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == "GET" && req.URL.Path == "/v2/kubernetes/clusters/k8s":
+			rw.Write([]byte(`{"id": "k8s", "name": "production", "firewall_id": "fw"}`))
+		case req.Method == "GET" && req.URL.Path == "/v2/firewalls/fw/rules":
+			rw.Write([]byte(`[
+				{"id":"web","firewall_id":"fw","protocol":"tcp","start_port":"80","end_port":"80","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"80"},
+				{"id":"api","firewall_id":"fw","protocol":"tcp","start_port":"6443","end_port":"6443","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"6443"},
+				{"id":"high","firewall_id":"fw","protocol":"tcp","start_port":"6000","end_port":"7000","cidr":["10.0.0.0/8"],"direction":"ingress","action":"allow"},
+				{"id":"udp","firewall_id":"fw","protocol":"udp","start_port":"6443","end_port":"6443","cidr":["0.0.0.0/0"],"direction":"ingress","action":"allow","ports":"6443"}
+			]`))
+		case req.Method == "POST" && req.URL.Path == "/v2/firewalls/fw/rules":
+			var rule FirewallRuleConfig
+			if err := json.NewDecoder(req.Body).Decode(&rule); err != nil {
+				t.Error(err)
+			}
+			created = append(created, rule)
+			rw.Write([]byte(`{"id":"new","firewall_id":"fw"}`))
+		case req.Method == "DELETE":
+			deleted = append(deleted, req.URL.Path)
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.RestrictKubernetesAPIAccess("k8s", []string{"203.0.113.0/24"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got.Unchanged) != 2 {
+		t.Errorf("Expected the web and udp rules to be unchanged, got %+v", got.Unchanged)
+	}
+
+	ports := []string{}
+	for _, rule := range created {
+		ports = append(ports, rule.Ports+rule.StartPort)
+	}
+	sort.Strings(ports)
+	if !reflect.DeepEqual(ports, []string{"6000-6442,6444-7000", "6443"}) {
+		t.Errorf("Expected the high range to be split and an API rule to be created, got %+v", created)
+	}
+	for _, rule := range created {
+		if rule.StartPort == "6443" && !reflect.DeepEqual(rule.Cidr, []string{"203.0.113.0/24"}) {
+			t.Errorf("Expected the API rule to only allow 203.0.113.0/24, got %v", rule.Cidr)
+		}
+	}
+
+	sort.Strings(deleted)
+	if !reflect.DeepEqual(deleted, []string{"/v2/firewalls/fw/rules/api", "/v2/firewalls/fw/rules/high"}) {
+		t.Errorf("Expected the api and high rules to be deleted, got %v", deleted)
+	}
+}
+
+func TestRestrictKubernetesAPIAccessInvalid(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/k8s": `{"id": "k8s", "name": "production"}`,
+	})
+	defer server.Close()
+
+	if _, err := client.RestrictKubernetesAPIAccess("k8s", nil); !errors.Is(err, FirewallRuleInvalidError) {
+		t.Errorf("Expected a FirewallRuleInvalidError without CIDRs, got %v", err)
+	}
+	if _, err := client.RestrictKubernetesAPIAccess("k8s", []string{"not-a-cidr"}); !errors.Is(err, FirewallRuleInvalidError) {
+		t.Errorf("Expected a FirewallRuleInvalidError for an invalid CIDR, got %v", err)
+	}
+	if _, err := client.RestrictKubernetesAPIAccess("k8s", []string{"203.0.113.0/24"}); !errors.Is(err, KubernetesClusterNoFirewallError) {
+		t.Errorf("Expected a KubernetesClusterNoFirewallError, got %v", err)
+	}
+}
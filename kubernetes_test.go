@@ -1,9 +1,15 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
+
+	. "github.com/onsi/gomega"
 )
 
 func TestListKubernetesClusters(t *testing.T) {
@@ -81,7 +87,7 @@ func TestListKubernetesClusters(t *testing.T) {
 				Name:              "your-cluster-name",
 				Version:           "2",
 				Status:            "ACTIVE",
-				BuiltAt:           buildAt,
+				BuiltAt:           NewTime(buildAt),
 				Ready:             true,
 				NumTargetNode:     1,
 				TargetNodeSize:    "g2.xsmall",
@@ -90,14 +96,14 @@ func TestListKubernetesClusters(t *testing.T) {
 				APIEndPoint:       "https://your.cluster.ip.address:6443",
 				MasterIP:          "your.cluster.ip.address",
 				DNSEntry:          "69a23478-a89e-41d2-97b1-6f4c341cee70.k8s.civo.com",
-				CreatedAt:         createAt,
+				CreatedAt:         NewTime(createAt),
 				Tags:              []string{},
 				FirewallID:        "42118911-44c2-4cab-ad77-bcae062815b3",
 				Instances: []KubernetesInstance{{
 					Hostname:   "kube-master-HEXDIGITS",
 					Size:       "g2.xsmall",
 					Region:     "lon1",
-					CreatedAt:  createAtInstance,
+					CreatedAt:  NewTime(createAtInstance),
 					Status:     "ACTIVE",
 					FirewallID: "5f0ba9ed-5ca7-4e14-9a09-449a84196d64",
 					PublicIP:   "your.cluster.ip.address",
@@ -122,7 +128,7 @@ func TestListKubernetesClusters(t *testing.T) {
 					Description:   "A reverse proxy/load-balancer that's easy, dynamic, automatic, fast, full-featured, open source, production proven and provides metrics.",
 					PostInstall:   "Some documentation here\n",
 					URL:           "https://traefik.io",
-					UpdatedAt:     updateAt,
+					UpdatedAt:     NewTime(updateAt),
 					Installed:     true,
 					Category:      "architecture",
 					ImageURL:      "https://api.civo.com/k3s-marketplace/traefik.png",
@@ -258,7 +264,7 @@ func TestNewKubernetesClusters(t *testing.T) {
 		Name:              "your-cluster-name",
 		Version:           "2",
 		Status:            "ACTIVE",
-		BuiltAt:           buildAt,
+		BuiltAt:           NewTime(buildAt),
 		Ready:             true,
 		NumTargetNode:     1,
 		TargetNodeSize:    "g2.xsmall",
@@ -267,14 +273,14 @@ func TestNewKubernetesClusters(t *testing.T) {
 		APIEndPoint:       "https://your.cluster.ip.address:6443",
 		MasterIP:          "your.cluster.ip.address",
 		DNSEntry:          "69a23478-a89e-41d2-97b1-6f4c341cee70.k8s.civo.com",
-		CreatedAt:         createAt,
+		CreatedAt:         NewTime(createAt),
 		Tags:              []string{},
 		FirewallID:        "42118911-44c2-4cab-ad77-bcae062815b3",
 		Instances: []KubernetesInstance{{
 			Hostname:   "kube-master-HEXDIGITS",
 			Size:       "g2.xsmall",
 			Region:     "lon1",
-			CreatedAt:  createAtInstance,
+			CreatedAt:  NewTime(createAtInstance),
 			Status:     "ACTIVE",
 			FirewallID: "5f0ba9ed-5ca7-4e14-9a09-449a84196d64",
 			PublicIP:   "your.cluster.ip.address",
@@ -287,7 +293,7 @@ func TestNewKubernetesClusters(t *testing.T) {
 			Description:   "A reverse proxy/load-balancer that's easy, dynamic, automatic, fast, full-featured, open source, production proven and provides metrics.",
 			PostInstall:   "Some documentation here\n",
 			URL:           "https://traefik.io",
-			UpdatedAt:     updateAt,
+			UpdatedAt:     NewTime(updateAt),
 			Installed:     true,
 			Category:      "architecture",
 			ImageURL:      "https://api.civo.com/k3s-marketplace/traefik.png",
@@ -368,7 +374,7 @@ func TestGetKubernetesClusters(t *testing.T) {
 		Name:              "your-cluster-name",
 		Version:           "2",
 		Status:            "ACTIVE",
-		BuiltAt:           buildAt,
+		BuiltAt:           NewTime(buildAt),
 		Ready:             true,
 		NumTargetNode:     1,
 		TargetNodeSize:    "g2.xsmall",
@@ -377,14 +383,14 @@ func TestGetKubernetesClusters(t *testing.T) {
 		APIEndPoint:       "https://your.cluster.ip.address:6443",
 		MasterIP:          "your.cluster.ip.address",
 		DNSEntry:          "69a23478-a89e-41d2-97b1-6f4c341cee70.k8s.civo.com",
-		CreatedAt:         createAt,
+		CreatedAt:         NewTime(createAt),
 		Tags:              []string{},
 		FirewallID:        "42118911-44c2-4cab-ad77-bcae062815b3",
 		Instances: []KubernetesInstance{{
 			Hostname:   "kube-master-HEXDIGITS",
 			Size:       "g2.xsmall",
 			Region:     "lon1",
-			CreatedAt:  createAtInstance,
+			CreatedAt:  NewTime(createAtInstance),
 			Status:     "ACTIVE",
 			FirewallID: "5f0ba9ed-5ca7-4e14-9a09-449a84196d64",
 			PublicIP:   "your.cluster.ip.address",
@@ -397,7 +403,7 @@ func TestGetKubernetesClusters(t *testing.T) {
 			Description:   "A reverse proxy/load-balancer that's easy, dynamic, automatic, fast, full-featured, open source, production proven and provides metrics.",
 			PostInstall:   "Some documentation here\n",
 			URL:           "https://traefik.io",
-			UpdatedAt:     updateAt,
+			UpdatedAt:     NewTime(updateAt),
 			Installed:     true,
 			Category:      "architecture",
 			ImageURL:      "https://api.civo.com/k3s-marketplace/traefik.png",
@@ -483,7 +489,7 @@ func TestUpdateKubernetesClusters(t *testing.T) {
 		Name:              "cluster-name",
 		Version:           "2",
 		Status:            "ACTIVE",
-		BuiltAt:           buildAt,
+		BuiltAt:           NewTime(buildAt),
 		Ready:             true,
 		NumTargetNode:     6,
 		TargetNodeSize:    "g2.xsmall",
@@ -492,14 +498,14 @@ func TestUpdateKubernetesClusters(t *testing.T) {
 		APIEndPoint:       "https://your.cluster.ip.address:6443",
 		MasterIP:          "your.cluster.ip.address",
 		DNSEntry:          "69a23478-a89e-41d2-97b1-6f4c341cee70.k8s.civo.com",
-		CreatedAt:         createAt,
+		CreatedAt:         NewTime(createAt),
 		Tags:              []string{},
 		FirewallID:        "42118911-44c2-4cab-ad77-bcae062815b3",
 		Instances: []KubernetesInstance{{
 			Hostname:   "kube-master-HEXDIGITS",
 			Size:       "g2.xsmall",
 			Region:     "lon1",
-			CreatedAt:  createAtInstance,
+			CreatedAt:  NewTime(createAtInstance),
 			Status:     "ACTIVE",
 			FirewallID: "5f0ba9ed-5ca7-4e14-9a09-449a84196d64",
 			PublicIP:   "your.cluster.ip.address",
@@ -512,7 +518,7 @@ func TestUpdateKubernetesClusters(t *testing.T) {
 			Description:   "A reverse proxy/load-balancer that's easy, dynamic, automatic, fast, full-featured, open source, production proven and provides metrics.",
 			PostInstall:   "Some documentation here\n",
 			URL:           "https://traefik.io",
-			UpdatedAt:     updateAt,
+			UpdatedAt:     NewTime(updateAt),
 			Installed:     true,
 			Category:      "architecture",
 			ImageURL:      "https://api.civo.com/k3s-marketplace/traefik.png",
@@ -654,3 +660,184 @@ func TestListAvailableKubernetesVersions(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestInstallKubernetesApplication(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/kubernetes/applications",
+					ResponseBody: `[{"name": "Longhorn", "plans": []}, {"name": "MariaDB", "plans": [{"label": "5GB"}, {"label": "10GB"}]}]`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","applications":"MariaDB:10GB","application_configuration":{"MariaDB":{"root_password":"secret"}}}`,
+					URL:          "/v2/kubernetes/clusters/69a23478",
+					ResponseBody: `{"id": "69a23478", "installed_applications": [{"application": "MariaDB", "plan": "10GB"}]}`,
+				},
+				{
+					RequestBody:  `{"region":"TEST","uninstall_applications":"MariaDB"}`,
+					URL:          "/v2/kubernetes/clusters/69a23478",
+					ResponseBody: `{"id": "69a23478", "installed_applications": []}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.InstallKubernetesApplication("69a23478", "mariadb", "10gb", map[string]string{"root_password": "secret"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.InstalledApplications) != 1 || got.InstalledApplications[0].Plan != "10GB" {
+		t.Errorf("Expected MariaDB to be installed, got %+v", got.InstalledApplications)
+	}
+
+	if _, err := client.InstallKubernetesApplication("69a23478", "MariaDB", "1TB", nil); !errors.Is(err, DatabaseKubernetesApplicationInvalidPlanError) {
+		t.Errorf("Expected DatabaseKubernetesApplicationInvalidPlanError, got %v", err)
+	}
+
+	if _, err := client.InstallKubernetesApplication("69a23478", "Missing", "", nil); !errors.Is(err, DatabaseKubernetesApplicationNotFoundError) {
+		t.Errorf("Expected DatabaseKubernetesApplicationNotFoundError, got %v", err)
+	}
+
+	got, err = client.RemoveKubernetesApplication("69a23478", "MariaDB")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.InstalledApplications) != 0 {
+		t.Errorf("Expected no applications, got %+v", got.InstalledApplications)
+	}
+}
+
+func TestUpgradeKubernetesCluster(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","kubernetes_version":"1.27.1-k3s1"}`,
+					URL:          "/v2/kubernetes/clusters/69a23478",
+					ResponseBody: `{"id": "69a23478", "status": "UPGRADING"}`,
+				},
+			},
+		},
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/kubernetes/clusters/69a23478",
+					ResponseBody: `{"id": "69a23478", "name": "your-cluster-name", "version": "1.26.4-k3s1", "cluster_type": "k3s"}`,
+				},
+				{
+					URL: "/v2/kubernetes/versions",
+					ResponseBody: `[
+						{"version": "1.25.9-k3s1", "type": "stable", "clusterType": "k3s"},
+						{"version": "1.27.1-k3s1", "type": "stable", "default": true, "clusterType": "k3s"},
+						{"version": "1.27.1-talos", "type": "development", "clusterType": "talos"}
+					]`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.UpgradeKubernetesCluster("69a23478", "1.27.1-k3s1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.Status != "UPGRADING" {
+		t.Errorf("Expected %s, got %s", "UPGRADING", got.Status)
+	}
+
+	for _, version := range []string{"1.25.9-k3s1", "1.27.1-talos", "9.9.9"} {
+		if _, err := client.UpgradeKubernetesCluster("69a23478", version); !errors.Is(err, KubernetesUpgradeInvalidError) {
+			t.Errorf("Expected KubernetesUpgradeInvalidError for %s, got %v", version, err)
+		}
+	}
+}
+
+func TestNewKubernetesClustersFullConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&sent)
+		rw.Write([]byte(`{"id": "69a23478-a89e-41d2-97b1-6f4c341cee70", "name": "full", "cni_plugin": "cilium", "network_id": "net-1", "firewall_id": "fw-1"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	cfg := &KubernetesClusterConfig{
+		Name:             "full",
+		NetworkID:        "net-1",
+		InstanceFirewall: "fw-1",
+		CNIPlugin:        KubernetesCNIPluginCilium,
+		Pools: []KubernetesClusterPoolConfig{
+			{ID: "workers", Count: 3, Size: "g4s.kube.medium", MinCount: 1, MaxCount: 5},
+			{ID: "gpu", Count: 1, Size: "g4g.kube.small", Annotations: map[string]string{"gpu": "true"}},
+		},
+	}
+	cfg.AddApplications("traefik2-nodeport", "metrics-server")
+	cfg.AddApplications("civo-cluster-autoscaler:default")
+
+	cluster, err := client.NewKubernetesClusters(cfg)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.CNIPlugin).To(Equal("cilium"))
+
+	g.Expect(sent["cni_plugin"]).To(Equal("cilium"))
+	g.Expect(sent["network_id"]).To(Equal("net-1"))
+	g.Expect(sent["instance_firewall"]).To(Equal("fw-1"))
+	g.Expect(sent["applications"]).To(Equal("traefik2-nodeport,metrics-server,civo-cluster-autoscaler:default"))
+	g.Expect(sent["pools"]).To(HaveLen(2))
+	g.Expect(cfg.ApplicationList()).To(Equal([]string{"traefik2-nodeport", "metrics-server", "civo-cluster-autoscaler:default"}))
+}
+
+func TestKubernetesClusterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config KubernetesClusterConfig
+		valid  bool
+	}{
+		{"minimal", KubernetesClusterConfig{Name: "c"}, true},
+		{"talos with cilium", KubernetesClusterConfig{Name: "c", ClusterType: "talos", CNIPlugin: "cilium"}, true},
+		{"no name", KubernetesClusterConfig{}, false},
+		{"unknown cni", KubernetesClusterConfig{Name: "c", CNIPlugin: "calico"}, false},
+		{"unknown cluster type", KubernetesClusterConfig{Name: "c", ClusterType: "k8s"}, false},
+		{"pool without size", KubernetesClusterConfig{Name: "c", Pools: []KubernetesClusterPoolConfig{{Count: 1}}}, false},
+		{"pool above max", KubernetesClusterConfig{Name: "c", Pools: []KubernetesClusterPoolConfig{{Size: "s", Count: 6, MaxCount: 5}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, KubernetesClusterConfigInvalidError) {
+				t.Errorf("Expected KubernetesClusterConfigInvalidError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestKubernetesClusterConfigValidateKeepsPoolErrors(t *testing.T) {
+	config := KubernetesClusterConfig{Name: "c", Pools: []KubernetesClusterPoolConfig{{Size: "s", Count: 6, MaxCount: 5}}}
+
+	err := config.Validate()
+	if !errors.Is(err, KubernetesClusterConfigInvalidError) || !errors.Is(err, KubernetesPoolInvalidError) {
+		t.Errorf("Expected both KubernetesClusterConfigInvalidError and KubernetesPoolInvalidError, got %v", err)
+	}
+	if err == nil || err.Error() != "KubernetesClusterConfigInvalid: pool 0: KubernetesPoolInvalid: count 6 is above the max count 5" {
+		t.Errorf("Unexpected message %v", err)
+	}
+}
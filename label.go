@@ -0,0 +1,96 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// LabelledResource is a kind of resource which can carry labels
+type LabelledResource string
+
+// The resources supporting labels
+const (
+	LabelledInstance          LabelledResource = "instances"
+	LabelledVolume            LabelledResource = "volumes"
+	LabelledKubernetesCluster LabelledResource = "kubernetes/clusters"
+)
+
+// labelsResponse is the part of a resource MergeLabels needs
+type labelsResponse struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// MergeLabels applies changes on top of the current labels of a resource and returns the result.
+// A label set to an empty value in changes is removed
+func (c *Client) MergeLabels(resource LabelledResource, id string, changes map[string]string) (map[string]string, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/%s/%s", resource, id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	current := labelsResponse{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&current); err != nil {
+		return nil, err
+	}
+
+	return c.ReplaceLabels(resource, id, mergeLabels(current.Labels, changes))
+}
+
+// ReplaceLabels sets the labels of a resource to labels, removing any other label it had
+func (c *Client) ReplaceLabels(resource LabelledResource, id string, labels map[string]string) (map[string]string, error) {
+	if err := validateLabels(labels); err != nil {
+		return nil, err
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/%s/%s/labels", resource, id), map[string]interface{}{
+		"labels": labels,
+		"region": c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	updated := labelsResponse{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&updated); err != nil {
+		return nil, err
+	}
+	if updated.Labels == nil {
+		updated.Labels = labels
+	}
+
+	return updated.Labels, nil
+}
+
+// mergeLabels returns a copy of current with changes applied, dropping the labels changed to an empty value
+func mergeLabels(current, changes map[string]string) map[string]string {
+	merged := make(map[string]string, len(current)+len(changes))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range changes {
+		if value == "" {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// validateLabels checks the keys of labels are single non-empty words
+func validateLabels(labels map[string]string) error {
+	for key := range labels {
+		if key == "" || strings.ContainsAny(key, " \t\n") {
+			err := fmt.Errorf("label key %q must be a single non-empty word", key)
+			return LabelInvalidError.wrap(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package civogo
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMergeLabels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/instances/12345",
+					ResponseBody: `{"id": "12345", "labels": {"env": "staging", "team": "ops", "old": "yes"}}`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"labels":{"app":"web","env":"prod","team":"ops"},"region":"TEST"}`,
+					URL:          "/v2/instances/12345/labels",
+					ResponseBody: `{"id": "12345", "labels": {"app": "web", "env": "prod", "team": "ops"}}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.MergeLabels(LabelledInstance, "12345", map[string]string{"env": "prod", "app": "web", "old": ""})
+	g.Expect(err).To(BeNil())
+	g.Expect(got).To(Equal(map[string]string{"app": "web", "env": "prod", "team": "ops"}))
+}
+
+func TestReplaceLabels(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"labels":{"tier":"db"},"region":"TEST"}`,
+					URL:          "/v2/volumes/12345/labels",
+					ResponseBody: `{"id": "12345", "labels": {"tier": "db"}}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.ReplaceLabels(LabelledVolume, "12345", map[string]string{"tier": "db"})
+	g.Expect(err).To(BeNil())
+	g.Expect(got).To(Equal(map[string]string{"tier": "db"}))
+
+	_, err = client.ReplaceLabels(LabelledVolume, "12345", map[string]string{"two words": "db"})
+	g.Expect(err).To(MatchError(LabelInvalidError))
+}
@@ -0,0 +1,74 @@
+package civogo
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListOptions pages and narrows down the results of the List methods returning a page, e.g.
+//
+//	clusters, err := client.ListKubernetesClusters(civogo.ListOptions{PerPage: 50, Tag: "production"})
+//	for err == nil && clusters.NextPage() != 0 {
+//		clusters, err = client.ListKubernetesClusters(civogo.ListOptions{Page: clusters.NextPage(), PerPage: 50, Tag: "production"})
+//	}
+//
+// Without options these methods return the first page the API picks, fields left empty are not sent
+type ListOptions struct {
+	Page    int
+	PerPage int
+	// Region lists another region than the one of the client
+	Region string
+	// Search only returns the resources whose name contains it
+	Search string
+	// Tag only returns the resources with the tag
+	Tag string
+}
+
+// query returns the query parameters of the options
+func (o ListOptions) query() url.Values {
+	q := url.Values{}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.Search != "" {
+		q.Set("search", o.Search)
+	}
+	if o.Tag != "" {
+		q.Set("tags", o.Tag)
+	}
+	return q
+}
+
+// sendListRequest gets path with the last of opts, if any, applied
+func (c *Client) sendListRequest(path string, opts []ListOptions) ([]byte, error) {
+	if len(opts) == 0 {
+		return c.SendGetRequest(path)
+	}
+
+	o := opts[len(opts)-1]
+	client := c
+	if o.Region != "" {
+		client = c.WithRegion(o.Region)
+	}
+	if q := o.query().Encode(); q != "" {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		path += separator + q
+	}
+
+	return client.SendGetRequest(path)
+}
+
+// nextPage returns the page after page, or 0 when page is the last of pages
+func nextPage(page, pages int) int {
+	if page < 1 || page >= pages {
+		return 0
+	}
+	return page + 1
+}
@@ -0,0 +1,65 @@
+package civogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestListOptions(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query()
+		rw.Write([]byte(`{"page": 2, "per_page": 10, "pages": 3, "items": [{"id": "69a23478", "name": "production"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	clusters, err := client.ListKubernetesClusters(ListOptions{Page: 2, PerPage: 10, Region: "LON1", Search: "prod", Tag: "web"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := url.Values{
+		"page":     {"2"},
+		"per_page": {"10"},
+		"region":   {"LON1"},
+		"search":   {"prod"},
+		"tags":     {"web"},
+	}
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("Expected query %v, got %v", expected, query)
+	}
+	if clusters.NextPage() != 3 {
+		t.Errorf("Expected the next page to be 3, got %d", clusters.NextPage())
+	}
+
+	if _, err := client.ListIPs(); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+	}
+	if !reflect.DeepEqual(query, url.Values{"region": {"TEST"}}) {
+		t.Errorf("Expected only the region to be sent without options, got %v", query)
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	tests := []struct {
+		page, pages, expected int
+	}{
+		{1, 3, 2},
+		{3, 3, 0},
+		{1, 1, 0},
+		{0, 0, 0},
+	}
+
+	for _, test := range tests {
+		page := &Page[Instance]{Page: test.page, Pages: test.pages}
+		if got := page.NextPage(); got != test.expected {
+			t.Errorf("Expected page %d of %d to be followed by %d, got %d", test.page, test.pages, test.expected, got)
+		}
+	}
+}
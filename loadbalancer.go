@@ -14,38 +14,88 @@ type LoadBalancerBackend struct {
 	SourcePort      int32  `json:"source_port"`
 	TargetPort      int32  `json:"target_port"`
 	HealthCheckPort int32  `json:"health_check_port,omitempty"`
+	// InstanceID is the instance behind IP, when the backend was added by instance
+	InstanceID          string `json:"instance_id,omitempty"`
+	HealthCheckPath     string `json:"health_check_path,omitempty"`
+	HealthCheckInterval int32  `json:"health_check_interval,omitempty"`
 }
 
-// LoadBalancerBackendConfig is the configuration for creating backends
+// LoadBalancerBackendConfig is the configuration for creating backends, when only InstanceID
+// is set AddLoadBalancerBackend fills IP with the private IP of the instance
 type LoadBalancerBackendConfig struct {
 	IP              string `json:"ip"`
 	Protocol        string `json:"protocol,omitempty"`
 	SourcePort      int32  `json:"source_port"`
 	TargetPort      int32  `json:"target_port"`
 	HealthCheckPort int32  `json:"health_check_port,omitempty"`
+	// InstanceID is the instance behind IP, when the backend was added by instance
+	InstanceID          string `json:"instance_id,omitempty"`
+	HealthCheckPath     string `json:"health_check_path,omitempty"`
+	HealthCheckInterval int32  `json:"health_check_interval,omitempty"`
+}
+
+// Health statuses reported for a load balancer backend
+const (
+	LoadBalancerBackendHealthy   = "healthy"
+	LoadBalancerBackendUnhealthy = "unhealthy"
+	LoadBalancerBackendUnknown   = "unknown"
+)
+
+// LoadBalancerBackendHealth is the result of the last health check of a load balancer backend
+type LoadBalancerBackendHealth struct {
+	IP            string `json:"ip"`
+	InstanceID    string `json:"instance_id,omitempty"`
+	TargetPort    int32  `json:"target_port"`
+	Status        string `json:"status"`
+	LastCheckedAt Time   `json:"last_checked_at"`
+	// FailureReason explains why the last health check failed, it's empty for healthy backends
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+// Healthy returns true if the last health check of the backend succeeded
+func (h *LoadBalancerBackendHealth) Healthy() bool {
+	return strings.EqualFold(h.Status, LoadBalancerBackendHealthy)
 }
 
 // LoadBalancer represents a load balancer configuration within Civo
 type LoadBalancer struct {
-	ID                           string                `json:"id"`
-	Name                         string                `json:"name"`
-	ServiceName                  string                `json:"service_name,omitempty"`
-	Algorithm                    string                `json:"algorithm"`
-	Backends                     []LoadBalancerBackend `json:"backends"`
-	ExternalTrafficPolicy        string                `json:"external_traffic_policy,omitempty"`
-	SessionAffinity              string                `json:"session_affinity,omitempty"`
-	SessionAffinityConfigTimeout int32                 `json:"session_affinity_config_timeout,omitempty"`
-	EnableProxyProtocol          string                `json:"enable_proxy_protocol,omitempty"`
-	PublicIP                     string                `json:"public_ip"`
-	PrivateIP                    string                `json:"private_ip"`
-	FirewallID                   string                `json:"firewall_id"`
-	ClusterID                    string                `json:"cluster_id,omitempty"`
-	State                        string                `json:"state"`
-	ReservedIPID                 string                `json:"reserved_ip_id,omitempty"`
-	ReservedIPName               string                `json:"reserved_ip_name,omitempty"`
-	ReservedIP                   string                `json:"reserved_ip,omitempty"`
-	MaxConcurrentRequests        int                   `json:"max_concurrent_requests,omitempty"`
-	Options                      *LoadBalancerOptions  `json:"options,omitempty"`
+	ID                           string                 `json:"id"`
+	Name                         string                 `json:"name"`
+	ServiceName                  string                 `json:"service_name,omitempty"`
+	Algorithm                    string                 `json:"algorithm"`
+	Backends                     []LoadBalancerBackend  `json:"backends"`
+	ExternalTrafficPolicy        string                 `json:"external_traffic_policy,omitempty"`
+	SessionAffinity              string                 `json:"session_affinity,omitempty"`
+	SessionAffinityConfigTimeout int32                  `json:"session_affinity_config_timeout,omitempty"`
+	EnableProxyProtocol          string                 `json:"enable_proxy_protocol,omitempty"`
+	PublicIP                     string                 `json:"public_ip"`
+	PrivateIP                    string                 `json:"private_ip"`
+	FirewallID                   string                 `json:"firewall_id"`
+	NetworkID                    string                 `json:"network_id,omitempty"`
+	ClusterID                    string                 `json:"cluster_id,omitempty"`
+	State                        string                 `json:"state"`
+	ReservedIPID                 string                 `json:"reserved_ip_id,omitempty"`
+	ReservedIPName               string                 `json:"reserved_ip_name,omitempty"`
+	ReservedIP                   string                 `json:"reserved_ip,omitempty"`
+	MaxConcurrentRequests        int                    `json:"max_concurrent_requests,omitempty"`
+	Options                      *LoadBalancerOptions   `json:"options,omitempty"`
+	SNIRoutes                    []LoadBalancerSNIRoute `json:"sni_routes,omitempty"`
+}
+
+// Clone returns a deep copy of the load balancer, sharing none of its slices and maps
+func (l *LoadBalancer) Clone() *LoadBalancer {
+	if l == nil {
+		return nil
+	}
+
+	c := *l
+	c.Backends = cloneSlice(l.Backends)
+	c.SNIRoutes = cloneEach(l.SNIRoutes, (*LoadBalancerSNIRoute).Clone)
+	if l.Options != nil {
+		options := *l.Options
+		c.Options = &options
+	}
+	return &c
 }
 
 // LoadBalancerConfig represents a load balancer to be created
@@ -65,6 +115,7 @@ type LoadBalancerConfig struct {
 	FirewallRules                string                      `json:"firewall_rule,omitempty"`
 	MaxConcurrentRequests        *int                        `json:"max_concurrent_requests,omitempty"`
 	LoadBalancerOptions          *LoadBalancerOptions        `json:"options,omitempty"`
+	SNIRoutes                    []LoadBalancerSNIRoute      `json:"sni_routes,omitempty"`
 }
 
 // LoadBalancerOptions are additional loadbalancer options
@@ -87,6 +138,8 @@ type LoadBalancerUpdateConfig struct {
 	FirewallID                   string                      `json:"firewall_id,omitempty"`
 	MaxConcurrentRequests        *int                        `json:"max_concurrent_requests,omitempty"`
 	LoadBalancerOptions          *LoadBalancerOptions        `json:"options,omitempty"`
+	// SNIRoutes replace the routes of the load balancer when set
+	SNIRoutes []LoadBalancerSNIRoute `json:"sni_routes,omitempty"`
 }
 
 // ListLoadBalancers returns all load balancers owned by the calling API account
@@ -155,6 +208,10 @@ func (c *Client) FindLoadBalancer(search string) (*LoadBalancer, error) {
 
 // CreateLoadBalancer creates a new load balancer
 func (c *Client) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error) {
+	if err := validateSNIRoutes(r.SNIRoutes); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPostRequest("/v2/loadbalancers", r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -170,6 +227,10 @@ func (c *Client) CreateLoadBalancer(r *LoadBalancerConfig) (*LoadBalancer, error
 
 // UpdateLoadBalancer updates a load balancer
 func (c *Client) UpdateLoadBalancer(id string, r *LoadBalancerUpdateConfig) (*LoadBalancer, error) {
+	if err := validateSNIRoutes(r.SNIRoutes); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/loadbalancers/%s", id), r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -192,3 +253,90 @@ func (c *Client) DeleteLoadBalancer(id string) (*SimpleResponse, error) {
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// AddLoadBalancerBackend adds a backend to a load balancer, keeping the ones it already has
+func (c *Client) AddLoadBalancerBackend(id string, backend *LoadBalancerBackendConfig) (*LoadBalancer, error) {
+	if backend.IP == "" && backend.InstanceID != "" {
+		instance, err := c.GetInstance(backend.InstanceID)
+		if err != nil {
+			return nil, err
+		}
+		backend.IP = instance.PrivateIP
+	}
+	if backend.IP == "" {
+		err := fmt.Errorf("the backend needs an IP or an instance ID")
+		return nil, LoadBalancerBackendInvalidError.wrap(err)
+	}
+
+	lb, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	backends := lb.backendConfigs()
+	for _, b := range backends {
+		if b.IP == backend.IP && b.SourcePort == backend.SourcePort && b.TargetPort == backend.TargetPort {
+			err := fmt.Errorf("the backend %s:%d is already in the load balancer %s", backend.IP, backend.TargetPort, lb.Name)
+			return nil, LoadBalancerBackendInvalidError.wrap(err)
+		}
+	}
+
+	return c.UpdateLoadBalancer(id, &LoadBalancerUpdateConfig{
+		Region:   c.Region,
+		Backends: append(backends, *backend),
+	})
+}
+
+// RemoveLoadBalancerBackend removes the backends with the given IP or instance ID from a load balancer.
+// The last backend cannot be removed, delete the load balancer instead
+func (c *Client) RemoveLoadBalancerBackend(id, ipOrInstanceID string) (*LoadBalancer, error) {
+	lb, err := c.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+
+	backends := []LoadBalancerBackendConfig{}
+	for _, b := range lb.backendConfigs() {
+		if b.IP != ipOrInstanceID && b.InstanceID != ipOrInstanceID {
+			backends = append(backends, b)
+		}
+	}
+
+	switch {
+	case len(backends) == len(lb.Backends):
+		err := fmt.Errorf("unable to find the backend %s in the load balancer %s", ipOrInstanceID, lb.Name)
+		return nil, LoadBalancerBackendNotFoundError.wrap(err)
+	case len(backends) == 0:
+		err := fmt.Errorf("the load balancer %s would have no backends left", lb.Name)
+		return nil, LoadBalancerBackendInvalidError.wrap(err)
+	}
+
+	return c.UpdateLoadBalancer(id, &LoadBalancerUpdateConfig{
+		Region:   c.Region,
+		Backends: backends,
+	})
+}
+
+// GetLoadBalancerBackendHealth returns the result of the last health check of every backend of a load balancer
+func (c *Client) GetLoadBalancerBackendHealth(lbID string) ([]LoadBalancerBackendHealth, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/loadbalancers/%s/backend_health", lbID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	health := make([]LoadBalancerBackendHealth, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&health); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return health, nil
+}
+
+// backendConfigs returns the backends of the load balancer as configs to send back in an update
+func (lb *LoadBalancer) backendConfigs() []LoadBalancerBackendConfig {
+	backends := make([]LoadBalancerBackendConfig, len(lb.Backends))
+	for i, b := range lb.Backends {
+		backends[i] = LoadBalancerBackendConfig(b)
+	}
+	return backends
+}
@@ -0,0 +1,179 @@
+package civogo
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LoadBalancerCertificate is a TLS certificate a load balancer terminates HTTPS with, its private key
+// is never returned by the API
+type LoadBalancerCertificate struct {
+	ID             string   `json:"id"`
+	LoadBalancerID string   `json:"loadbalancer_id,omitempty"`
+	Name           string   `json:"name"`
+	Domains        []string `json:"domains,omitempty"`
+	Fingerprint    string   `json:"fingerprint,omitempty"`
+	NotBefore      Time     `json:"not_before,omitempty"`
+	NotAfter       Time     `json:"not_after,omitempty"`
+	CreatedAt      Time     `json:"created_at,omitempty"`
+}
+
+// Clone returns a deep copy of the certificate, sharing none of its slices
+func (cert *LoadBalancerCertificate) Clone() *LoadBalancerCertificate {
+	if cert == nil {
+		return nil
+	}
+
+	c := *cert
+	c.Domains = cloneSlice(cert.Domains)
+	return &c
+}
+
+// LoadBalancerCertificateConfig is a PEM encoded certificate, followed by its chain if any, and its
+// private key to upload to a load balancer
+type LoadBalancerCertificateConfig struct {
+	Region      string `json:"region"`
+	Name        string `json:"name"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// Validate checks the certificate and key parse, match each other and the certificate hasn't expired
+func (cfg *LoadBalancerCertificateConfig) Validate() error {
+	_, err := cfg.parse()
+	return err
+}
+
+// parse validates the config like Validate and returns the leaf certificate
+func (cfg *LoadBalancerCertificateConfig) parse() (*x509.Certificate, error) {
+	if cfg.Name == "" {
+		return nil, LoadBalancerCertificateInvalidError.wrap(fmt.Errorf("the name is empty"))
+	}
+
+	pair, err := tls.X509KeyPair([]byte(cfg.Certificate), []byte(cfg.PrivateKey))
+	if err != nil {
+		return nil, LoadBalancerCertificateInvalidError.wrap(err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, LoadBalancerCertificateInvalidError.wrap(err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		err := fmt.Errorf("the certificate expired on %s", leaf.NotAfter.Format(time.RFC3339))
+		return nil, LoadBalancerCertificateInvalidError.wrap(err)
+	}
+
+	return leaf, nil
+}
+
+// LoadBalancerSNIRoute sends the HTTPS traffic for Hostname, which can be a *.example.com wildcard, to
+// the backends with the given IPs, or to all of them when none is given, using the certificate CertificateID
+type LoadBalancerSNIRoute struct {
+	Hostname      string   `json:"hostname"`
+	CertificateID string   `json:"certificate_id"`
+	BackendIPs    []string `json:"backend_ips,omitempty"`
+	TargetPort    int32    `json:"target_port,omitempty"`
+}
+
+// Clone returns a deep copy of the route, sharing none of its slices
+func (r *LoadBalancerSNIRoute) Clone() *LoadBalancerSNIRoute {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	c.BackendIPs = cloneSlice(r.BackendIPs)
+	return &c
+}
+
+// validateSNIRoutes checks every route has a hostname and a certificate, and no hostname is routed twice
+func validateSNIRoutes(routes []LoadBalancerSNIRoute) error {
+	seen := map[string]bool{}
+	for _, route := range routes {
+		hostname := strings.ToLower(route.Hostname)
+		switch {
+		case hostname == "":
+			return LoadBalancerSNIRouteInvalidError.wrap(fmt.Errorf("a route has no hostname"))
+		case strings.Contains(strings.TrimPrefix(hostname, "*."), "*"):
+			return LoadBalancerSNIRouteInvalidError.wrap(fmt.Errorf("%s: only a leading *. wildcard is supported", route.Hostname))
+		case route.CertificateID == "":
+			return LoadBalancerSNIRouteInvalidError.wrap(fmt.Errorf("%s: the route has no certificate", route.Hostname))
+		case seen[hostname]:
+			return LoadBalancerSNIRouteInvalidError.wrap(fmt.Errorf("%s is routed more than once", route.Hostname))
+		}
+		seen[hostname] = true
+	}
+
+	return nil
+}
+
+// UploadLoadBalancerCertificate uploads a certificate and its key to a load balancer, once validated
+func (c *Client) UploadLoadBalancerCertificate(lbID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	config.Region = c.Region
+	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/loadbalancers/%s/certificates", lbID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	certificate := &LoadBalancerCertificate{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(certificate); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return certificate, nil
+}
+
+// ListLoadBalancerCertificates returns the certificates of a load balancer
+func (c *Client) ListLoadBalancerCertificates(lbID string) ([]LoadBalancerCertificate, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/loadbalancers/%s/certificates", lbID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	certificates := make([]LoadBalancerCertificate, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&certificates); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return certificates, nil
+}
+
+// RotateLoadBalancerCertificate replaces the certificate and key of a certificate of a load balancer,
+// keeping its ID so the SNI routes using it carry on without being updated
+func (c *Client) RotateLoadBalancerCertificate(lbID, certificateID string, config *LoadBalancerCertificateConfig) (*LoadBalancerCertificate, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	config.Region = c.Region
+	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/loadbalancers/%s/certificates/%s", lbID, certificateID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	certificate := &LoadBalancerCertificate{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(certificate); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return certificate, nil
+}
+
+// DeleteLoadBalancerCertificate deletes a certificate of a load balancer, which no SNI route may use
+func (c *Client) DeleteLoadBalancerCertificate(lbID, certificateID string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/loadbalancers/%s/certificates/%s", lbID, certificateID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
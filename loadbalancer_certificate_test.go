@@ -0,0 +1,108 @@
+package civogo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// testCertificate returns a self-signed PEM certificate for domains valid until notAfter, and its key
+func testCertificate(t *testing.T, notAfter time.Time, domains ...string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		DNSNames:     domains,
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestLoadBalancerCertificateConfigValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour), "example.com", "www.example.com")
+	config := &LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key}
+	g.Expect(config.Validate()).To(Succeed())
+	leaf, err := config.parse()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(leaf.DNSNames).To(Equal([]string{"example.com", "www.example.com"}))
+
+	_, otherKey := testCertificate(t, time.Now().Add(24*time.Hour), "example.com")
+	err = (&LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: otherKey}).Validate()
+	g.Expect(errors.Is(err, LoadBalancerCertificateInvalidError)).To(BeTrue())
+
+	expired, expiredKey := testCertificate(t, time.Now().Add(-time.Hour), "example.com")
+	err = (&LoadBalancerCertificateConfig{Name: "example", Certificate: expired, PrivateKey: expiredKey}).Validate()
+	g.Expect(errors.Is(err, LoadBalancerCertificateInvalidError)).To(BeTrue())
+}
+
+func TestUploadLoadBalancerCertificate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour), "example.com")
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers/lb-1/certificates": `{"id": "cert-1", "name": "example", "domains": ["example.com"], "not_after": "2030-01-01T00:00:00Z"}`,
+	})
+	defer server.Close()
+
+	got, err := client.UploadLoadBalancerCertificate("lb-1", &LoadBalancerCertificateConfig{Name: "example", Certificate: cert, PrivateKey: key})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.ID).To(Equal("cert-1"))
+	g.Expect(got.Domains).To(Equal([]string{"example.com"}))
+	g.Expect(got.NotAfter.Year()).To(Equal(2030))
+
+	_, err = client.UploadLoadBalancerCertificate("lb-1", &LoadBalancerCertificateConfig{Name: "example", Certificate: "junk", PrivateKey: key})
+	g.Expect(errors.Is(err, LoadBalancerCertificateInvalidError)).To(BeTrue())
+}
+
+func TestLoadBalancerSNIRoutes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers": `{"id": "lb-1", "name": "web", "sni_routes": [{"hostname": "*.example.com", "certificate_id": "cert-1"}]}`,
+	})
+	defer server.Close()
+
+	lb, err := client.CreateLoadBalancer(&LoadBalancerConfig{
+		Name:      "web",
+		SNIRoutes: []LoadBalancerSNIRoute{{Hostname: "*.example.com", CertificateID: "cert-1"}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(lb.SNIRoutes).To(Equal([]LoadBalancerSNIRoute{{Hostname: "*.example.com", CertificateID: "cert-1"}}))
+
+	for _, routes := range [][]LoadBalancerSNIRoute{
+		{{CertificateID: "cert-1"}},
+		{{Hostname: "example.com"}},
+		{{Hostname: "a.*.example.com", CertificateID: "cert-1"}},
+		{{Hostname: "example.com", CertificateID: "cert-1"}, {Hostname: "EXAMPLE.com", CertificateID: "cert-2"}},
+	} {
+		_, err := client.UpdateLoadBalancer("lb-1", &LoadBalancerUpdateConfig{SNIRoutes: routes})
+		g.Expect(errors.Is(err, LoadBalancerSNIRouteInvalidError)).To(BeTrue())
+	}
+}
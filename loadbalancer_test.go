@@ -1,8 +1,13 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListLoadBalancers(t *testing.T) {
@@ -162,6 +167,7 @@ func TestCreateLoadBalancer(t *testing.T) {
 			},
 		},
 		FirewallID: "9717fb32-dc0b-49e9-8265-5c84863e2164",
+		NetworkID:  "b064d568-5869-427c-827a-77d48cde6a2e",
 	}
 
 	if !reflect.DeepEqual(got, expected) {
@@ -227,6 +233,7 @@ func TestUpdateLoadBalancer(t *testing.T) {
 			},
 		},
 		FirewallID: "9717fb32-dc0b-49e9-8265-5c84863e2164",
+		NetworkID:  "b064d568-5869-427c-827a-77d48cde6a2e",
 	}
 
 	if !reflect.DeepEqual(got, expected) {
@@ -289,6 +296,7 @@ func TestGetLoadBalancer(t *testing.T) {
 			},
 		},
 		FirewallID: "9717fb32-dc0b-49e9-8265-5c84863e2164",
+		NetworkID:  "b064d568-5869-427c-827a-77d48cde6a2e",
 	}
 
 	if !reflect.DeepEqual(got, expected) {
@@ -312,3 +320,103 @@ func TestDeleteLoadBalancer(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestAddLoadBalancerBackend(t *testing.T) {
+	var sent LoadBalancerUpdateConfig
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/v2/instances/inst-2":
+			rw.Write([]byte(`{"id": "inst-2", "private_ip": "192.168.1.4"}`))
+		case req.Method == "GET" && req.URL.Path == "/v2/loadbalancers/lb":
+			rw.Write([]byte(`{"id": "lb", "name": "web", "backends": [{"ip": "192.168.1.3", "protocol": "TCP", "source_port": 80, "target_port": 8080}]}`))
+		case req.Method == "PUT" && req.URL.Path == "/v2/loadbalancers/lb":
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Error(err)
+			}
+			rw.Write([]byte(`{"id": "lb", "name": "web"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &LoadBalancerBackendConfig{InstanceID: "inst-2", Protocol: "TCP", SourcePort: 80, TargetPort: 8080, HealthCheckPath: "/healthz"}
+	if _, err := client.AddLoadBalancerBackend("lb", backend); err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []LoadBalancerBackendConfig{
+		{IP: "192.168.1.3", Protocol: "TCP", SourcePort: 80, TargetPort: 8080},
+		{IP: "192.168.1.4", Protocol: "TCP", SourcePort: 80, TargetPort: 8080, InstanceID: "inst-2", HealthCheckPath: "/healthz"},
+	}
+	if !reflect.DeepEqual(sent.Backends, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, sent.Backends)
+	}
+
+	_, err = client.AddLoadBalancerBackend("lb", &LoadBalancerBackendConfig{IP: "192.168.1.3", SourcePort: 80, TargetPort: 8080})
+	if !errors.Is(err, LoadBalancerBackendInvalidError) {
+		t.Errorf("Expected LoadBalancerBackendInvalidError, got %v", err)
+	}
+}
+
+func TestRemoveLoadBalancerBackend(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers/lb": `{"id": "lb", "name": "web", "backends": [{"ip": "192.168.1.3", "source_port": 80, "target_port": 8080}]}`,
+	})
+	defer server.Close()
+
+	_, err := client.RemoveLoadBalancerBackend("lb", "10.0.0.1")
+	if !errors.Is(err, LoadBalancerBackendNotFoundError) {
+		t.Errorf("Expected LoadBalancerBackendNotFoundError, got %v", err)
+	}
+
+	_, err = client.RemoveLoadBalancerBackend("lb", "192.168.1.3")
+	if !errors.Is(err, LoadBalancerBackendInvalidError) {
+		t.Errorf("Expected LoadBalancerBackendInvalidError, got %v", err)
+	}
+}
+
+func TestGetLoadBalancerBackendHealth(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/loadbalancers/56dca3be-b3e4-4bd4-8b73-1d6b7e5e8f3a/backend_health": `[
+			{"ip": "10.0.0.1", "instance_id": "i-1", "target_port": 8080, "status": "healthy", "last_checked_at": "2026-03-01T10:00:00Z"},
+			{"ip": "10.0.0.2", "target_port": 8080, "status": "unhealthy", "last_checked_at": "2026-03-01T10:00:05Z", "failure_reason": "connection refused"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.GetLoadBalancerBackendHealth("56dca3be-b3e4-4bd4-8b73-1d6b7e5e8f3a")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []LoadBalancerBackendHealth{
+		{
+			IP:            "10.0.0.1",
+			InstanceID:    "i-1",
+			TargetPort:    8080,
+			Status:        LoadBalancerBackendHealthy,
+			LastCheckedAt: NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			IP:            "10.0.0.2",
+			TargetPort:    8080,
+			Status:        LoadBalancerBackendUnhealthy,
+			LastCheckedAt: NewTime(time.Date(2026, 3, 1, 10, 0, 5, 0, time.UTC)),
+			FailureReason: "connection refused",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+	if !got[0].Healthy() || got[1].Healthy() {
+		t.Errorf("Expected only the first backend to be healthy, got %+v", got)
+	}
+}
@@ -0,0 +1,126 @@
+package civogo
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DebugEnvVar is the environment variable turning on the debug mode of new clients when set to a true
+// value such as "1" or "true", see WithDebug
+const DebugEnvVar = "CIVO_DEBUG"
+
+// redacted replaces the secrets in debug logs
+const redacted = "[REDACTED]"
+
+// sensitiveJSONField matches the string fields of a request or response body which must not be logged
+var sensitiveJSONField = regexp.MustCompile(`"(api_key|password|initial_password|rescue_password|secret|secret_access_key|token|kubeconfig|private_key)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// sensitivePathFields match the fields which are only secret in the bodies of some endpoints, by path prefix,
+// e.g. the key of a new API key, when "key" is an ordinary field elsewhere
+var sensitivePathFields = map[string]*regexp.Regexp{
+	"/v2/api_keys": regexp.MustCompile(`"(key)"(\s*:\s*)"(?:[^"\\]|\\.)*"`),
+}
+
+// redactSecrets replaces the values of the secret fields of a body sent to or received from path
+func redactSecrets(path, body string) string {
+	body = sensitiveJSONField.ReplaceAllString(body, `"$1"$2"`+redacted+`"`)
+	for prefix, field := range sensitivePathFields {
+		if strings.HasPrefix(path, prefix) {
+			body = field.ReplaceAllString(body, `"$1"$2"`+redacted+`"`)
+		}
+	}
+	return body
+}
+
+// Logger receives the messages of a Client, *log.Logger satisfies it
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithLogger makes the client log what it does, such as retrying a request, to logger
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithDebug turns on, or off, the logging of every request and response the client sends and receives,
+// with the API key and other secrets redacted. It goes to the logger of the client, or to stderr without one
+func WithDebug(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.debug = enabled
+	}
+}
+
+// debugFromEnv reports whether DebugEnvVar asks for the debug mode
+func debugFromEnv() bool {
+	enabled, err := strconv.ParseBool(os.Getenv(DebugEnvVar))
+	return err == nil && enabled
+}
+
+// logf logs a message if the client has a logger
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
+// debugf logs a message in debug mode, to stderr if the client has no logger
+func (c *Client) debugf(format string, v ...interface{}) {
+	if !c.debug {
+		return
+	}
+
+	logger := c.logger
+	if logger == nil {
+		logger = log.New(os.Stderr, "civogo ", log.LstdFlags)
+	}
+	logger.Printf(format, v...)
+}
+
+// debugRequest logs req in debug mode, leaving its body readable
+func (c *Client) debugRequest(req *http.Request) {
+	if !c.debug {
+		return
+	}
+
+	body := ""
+	if req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(r)
+			body = string(b)
+		}
+	}
+
+	headers := make([]string, 0, len(req.Header))
+	for name, values := range req.Header {
+		value := strings.Join(values, ", ")
+		if strings.EqualFold(name, "Authorization") {
+			value = redacted
+		}
+		headers = append(headers, name+": "+value)
+	}
+	sort.Strings(headers)
+
+	c.debugf("request: %s %s [%s] %s", req.Method, req.URL.String(), strings.Join(headers, "; "), c.sanitise(req.URL.Path, body))
+}
+
+// debugResponse logs a response in debug mode
+func (c *Client) debugResponse(req *http.Request, resp *http.Response, body []byte) {
+	c.debugf("response: %s %s %s %s", req.Method, req.URL.Path, resp.Status, c.sanitise(req.URL.Path, string(body)))
+}
+
+// sanitise redacts the API key and the secret fields of a body sent to or received from path
+func (c *Client) sanitise(path, body string) string {
+	if apiKey := c.knownAPIKey(); apiKey != "" {
+		body = strings.ReplaceAll(body, apiKey, redacted)
+	}
+
+	return redactSecrets(path, body)
+}
@@ -0,0 +1,227 @@
+package civogo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultLogReconnectDelay is how long a log tail waits before reconnecting unless told otherwise
+const DefaultLogReconnectDelay = 2 * time.Second
+
+// LogOptions configures a log tail
+type LogOptions struct {
+	// Since, if set, only returns lines logged after it
+	Since time.Time
+
+	// Tail is how many past lines to start with, zero means the server default
+	Tail int
+
+	// Process restricts application logs to one process type of the Procfile
+	Process string
+
+	// ReconnectDelay is the wait before reconnecting a dropped stream, defaults to DefaultLogReconnectDelay
+	ReconnectDelay time.Duration
+
+	// MaxReconnects is how many times in a row a dropped stream is reconnected before giving up,
+	// zero means forever
+	MaxReconnects int
+}
+
+// LogLine is one line of a log stream. The last value sent before the channel is closed
+// has Err set when the tail stopped for another reason than its context ending
+type LogLine struct {
+	Timestamp Time   `json:"timestamp"`
+	Source    string `json:"source,omitempty"`
+	Message   string `json:"message"`
+	Err       error  `json:"-"`
+}
+
+// TailApplicationLogs follows the logs of an application until ctx is done, reconnecting when the stream drops
+func (c *Client) TailApplicationLogs(ctx context.Context, appID string, opts LogOptions) (<-chan LogLine, error) {
+	return c.tailLogs(ctx, fmt.Sprintf("/v2/applications/%s/logs/stream", appID), opts)
+}
+
+// TailInstanceLogs follows the console log of an instance until ctx is done, reconnecting when the stream drops
+func (c *Client) TailInstanceLogs(ctx context.Context, instanceID string, opts LogOptions) (<-chan LogLine, error) {
+	return c.tailLogs(ctx, fmt.Sprintf("/v2/instances/%s/logs/stream", instanceID), opts)
+}
+
+// tailLogs opens the newline delimited JSON log stream at path, returning an error if the first
+// connection fails, and keeps it going in the background
+func (c *Client) tailLogs(ctx context.Context, path string, opts LogOptions) (<-chan LogLine, error) {
+	if opts.ReconnectDelay <= 0 {
+		opts.ReconnectDelay = DefaultLogReconnectDelay
+	}
+
+	tail := &logTail{client: c, path: path, opts: opts, since: opts.Since}
+	body, err := tail.connect(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(chan LogLine)
+	go tail.run(ctx, body, lines)
+
+	return lines, nil
+}
+
+// logTail keeps track of where a log stream got to, so a reconnection picks up after the last line seen
+type logTail struct {
+	client *Client
+	path   string
+	opts   LogOptions
+
+	// since is the timestamp of the last line seen and seenAtSince how many lines had it,
+	// those are skipped when the server sends them again after a reconnection
+	since       time.Time
+	seenAtSince int
+}
+
+// run reads the stream into lines, reconnecting as needed, and closes lines when done
+func (t *logTail) run(ctx context.Context, body io.ReadCloser, lines chan<- LogLine) {
+	defer close(lines)
+
+	failures := 0
+	for {
+		received, err := t.read(ctx, body, lines)
+		body.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			failures = 0
+		}
+
+		for {
+			failures++
+			if t.opts.MaxReconnects > 0 && failures > t.opts.MaxReconnects {
+				if err == nil {
+					err = io.ErrUnexpectedEOF
+				}
+				t.send(ctx, lines, LogLine{Err: fmt.Errorf("giving up on the log stream after %d reconnections: %w", t.opts.MaxReconnects, err)})
+				return
+			}
+			if waitForRetry(ctx, t.opts.ReconnectDelay) != nil {
+				return
+			}
+
+			body, err = t.connect(ctx, false)
+			if err == nil {
+				break
+			}
+			if !isRetryableLogError(err) {
+				t.send(ctx, lines, LogLine{Err: err})
+				return
+			}
+		}
+	}
+}
+
+// read sends the lines of body until it ends, reporting whether any line was received
+func (t *logTail) read(ctx context.Context, body io.Reader, lines chan<- LogLine) (bool, error) {
+	received := false
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	replayed := 0
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		line := LogLine{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			line = LogLine{Timestamp: NewTime(time.Now()), Message: scanner.Text()}
+		}
+
+		switch {
+		case line.Timestamp.Before(t.since):
+			continue
+		case line.Timestamp.Equal(t.since):
+			replayed++
+			if replayed <= t.seenAtSince {
+				continue
+			}
+			t.seenAtSince++
+		default:
+			t.since, t.seenAtSince, replayed = line.Timestamp.Time, 1, 1
+		}
+
+		received = true
+		if !t.send(ctx, lines, line) {
+			return received, ctx.Err()
+		}
+	}
+
+	return received, scanner.Err()
+}
+
+// send hands line to the reader, returning false if ctx ended first
+func (t *logTail) send(ctx context.Context, lines chan<- LogLine, line LogLine) bool {
+	select {
+	case lines <- line:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// connect opens the log stream, asking for the past lines only on the first connection
+func (t *logTail) connect(ctx context.Context, first bool) (io.ReadCloser, error) {
+	query := url.Values{}
+	query.Set("region", t.client.Region)
+	query.Set("follow", "true")
+	if !t.since.IsZero() {
+		query.Set("since", t.since.UTC().Format(time.RFC3339Nano))
+	}
+	if first && t.opts.Tail > 0 {
+		query.Set("tail", strconv.Itoa(t.opts.Tail))
+	}
+	if t.opts.Process != "" {
+		query.Set("process", t.opts.Process)
+	}
+
+	u := t.client.prepareClientURL(t.path)
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+	req.Header.Set("User-Agent", t.client.UserAgent)
+	apiKey, err := t.client.apiKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", apiKey))
+	if err := t.client.applyRequestMiddleware(req); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, decodeError(HTTPError{Code: resp.StatusCode, Status: resp.Status, Reason: string(body), RequestID: resp.Header.Get(requestIDHeader)})
+	}
+
+	return resp.Body, nil
+}
+
+// isRetryableLogError reports whether reconnecting may fix err, i.e. it is not a client error from the API
+func isRetryableLogError(err error) bool {
+	var apiErr *APIError
+	return !errors.As(err, &apiErr) || isRetryableStatus(apiErr.StatusCode)
+}
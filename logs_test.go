@@ -0,0 +1,124 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTailApplicationLogs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var mu sync.Mutex
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		attempt := len(queries)
+		mu.Unlock()
+
+		g.Expect(r.URL.Path).To(Equal("/v2/applications/12345/logs/stream"))
+		switch attempt {
+		case 1:
+			fmt.Fprintln(w, `{"timestamp":"2026-01-01T10:00:00Z","source":"web.1","message":"one"}`)
+			fmt.Fprintln(w, `{"timestamp":"2026-01-01T10:00:01Z","source":"web.1","message":"two"}`)
+		case 2:
+			// the server replays the lines at the since timestamp after a reconnection
+			fmt.Fprintln(w, `{"timestamp":"2026-01-01T10:00:01Z","source":"web.1","message":"two"}`)
+			fmt.Fprintln(w, `{"timestamp":"2026-01-01T10:00:02Z","source":"web.1","message":"three"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"application_not_found","reason":"gone"}`)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lines, err := client.TailApplicationLogs(ctx, "12345", LogOptions{Tail: 10, ReconnectDelay: time.Millisecond})
+	g.Expect(err).To(BeNil())
+
+	messages := []string{}
+	var lastErr error
+	for line := range lines {
+		if line.Err != nil {
+			lastErr = line.Err
+			continue
+		}
+		messages = append(messages, line.Message)
+	}
+
+	g.Expect(messages).To(Equal([]string{"one", "two", "three"}))
+	var apiErr *APIError
+	g.Expect(errors.As(lastErr, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+
+	mu.Lock()
+	defer mu.Unlock()
+	g.Expect(queries[0]).To(ContainSubstring("tail=10"))
+	g.Expect(queries[0]).To(ContainSubstring("follow=true"))
+	g.Expect(queries[1]).To(ContainSubstring("since=2026-01-01T10%3A00%3A01Z"))
+	g.Expect(queries[1]).NotTo(ContainSubstring("tail="))
+}
+
+func TestTailInstanceLogsNotFound(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code":"instance_not_found","reason":"no instance"}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	lines, err := client.TailInstanceLogs(context.Background(), "99999", LogOptions{})
+	g.Expect(lines).To(BeNil())
+	var apiErr *APIError
+	g.Expect(errors.As(err, &apiErr)).To(BeTrue())
+	g.Expect(apiErr.StatusCode).To(Equal(http.StatusNotFound))
+}
+
+func TestTailLogsMaxReconnects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"timestamp":"2026-01-01T10:00:00Z","message":"only"}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lines, err := client.TailInstanceLogs(ctx, "12345", LogOptions{ReconnectDelay: time.Millisecond, MaxReconnects: 2})
+	g.Expect(err).To(BeNil())
+
+	received := []LogLine{}
+	for line := range lines {
+		received = append(received, line)
+	}
+
+	g.Expect(received).To(HaveLen(2))
+	g.Expect(received[0].Message).To(Equal("only"))
+	g.Expect(received[1].Err).NotTo(BeNil())
+	g.Expect(received[1].Err.Error()).To(ContainSubstring("after 2 reconnections"))
+}
+
+func TestIsRetryableLogErrorWrapped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+	g.Expect(isRetryableLogError(notFound)).To(BeFalse())
+	g.Expect(isRetryableLogError(fmt.Errorf("tailing logs: %w", notFound))).To(BeFalse())
+	g.Expect(isRetryableLogError(fmt.Errorf("tailing logs: %w", &APIError{StatusCode: http.StatusBadGateway}))).To(BeTrue())
+	g.Expect(isRetryableLogError(errors.New("connection reset"))).To(BeTrue())
+}
@@ -0,0 +1,67 @@
+package civogo
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// RequestMetrics describes one HTTP request the client sent, retries being requests of their own
+type RequestMetrics struct {
+	// Method is the HTTP method, e.g. GET
+	Method string
+	// Endpoint is the path of the request with the IDs replaced by ":id", e.g. /v2/dns/:id/records,
+	// so requests to the same endpoint can be counted together
+	Endpoint string
+	// StatusCode is the status of the response, zero when none was received
+	StatusCode int
+	// Duration is the time it took to get the response
+	Duration time.Duration
+	// Err is the error of the request when no response was received
+	Err error
+}
+
+// Failed reports whether the request got an error or an error status
+func (m RequestMetrics) Failed() bool {
+	return m.Err != nil || m.StatusCode >= 400
+}
+
+// MetricsCollector is given the metrics of every request a client configured with WithMetricsCollector
+// sends, it must be safe for concurrent use. The prometheus subpackage has a ready made one
+type MetricsCollector interface {
+	ObserveRequest(metrics RequestMetrics)
+}
+
+// WithMetricsCollector makes the client report every request it sends to collector
+func WithMetricsCollector(collector MetricsCollector) ClientOption {
+	return func(c *Client) {
+		c.metricsCollector = collector
+	}
+}
+
+// observeRequest reports a request to the metrics collector of the client, if any
+func (c *Client) observeRequest(method, path string, status int, start time.Time, err error) {
+	if c.metricsCollector == nil {
+		return
+	}
+
+	c.metricsCollector.ObserveRequest(RequestMetrics{
+		Method:     method,
+		Endpoint:   endpointTemplate(path),
+		StatusCode: status,
+		Duration:   time.Since(start),
+		Err:        err,
+	})
+}
+
+// endpointTemplate replaces the IDs in path with ":id", an ID being a segment with a digit in it
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if i > 1 && strings.IndexFunc(segment, unicode.IsDigit) >= 0 {
+			segments[i] = ":id"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
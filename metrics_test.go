@@ -0,0 +1,60 @@
+package civogo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type recordingCollector struct {
+	mu       sync.Mutex
+	requests []RequestMetrics
+}
+
+func (r *recordingCollector) ObserveRequest(m RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, m)
+}
+
+func TestMetricsCollector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	collector := &recordingCollector{}
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(1, time.Millisecond)(client)
+	WithMetricsCollector(collector)(client)
+
+	_, err := client.ListDNSRecords("edc5dacf-1111")
+	g.Expect(err).To(BeNil())
+
+	g.Expect(collector.requests).To(HaveLen(2))
+	g.Expect(collector.requests[0].Endpoint).To(Equal("/v2/dns/:id/records"))
+	g.Expect(collector.requests[0].Method).To(Equal("GET"))
+	g.Expect(collector.requests[0].StatusCode).To(Equal(http.StatusTooManyRequests))
+	g.Expect(collector.requests[0].Failed()).To(BeTrue())
+	g.Expect(collector.requests[1].StatusCode).To(Equal(http.StatusOK))
+	g.Expect(collector.requests[1].Failed()).To(BeFalse())
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(endpointTemplate("/v2/instances")).To(Equal("/v2/instances"))
+	g.Expect(endpointTemplate("/v2/kubernetes/clusters/ab-12/pools/3")).To(Equal("/v2/kubernetes/clusters/:id/pools/:id"))
+}
@@ -0,0 +1,52 @@
+package civogo
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// RequestMiddleware is called with every request the client sends, retries included, after the client
+// set its own headers. It can add headers, sign or record the request, returning an error stops it
+type RequestMiddleware func(req *http.Request) error
+
+// ResponseHook is called with every response the client receives, before its status is checked. The body
+// can be read, the client keeps its own copy. Returning an error makes the call fail with it
+type ResponseHook func(resp *http.Response) error
+
+// WithRequestMiddleware adds middleware to the ones the client calls, in the order they were added
+func WithRequestMiddleware(middleware ...RequestMiddleware) ClientOption {
+	return func(c *Client) {
+		c.requestMiddleware = append(c.requestMiddleware, middleware...)
+	}
+}
+
+// WithResponseHook adds hooks to the ones the client calls, in the order they were added
+func WithResponseHook(hooks ...ResponseHook) ClientOption {
+	return func(c *Client) {
+		c.responseHooks = append(c.responseHooks, hooks...)
+	}
+}
+
+// applyRequestMiddleware passes req through the request middleware of the client
+func (c *Client) applyRequestMiddleware(req *http.Request) error {
+	for _, middleware := range c.requestMiddleware {
+		if err := middleware(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyResponseHooks passes resp, whose body has already been read into body, to the response hooks of the client
+func (c *Client) applyResponseHooks(resp *http.Response, body []byte) error {
+	for _, hook := range c.responseHooks {
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err := hook(resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package civogo
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRequestMiddlewareAndResponseHooks(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Header.Get("X-Audit-ID")).To(Equal("audit-1"))
+		g.Expect(r.Header.Get("X-Signature")).To(Equal("signed:audit-1"))
+		w.Header().Set("X-Request-ID", "req-1")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var seenRequestID, seenBody string
+	client, _ := NewClientForTestingWithServer(server)
+	WithRequestMiddleware(
+		func(req *http.Request) error {
+			req.Header.Set("X-Audit-ID", "audit-1")
+			return nil
+		},
+		// middleware runs in order, so this one sees the header set by the first
+		func(req *http.Request) error {
+			req.Header.Set("X-Signature", "signed:"+req.Header.Get("X-Audit-ID"))
+			return nil
+		},
+	)(client)
+	WithResponseHook(func(resp *http.Response) error {
+		seenRequestID = resp.Header.Get("X-Request-ID")
+		body, _ := io.ReadAll(resp.Body)
+		seenBody = string(body)
+		return nil
+	})(client)
+
+	domains, err := client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	g.Expect(domains).To(BeEmpty())
+	g.Expect(seenRequestID).To(Equal("req-1"))
+	g.Expect(seenBody).To(Equal("[]"))
+}
+
+func TestRequestMiddlewareError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	refused := errors.New("refused by policy")
+	client, _ := NewClientForTestingWithServer(server)
+	WithRequestMiddleware(func(req *http.Request) error { return refused })(client)
+
+	_, err := client.ListDNSDomains()
+	g.Expect(errors.Is(err, refused)).To(BeTrue())
+	g.Expect(called).To(BeFalse())
+}
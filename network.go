@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 )
 
@@ -26,6 +27,26 @@ type Network struct {
 	GatewayIPv4           string   `json:"gateway_ipv4" validate:"required" schema:"gateway_ipv4"`
 	AllocationPoolV4Start string   `json:"allocation_pool_v4_start" validate:"required" schema:"allocation_pool_v4_start"`
 	AllocationPoolV4End   string   `json:"allocation_pool_v4_end" validate:"required" schema:"allocation_pool_v4_end"`
+	// IPv6Ranges are the IPv6 ranges allocated to the network, CIDRV6 is the first of them
+	IPv6Ranges []string `json:"ipv6_ranges,omitempty"`
+}
+
+// DualStack reports whether the network has both IPv4 and IPv6 enabled
+func (n *Network) DualStack() bool {
+	return n.IPv4Enabled && n.IPv6Enabled
+}
+
+// Clone returns a deep copy of the network, sharing none of its slices and maps
+func (n *Network) Clone() *Network {
+	if n == nil {
+		return nil
+	}
+
+	c := *n
+	c.NameserversV4 = cloneSlice(n.NameserversV4)
+	c.NameserversV6 = cloneSlice(n.NameserversV6)
+	c.IPv6Ranges = cloneSlice(n.IPv6Ranges)
+	return &c
 }
 
 // Subnet represents a subnet within a private network
@@ -37,6 +58,16 @@ type Subnet struct {
 	Status     string `json:"status,omitempty"`
 }
 
+// Clone returns a copy of the subnet
+func (s *Subnet) Clone() *Subnet {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
+
 // SubnetConfig contains incoming request parameters for the subnet object
 type SubnetConfig struct {
 	Name string `json:"name" validate:"required" schema:"name"`
@@ -89,6 +120,72 @@ type NetworkConfig struct {
 	NameserversV6 []string           `json:"nameservers_v6"`
 	Region        string             `json:"region"`
 	VLanConfig    *VLANConnectConfig `json:"vlan_connect,omitempty"`
+	// CIDRv6 is the IPv6 range of the network, the API allocates one when IPv6 is enabled and it's empty
+	CIDRv6 string `json:"cidr_v6,omitempty"`
+}
+
+// Validate checks the label, CIDRs and addresses of the network before it is sent to the API,
+// the rejected fields are listed in the ValidationErrors it wraps
+func (nc *NetworkConfig) Validate() error {
+	var errs ValidationErrors
+	if nc.Label == "" {
+		errs.add("label", nc.Label, "the network label is empty")
+	}
+
+	if nc.CIDRv4 != "" {
+		if ip, _, err := net.ParseCIDR(nc.CIDRv4); err != nil || ip.To4() == nil {
+			errs.add("cidr_v4", nc.CIDRv4, "%q is not an IPv4 CIDR", nc.CIDRv4)
+		}
+	}
+
+	if nc.CIDRv6 != "" {
+		if ip, _, err := net.ParseCIDR(nc.CIDRv6); err != nil || ip.To4() != nil {
+			errs.add("cidr_v6", nc.CIDRv6, "%q is not an IPv6 CIDR", nc.CIDRv6)
+		}
+	}
+
+	ipv4Disabled := nc.IPv4Enabled != nil && !*nc.IPv4Enabled
+	ipv6Disabled := nc.IPv6Enabled != nil && !*nc.IPv6Enabled
+	switch {
+	case ipv4Disabled && ipv6Disabled:
+		errs.add("ipv6_enabled", false, "the network needs IPv4 or IPv6 enabled")
+	case ipv6Disabled && (nc.CIDRv6 != "" || len(nc.NameserversV6) > 0):
+		errs.add("ipv6_enabled", false, "IPv6 is disabled but an IPv6 CIDR or nameservers are set")
+	}
+
+	for i, ns := range nc.NameserversV4 {
+		if ip := net.ParseIP(ns); ip == nil || ip.To4() == nil {
+			errs.add(fmt.Sprintf("nameservers_v4[%d]", i), ns, "nameserver %q is not an IPv4 address", ns)
+		}
+	}
+	for i, ns := range nc.NameserversV6 {
+		if ip := net.ParseIP(ns); ip == nil || ip.To4() != nil {
+			errs.add(fmt.Sprintf("nameservers_v6[%d]", i), ns, "nameserver %q is not an IPv6 address", ns)
+		}
+	}
+
+	if nc.VLanConfig != nil {
+		if id := nc.VLanConfig.VlanID; id < 1 || id > 4094 {
+			errs.add("vlan_connect.vlan_id", id, "VLAN ID must be between 1 and 4094, got %d", id)
+		}
+		_, cidr, err := net.ParseCIDR(nc.VLanConfig.CIDRv4)
+		if err != nil {
+			errs.add("vlan_connect.cidr_v4", nc.VLanConfig.CIDRv4, "VLAN CIDR %q is invalid", nc.VLanConfig.CIDRv4)
+		} else {
+			addresses := []struct{ field, address string }{
+				{"vlan_connect.gateway_ipv4", nc.VLanConfig.GatewayIPv4},
+				{"vlan_connect.allocation_pool_v4_start", nc.VLanConfig.AllocationPoolV4Start},
+				{"vlan_connect.allocation_pool_v4_end", nc.VLanConfig.AllocationPoolV4End},
+			}
+			for _, a := range addresses {
+				if ip := net.ParseIP(a.address); ip == nil || !cidr.Contains(ip) {
+					errs.add(a.field, a.address, "VLAN address %q is not within %s", a.address, cidr)
+				}
+			}
+		}
+	}
+
+	return errs.wrap(NetworkConfigInvalidError)
 }
 
 // NetworkResult represents the result from a network create/update call
@@ -107,9 +204,9 @@ func (c *Client) GetDefaultNetwork() (*Network, error) {
 
 	networks := make([]Network, 0)
 	json.NewDecoder(bytes.NewReader(resp)).Decode(&networks)
-	for _, network := range networks {
-		if network.Default {
-			return &network, nil
+	for i := range networks {
+		if networks[i].Default {
+			return networks[i].Clone(), nil
 		}
 	}
 
@@ -159,38 +256,15 @@ func (c *Client) ListNetworks() ([]Network, error) {
 	return networks, nil
 }
 
-// FindNetwork finds a network by either part of the ID or part of the name
+// FindNetwork finds a network by its ID, name or label, exactly, by prefix or by any part of them in that order.
+// A search matching several networks returns an *ErrMultipleMatchesFound
 func (c *Client) FindNetwork(search string) (*Network, error) {
 	networks, err := c.ListNetworks()
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := Network{}
-
-	for _, value := range networks {
-		if value.Name == search || value.ID == search || value.Label == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) || strings.Contains(value.Label, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
-			}
-		}
-	}
-
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
-	}
+	return findMatch(networks, search, false, func(n Network) (string, []string) { return n.ID, []string{n.Name, n.Label} })
 }
 
 // RenameNetwork renames an existing private network
@@ -248,6 +322,10 @@ func (c *Client) ListSubnets(networkID string) ([]Subnet, error) {
 
 // CreateSubnet creates a new subnet for a private network
 func (c *Client) CreateSubnet(networkID string, subnet SubnetConfig) (*Subnet, error) {
+	if subnet.Name == "" {
+		return nil, NetworkConfigInvalidError.wrap(errors.New("the subnet name is empty"))
+	}
+
 	body, err := c.SendPostRequest(fmt.Sprintf("/v2/networks/%s/subnets", networkID), subnet)
 	if err != nil {
 		return nil, decodeError(err)
@@ -332,8 +410,12 @@ func (c *Client) DeleteSubnet(networkID, subnetID string) (*SimpleResponse, erro
 	return c.DecodeSimpleResponse(resp)
 }
 
-// CreateNetwork creates a new network
+// CreateNetwork creates a new network, see NetworkConfig.Validate for the checks made beforehand
 func (c *Client) CreateNetwork(nc NetworkConfig) (*NetworkResult, error) {
+	if err := nc.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPostRequest("/v2/networks", nc)
 	if err != nil {
 		return nil, decodeError(err)
@@ -0,0 +1,106 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Statuses of an instance network attachment
+const (
+	NetworkAttachmentStatusAttaching = "attaching"
+	NetworkAttachmentStatusAttached  = "attached"
+	NetworkAttachmentStatusDetaching = "detaching"
+	NetworkAttachmentStatusFailed    = "failed"
+)
+
+// NetworkAttachment connects an instance to a network other than the one it was created in
+type NetworkAttachment struct {
+	ID         string `json:"id"`
+	InstanceID string `json:"instance_id"`
+	NetworkID  string `json:"network_id"`
+	PrivateIP  string `json:"private_ip,omitempty"`
+	Status     string `json:"status"`
+}
+
+// Clone returns a copy of the attachment
+func (a *NetworkAttachment) Clone() *NetworkAttachment {
+	if a == nil {
+		return nil
+	}
+
+	c := *a
+	return &c
+}
+
+// networkAttachmentConfig is the request to attach an instance to a network
+type networkAttachmentConfig struct {
+	NetworkID string `json:"network_id"`
+	Region    string `json:"region"`
+}
+
+// AttachInstanceToNetwork connects an instance to an additional network. The attachment starts
+// as attaching, use WaitForInstanceNetworkAttached to wait until the instance has an address in it
+func (c *Client) AttachInstanceToNetwork(instanceID, networkID string) (*NetworkAttachment, error) {
+	if instanceID == "" || networkID == "" {
+		err := fmt.Errorf("both the instance ID and the network ID are needed")
+		return nil, IDisEmptyError.wrap(err)
+	}
+
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/instances/%s/networks", instanceID), networkAttachmentConfig{
+		NetworkID: networkID,
+		Region:    c.Region,
+	})
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result = &NetworkAttachment{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListInstanceNetworkAttachments returns the networks an instance is attached to
+func (c *Client) ListInstanceNetworkAttachments(instanceID string) ([]NetworkAttachment, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/instances/%s/networks", instanceID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	attachments := make([]NetworkAttachment, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&attachments); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// GetInstanceNetworkAttachment returns the attachment of an instance to a network
+func (c *Client) GetInstanceNetworkAttachment(instanceID, networkID string) (*NetworkAttachment, error) {
+	attachments, err := c.ListInstanceNetworkAttachments(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range attachments {
+		if attachments[i].NetworkID == networkID {
+			return attachments[i].Clone(), nil
+		}
+	}
+
+	err = fmt.Errorf("instance %s is not attached to network %s", instanceID, networkID)
+	return nil, ZeroMatchesError.wrap(err)
+}
+
+// DetachInstanceFromNetwork disconnects an instance from an additional network
+func (c *Client) DetachInstanceFromNetwork(instanceID, networkID string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/instances/%s/networks/%s", instanceID, networkID))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
@@ -0,0 +1,49 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAttachInstanceToNetwork(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/inst-1/networks": `{"id": "att-1", "instance_id": "inst-1", "network_id": "net-2", "status": "attaching"}`,
+	})
+	defer server.Close()
+
+	got, err := client.AttachInstanceToNetwork("inst-1", "net-2")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &NetworkAttachment{ID: "att-1", InstanceID: "inst-1", NetworkID: "net-2", Status: NetworkAttachmentStatusAttaching}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.AttachInstanceToNetwork("inst-1", ""); !errors.Is(err, IDisEmptyError) {
+		t.Errorf("Expected IDisEmptyError, got %v", err)
+	}
+}
+
+func TestGetInstanceNetworkAttachment(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/inst-1/networks": `[{"id": "att-1", "instance_id": "inst-1", "network_id": "net-2", "private_ip": "10.1.0.5", "status": "attached"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceNetworkAttachment("inst-1", "net-2")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.PrivateIP != "10.1.0.5" {
+		t.Errorf("Expected %s, got %s", "10.1.0.5", got.PrivateIP)
+	}
+
+	if _, err := client.GetInstanceNetworkAttachment("inst-1", "net-3"); !errors.Is(err, ZeroMatchesError) {
+		t.Errorf("Expected ZeroMatchesError, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -43,6 +44,26 @@ func TestGetNetwork(t *testing.T) {
 	}
 }
 
+func TestGetDualStackNetwork(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/networks/12345": `{"id": "12345", "name": "dual", "ipv4_enabled": true, "ipv6_enabled": true, "cidr_v6": "fd00:1::/64", "ipv6_ranges": ["fd00:1::/64", "fd00:2::/64"]}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetNetwork("12345")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if !got.DualStack() {
+		t.Errorf("Expected a dual-stack network, got %+v", got)
+	}
+	expected := []string{"fd00:1::/64", "fd00:2::/64"}
+	if !reflect.DeepEqual(got.IPv6Ranges, expected) {
+		t.Errorf("Expected %v, got %v", expected, got.IPv6Ranges)
+	}
+}
+
 func TestNewNetwork(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/networks": `{
@@ -388,3 +409,38 @@ func TestDeleteSubnet(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestNetworkConfigValidate(t *testing.T) {
+	enabled, disabled := true, false
+	tests := []struct {
+		name   string
+		config NetworkConfig
+		valid  bool
+	}{
+		{"label only", NetworkConfig{Label: "private"}, true},
+		{"cidr and nameservers", NetworkConfig{Label: "private", CIDRv4: "10.1.0.0/16", NameserversV4: []string{"1.1.1.1"}, NameserversV6: []string{"2606:4700:4700::1111"}}, true},
+		{"no label", NetworkConfig{}, false},
+		{"ipv6 cidr as v4", NetworkConfig{Label: "private", CIDRv4: "fd00::/64"}, false},
+		{"bad cidr", NetworkConfig{Label: "private", CIDRv4: "10.1.0.0/40"}, false},
+		{"v6 nameserver as v4", NetworkConfig{Label: "private", NameserversV4: []string{"2606:4700:4700::1111"}}, false},
+		{"gateway outside vlan", NetworkConfig{Label: "private", VLanConfig: &VLANConnectConfig{CIDRv4: "10.0.0.0/24", GatewayIPv4: "10.0.1.1", AllocationPoolV4Start: "10.0.0.10", AllocationPoolV4End: "10.0.0.20"}}, false},
+		{"vlan", NetworkConfig{Label: "private", VLanConfig: &VLANConnectConfig{VlanID: 100, CIDRv4: "10.0.0.0/24", GatewayIPv4: "10.0.0.1", AllocationPoolV4Start: "10.0.0.10", AllocationPoolV4End: "10.0.0.20"}}, true},
+		{"vlan id out of range", NetworkConfig{Label: "private", VLanConfig: &VLANConnectConfig{VlanID: 4095, CIDRv4: "10.0.0.0/24", GatewayIPv4: "10.0.0.1", AllocationPoolV4Start: "10.0.0.10", AllocationPoolV4End: "10.0.0.20"}}, false},
+		{"dual stack", NetworkConfig{Label: "private", IPv4Enabled: &enabled, IPv6Enabled: &enabled, CIDRv4: "10.1.0.0/16", CIDRv6: "fd00:1::/64"}, true},
+		{"ipv4 cidr as v6", NetworkConfig{Label: "private", CIDRv6: "10.1.0.0/16"}, false},
+		{"v6 cidr with ipv6 disabled", NetworkConfig{Label: "private", IPv6Enabled: &disabled, CIDRv6: "fd00:1::/64"}, false},
+		{"ipv4 and ipv6 disabled", NetworkConfig{Label: "private", IPv4Enabled: &disabled, IPv6Enabled: &disabled}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, NetworkConfigInvalidError) {
+				t.Errorf("Expected NetworkConfigInvalidError, got %v", err)
+			}
+		})
+	}
+}
@@ -17,6 +17,16 @@ type ObjectStore struct {
 	Status    string      `json:"status"`
 }
 
+// Clone returns a copy of the object store
+func (o *ObjectStore) Clone() *ObjectStore {
+	if o == nil {
+		return nil
+	}
+
+	c := *o
+	return &c
+}
+
 // BucketOwner is the struct for owner details of an Object Store
 type BucketOwner struct {
 	AccessKeyID  string `json:"access_key_id,omitempty"`
@@ -32,6 +42,11 @@ type PaginatedObjectstores struct {
 	Items   []ObjectStore `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedObjectstores) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // CreateObjectStoreRequest holds the request to create a new object storage
 type CreateObjectStoreRequest struct {
 	Name        string `json:"name,omitempty"`
@@ -55,9 +70,9 @@ type UpdateObjectStoreRequest struct {
 	Region string `json:"region"`
 }
 
-// ListObjectStores returns all objectstores in that specific region
-func (c *Client) ListObjectStores() (*PaginatedObjectstores, error) {
-	resp, err := c.SendGetRequest("/v2/objectstores")
+// ListObjectStores returns all objectstores in that specific region, a page at a time, see ListOptions
+func (c *Client) ListObjectStores(opts ...ListOptions) (*PaginatedObjectstores, error) {
+	resp, err := c.sendListRequest("/v2/objectstores", opts)
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -134,6 +149,42 @@ func (c *Client) NewObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, erro
 	return result, nil
 }
 
+// CreateObjectStore checks the request and creates a new objectstore in the client's region,
+// unless another region is given
+func (c *Client) CreateObjectStore(v *CreateObjectStoreRequest) (*ObjectStore, error) {
+	if v.MaxSizeGB <= 0 {
+		err := fmt.Errorf("the size of the objectstore must be positive, got %dGB", v.MaxSizeGB)
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
+	return c.NewObjectStore(v)
+}
+
+// ResizeObjectStore changes the maximum size of an objectstore, refusing to shrink it below
+// the space its objects already use
+func (c *Client) ResizeObjectStore(id string, maxSizeGB int64) (*ObjectStore, error) {
+	if maxSizeGB <= 0 {
+		err := fmt.Errorf("the size of the objectstore must be positive, got %dGB", maxSizeGB)
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	stats, err := c.GetObjectStoreStats(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxSizeGB*1024*1024 < stats.SizeKBUtilised {
+		err := fmt.Errorf("unable to resize the objectstore to %dGB, %dKB are already used", maxSizeGB, stats.SizeKBUtilised)
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	return c.UpdateObjectStore(id, &UpdateObjectStoreRequest{MaxSizeGB: maxSizeGB, Region: c.Region})
+}
+
 // UpdateObjectStore updates an objectstore
 func (c *Client) UpdateObjectStore(id string, v *UpdateObjectStoreRequest) (*ObjectStore, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/objectstores/%s", id), v)
@@ -26,6 +26,11 @@ type PaginatedObjectStoreCredentials struct {
 	Items   []ObjectStoreCredential `json:"items"`
 }
 
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *PaginatedObjectStoreCredentials) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
 // CreateObjectStoreCredentialRequest holds the request to create a new object store credential
 type CreateObjectStoreCredentialRequest struct {
 	Name              string  `json:"name" validate:"required"`
@@ -127,6 +132,33 @@ func (c *Client) NewObjectStoreCredential(v *CreateObjectStoreCredentialRequest)
 	return result, nil
 }
 
+// CreateObjectStoreCredential creates a new objectstore credential called name in the client's region,
+// the access and secret keys are generated by Civo, see GetObjectStoreCredentialKeys to read them
+func (c *Client) CreateObjectStoreCredential(name string) (*ObjectStoreCredential, error) {
+	if name == "" {
+		err := fmt.Errorf("the credential name is empty")
+		return nil, ObjectStoreInvalidError.wrap(err)
+	}
+
+	return c.NewObjectStoreCredential(&CreateObjectStoreCredentialRequest{Name: name, Region: c.Region})
+}
+
+// GetObjectStoreCredentialKeys returns the access key ID and the secret access key of an objectstore credential,
+// they are only available once the credential is ready
+func (c *Client) GetObjectStoreCredentialKeys(id string) (accessKeyID, secretAccessKey string, err error) {
+	credential, err := c.GetObjectStoreCredential(id)
+	if err != nil {
+		return "", "", err
+	}
+
+	if credential.AccessKeyID == "" || credential.SecretAccessKeyID == "" {
+		err := fmt.Errorf("the keys of credential %s are not available yet, it is %s", credential.Name, credential.Status)
+		return "", "", ObjectStoreCredentialUnavailableError.wrap(err)
+	}
+
+	return credential.AccessKeyID, credential.SecretAccessKeyID, nil
+}
+
 // UpdateObjectStoreCredential updates an objectstore credential
 func (c *Client) UpdateObjectStoreCredential(id string, v *UpdateObjectStoreCredentialRequest) (*ObjectStoreCredential, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/objectstore/credentials/%s", id), v)
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -134,6 +135,28 @@ func TestDeleteObjectStoreCredential(t *testing.T) {
 	}
 }
 
+func TestGetObjectStoreCredentialKeys(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/objectstore/credentials/ready":   `{"id": "ready", "name": "ready", "access_key_id": "AKIA123", "secret_access_key_id": "secret", "status": "ready"}`,
+		"/v2/objectstore/credentials/pending": `{"id": "pending", "name": "pending", "status": "creating"}`,
+	})
+	defer server.Close()
+
+	accessKeyID, secretAccessKey, err := client.GetObjectStoreCredentialKeys("ready")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if accessKeyID != "AKIA123" || secretAccessKey != "secret" {
+		t.Errorf("Expected AKIA123/secret, got %s/%s", accessKeyID, secretAccessKey)
+	}
+
+	_, _, err = client.GetObjectStoreCredentialKeys("pending")
+	if !errors.Is(err, ObjectStoreCredentialUnavailableError) {
+		t.Errorf("Expected ObjectStoreCredentialUnavailableError, got %v", err)
+	}
+}
+
 func intPtr(i int) *int {
 	return &i
 }
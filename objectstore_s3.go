@@ -0,0 +1,160 @@
+package civogo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ObjectStoreS3Config holds what an S3 compatible client needs to talk to an objectstore
+type ObjectStoreS3Config struct {
+	// Endpoint is the base URL of the S3 API, e.g. https://objectstore.lon1.civo.com
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// ObjectStorePresignMaxExpiry is the longest a presigned URL can be valid for
+const ObjectStorePresignMaxExpiry = 7 * 24 * time.Hour
+
+// S3Config returns the endpoint, bucket and access key of the objectstore. The secret key is not
+// part of the objectstore, set it from GetObjectStoreCredentialKeys or use GetObjectStoreS3Config
+func (o *ObjectStore) S3Config() *ObjectStoreS3Config {
+	endpoint := o.BucketURL
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+
+	return &ObjectStoreS3Config{
+		Endpoint:    strings.TrimSuffix(endpoint, "/"),
+		Bucket:      o.Name,
+		AccessKeyID: o.OwnerInfo.AccessKeyID,
+	}
+}
+
+// GetObjectStoreS3Config returns the full S3 configuration of an objectstore, including the secret
+// key of the credential owning it
+func (c *Client) GetObjectStoreS3Config(id string) (*ObjectStoreS3Config, error) {
+	store, err := c.GetObjectStore(id)
+	if err != nil {
+		return nil, err
+	}
+
+	config := store.S3Config()
+	config.Region = c.Region
+	config.AccessKeyID, config.SecretAccessKey, err = c.GetObjectStoreCredentialKeys(store.OwnerInfo.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// PresignGetURL returns a URL anyone can use to download key from the bucket until it expires
+func (s *ObjectStoreS3Config) PresignGetURL(key string, expires time.Duration) (string, error) {
+	return s.presign("GET", key, expires, time.Now())
+}
+
+// PresignPutURL returns a URL anyone can use to upload key to the bucket until it expires
+func (s *ObjectStoreS3Config) PresignPutURL(key string, expires time.Duration) (string, error) {
+	return s.presign("PUT", key, expires, time.Now())
+}
+
+// presign builds a path style AWS signature version 4 query string URL for key
+func (s *ObjectStoreS3Config) presign(method, key string, expires time.Duration, now time.Time) (string, error) {
+	if s.Endpoint == "" || s.Bucket == "" || s.AccessKeyID == "" || s.SecretAccessKey == "" {
+		err := fmt.Errorf("the endpoint, bucket, access key and secret key are needed to presign a URL")
+		return "", ObjectStoreInvalidError.wrap(err)
+	}
+	if expires < time.Second || expires > ObjectStorePresignMaxExpiry {
+		err := fmt.Errorf("the expiry of a presigned URL must be between 1s and %s, got %s", ObjectStorePresignMaxExpiry, expires)
+		return "", ObjectStoreInvalidError.wrap(err)
+	}
+
+	endpoint, err := url.Parse(s.Endpoint)
+	if err != nil {
+		return "", ObjectStoreInvalidError.wrap(err)
+	}
+
+	region := s.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	path := strings.TrimSuffix(endpoint.Path, "/") + "/" + s.Bucket + "/" + strings.TrimPrefix(key, "/")
+	query := presignS3(method, endpoint.Host, path, region, s.AccessKeyID, s.SecretAccessKey, expires, now)
+
+	return fmt.Sprintf("%s://%s%s?%s", endpoint.Scheme, endpoint.Host, s3Escape(path, false), query), nil
+}
+
+// presignS3 returns the signed query string of a request, following
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func presignS3(method, host, path, region, accessKeyID, secretAccessKey string, expires time.Duration, now time.Time) string {
+	datetime := now.UTC().Format("20060102T150405Z")
+	date := datetime[:8]
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+
+	params := map[string]string{
+		"X-Amz-Algorithm":     "AWS4-HMAC-SHA256",
+		"X-Amz-Credential":    accessKeyID + "/" + scope,
+		"X-Amz-Date":          datetime,
+		"X-Amz-Expires":       strconv.Itoa(int(expires.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = s3Escape(name, true) + "=" + s3Escape(params[name], true)
+	}
+	query := strings.Join(pairs, "&")
+
+	canonicalRequest := strings.Join([]string{method, s3Escape(path, false), query, "host:" + host, "", "host", "UNSIGNED-PAYLOAD"}, "\n")
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", datetime, scope, hex.EncodeToString(hashed[:])}, "\n")
+
+	signingKey := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+
+	return query + "&X-Amz-Signature=" + hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with key
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3Escape percent-encodes everything but the unreserved characters of RFC 3986,
+// keeping slashes unless encodeSlash is set
+func s3Escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
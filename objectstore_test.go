@@ -1,6 +1,9 @@
 package civogo
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -118,6 +121,41 @@ func TestUpdateObjectStore(t *testing.T) {
 	}
 }
 
+func TestResizeObjectStore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == "/v2/objectstores/12345/stats":
+			rw.Write([]byte(`{"size_kb_utilised": 2097152, "max_size_kb": 524288000, "num_objects": 10}`))
+		case req.Method == "PUT" && req.URL.Path == "/v2/objectstores/12345":
+			rw.Write([]byte(`{"id": "12345", "name": "test-objectstore", "max_size": 1000}`))
+		default:
+			t.Errorf("Unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.ResizeObjectStore("12345", 1000)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.MaxSize != 1000 {
+		t.Errorf("Expected %d, got %d", 1000, got.MaxSize)
+	}
+
+	if _, err := client.ResizeObjectStore("12345", 1); !errors.Is(err, ObjectStoreInvalidError) {
+		t.Errorf("Expected ObjectStoreInvalidError, got %v", err)
+	}
+	if _, err := client.ResizeObjectStore("12345", 0); !errors.Is(err, ObjectStoreInvalidError) {
+		t.Errorf("Expected ObjectStoreInvalidError, got %v", err)
+	}
+}
+
 func TestDeleteObjectStore(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/objectstores/12345": `{"result": "success"}`,
@@ -3,37 +3,36 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
-	"time"
 )
 
 // Organisation represents a group of accounts treated as a single entity
 type Organisation struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	Token     string    `json:"token"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Token     string `json:"token"`
+	CreatedAt Time   `json:"created_at,omitempty"`
+	UpdatedAt Time   `json:"updated_at,omitempty"`
 }
 
 // Account is the owner of Civo resources such as instances, Kubernetes clusters, volumes, etc
 // Really the Account should be defined with Account endpoints, but there aren't any that are
 // publicly-useful
 type Account struct {
-	ID              string    `json:"id"`
-	CreatedAt       time.Time `json:"created_at,omitempty"`
-	UpdatedAt       time.Time `json:"updated_at,omitempty"`
-	Label           string    `json:"label,omitempty"`
-	EmailAddress    string    `json:"email_address,omitempty"`
-	APIKey          string    `json:"api_key,omitempty"`
-	Token           string    `json:"token,omitempty"`
-	Flags           string    `json:"flags,omitempty"`
-	Timezone        string    `json:"timezone,omitempty"`
-	Partner         string    `json:"partner,omitempty"`
-	DefaultUserID   string    `json:"default_user_id,omitempty"`
-	Status          string    `json:"status,omitempty"`
-	EmailConfirmed  bool      `json:"email_confirmed,omitempty"`
-	CreditCardAdded bool      `json:"credit_card_added,omitempty"`
-	Enabled         bool      `json:"enabled,omitempty"`
+	ID              string `json:"id"`
+	CreatedAt       Time   `json:"created_at,omitempty"`
+	UpdatedAt       Time   `json:"updated_at,omitempty"`
+	Label           string `json:"label,omitempty"`
+	EmailAddress    string `json:"email_address,omitempty"`
+	APIKey          string `json:"api_key,omitempty"`
+	Token           string `json:"token,omitempty"`
+	Flags           string `json:"flags,omitempty"`
+	Timezone        string `json:"timezone,omitempty"`
+	Partner         string `json:"partner,omitempty"`
+	DefaultUserID   string `json:"default_user_id,omitempty"`
+	Status          string `json:"status,omitempty"`
+	EmailConfirmed  bool   `json:"email_confirmed,omitempty"`
+	CreditCardAdded bool   `json:"credit_card_added,omitempty"`
+	Enabled         bool   `json:"enabled,omitempty"`
 }
 
 // GetOrganisation returns the organisation associated with the current account
@@ -90,6 +89,10 @@ func (c *Client) AddAccountToOrganisation(organisationID, organisationToken stri
 	if err != nil {
 		return nil, decodeError(err)
 	}
+	if c.dryRun {
+		// the canned dry-run response is an object, not the list of accounts
+		return []Account{}, nil
+	}
 
 	accounts := make([]Account, 0)
 	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&accounts); err != nil {
@@ -0,0 +1,125 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// defaultPerPage is the page size used when a paginator is created without one
+const defaultPerPage = 100
+
+// Page is a single page of a paginated list returned by the API
+type Page[T any] struct {
+	Page    int `json:"page"`
+	PerPage int `json:"per_page"`
+	Pages   int `json:"pages"`
+	Items   []T `json:"items"`
+}
+
+// NextPage returns the number of the page after this one, or 0 on the last page
+func (p *Page[T]) NextPage() int {
+	return nextPage(p.Page, p.Pages)
+}
+
+// PageFetcher fetches a single page of results, pages are numbered from 1
+type PageFetcher[T any] func(page, perPage int) (*Page[T], error)
+
+// Paginator walks the pages of a paginated list endpoint one at a time
+//
+//	p := client.ApplicationsPaginator(50)
+//	for p.HasNext() {
+//		apps, err := p.Next()
+//		...
+//	}
+type Paginator[T any] struct {
+	fetch   PageFetcher[T]
+	perPage int
+	next    int
+	done    bool
+}
+
+// NewPaginator returns a Paginator which uses fetch to retrieve perPage items at a time
+func NewPaginator[T any](perPage int, fetch PageFetcher[T]) *Paginator[T] {
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+
+	return &Paginator[T]{
+		fetch:   fetch,
+		perPage: perPage,
+		next:    1,
+	}
+}
+
+// HasNext reports whether there are pages left to fetch
+func (p *Paginator[T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page and returns its items
+func (p *Paginator[T]) Next() ([]T, error) {
+	if p.done {
+		return []T{}, nil
+	}
+
+	page, err := p.fetch(p.next, p.perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	// some endpoints ignore the paging parameters, trust what we asked for in that case
+	current := page.Page
+	if current == 0 {
+		current = p.next
+	}
+
+	p.next = current + 1
+	if current >= page.Pages || len(page.Items) == 0 {
+		p.done = true
+	}
+
+	return page.Items, nil
+}
+
+// All walks every remaining page and returns all the items
+func (p *Paginator[T]) All() ([]T, error) {
+	items := make([]T, 0)
+	for p.HasNext() {
+		page, err := p.Next()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+
+	return items, nil
+}
+
+// fetchPage requests a single page of path and decodes it
+func fetchPage[T any](c *Client, path string, page, perPage int) (*Page[T], error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	resp, err := c.SendGetRequest(fmt.Sprintf("%s%spage=%d&per_page=%d", path, separator, page, perPage))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	result := &Page[T]{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// newPathPaginator returns a Paginator over a list endpoint following the usual page/per_page convention
+func newPathPaginator[T any](c *Client, path string, perPage int) *Paginator[T] {
+	return NewPaginator(perPage, func(page, perPage int) (*Page[T], error) {
+		return fetchPage[T](c, path, page, perPage)
+	})
+}
@@ -0,0 +1,89 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPaginatorWalksAllPages(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	requested := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		requested = append(requested, page)
+		g.Expect(req.URL.Query().Get("per_page")).To(Equal("1"))
+		rw.Write([]byte(fmt.Sprintf(`{"page": %s, "per_page": 1, "pages": 3, "items": [{"id": "app-%s", "name": "app-%s"}]}`, page, page, page)))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	p := client.ApplicationsPaginator(1)
+	first, err := p.Next()
+	g.Expect(err).To(BeNil())
+	g.Expect(first).To(HaveLen(1))
+	g.Expect(first[0].ID).To(Equal("app-1"))
+	g.Expect(p.HasNext()).To(BeTrue())
+
+	rest, err := p.All()
+	g.Expect(err).To(BeNil())
+	g.Expect(rest).To(HaveLen(2))
+	g.Expect(rest[1].ID).To(Equal("app-3"))
+	g.Expect(p.HasNext()).To(BeFalse())
+	g.Expect(requested).To(Equal([]string{"1", "2", "3"}))
+}
+
+func TestListAllKubernetesClusters(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters": `{"page": 1, "per_page": 100, "pages": 1, "items": [{"id": "69a23478-a89e-41d2-97b1-6f4c341cee70", "name": "your-cluster-name"}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.ListAllKubernetesClusters()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	if len(got) != 1 || got[0].Name != "your-cluster-name" {
+		t.Errorf("Expected a single cluster called your-cluster-name, got %+v", got)
+	}
+}
+
+func TestPaginatorStopsOnError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	failure := errors.New("boom")
+	calls := 0
+	p := NewPaginator(10, func(page, perPage int) (*Page[string], error) {
+		calls++
+		if page == 2 {
+			return nil, failure
+		}
+		return &Page[string]{Page: page, PerPage: perPage, Pages: 5, Items: []string{"a"}}, nil
+	})
+
+	_, err := p.All()
+	g.Expect(err).To(MatchError(failure))
+	g.Expect(calls).To(Equal(2))
+}
+
+func TestPaginatorIgnoredPageParams(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// an endpoint that always answers with everything and no paging metadata
+	p := NewPaginator(0, func(page, perPage int) (*Page[string], error) {
+		g.Expect(perPage).To(Equal(defaultPerPage))
+		return &Page[string]{Items: []string{"a", "b"}}, nil
+	})
+
+	got, err := p.All()
+	g.Expect(err).To(BeNil())
+	g.Expect(got).To(Equal([]string{"a", "b"}))
+}
@@ -12,6 +12,36 @@ type Permission struct {
 	Description string `json:"description,omitempty"`
 }
 
+// Permission codes that can be given to roles and team members, a code ending in ".*" grants
+// every action on that resource. Use ListPermissions for the full list the API knows about
+const (
+	PermissionAll = "*.*"
+
+	PermissionInstanceAll    = "instance.*"
+	PermissionInstanceCreate = "instance.create"
+	PermissionInstanceRead   = "instance.read"
+	PermissionInstanceUpdate = "instance.update"
+	PermissionInstanceDelete = "instance.delete"
+
+	PermissionKubernetesAll    = "kubernetes.*"
+	PermissionKubernetesCreate = "kubernetes.create"
+	PermissionKubernetesRead   = "kubernetes.read"
+	PermissionKubernetesUpdate = "kubernetes.update"
+	PermissionKubernetesDelete = "kubernetes.delete"
+
+	PermissionNetworkAll      = "network.*"
+	PermissionFirewallAll     = "firewall.*"
+	PermissionLoadBalancerAll = "loadbalancer.*"
+	PermissionVolumeAll       = "volume.*"
+	PermissionIPAll           = "ip.*"
+	PermissionDNSAll          = "dns.*"
+	PermissionDatabaseAll     = "database.*"
+	PermissionObjectStoreAll  = "objectstore.*"
+	PermissionSSHKeyAll       = "sshkey.*"
+	PermissionBillingAll      = "billing.*"
+	PermissionTeamAll         = "team.*"
+)
+
 // ListPermissions returns all permissions available to be assigned to team member
 func (c *Client) ListPermissions() ([]Permission, error) {
 	resp, err := c.SendGetRequest("/v2/permissions")
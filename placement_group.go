@@ -0,0 +1,126 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// PlacementGroupPolicyAntiAffinity places every instance of the group on a different host, so a single host
+// failing takes down at most one of them
+const PlacementGroupPolicyAntiAffinity = "anti-affinity"
+
+// PlacementGroup decides which hosts the instances created in it land on, see InstanceConfig.PlacementGroupID
+type PlacementGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Policy      string   `json:"policy"`
+	Region      string   `json:"region,omitempty"`
+	InstanceIDs []string `json:"instance_ids,omitempty"`
+	CreatedAt   Time     `json:"created_at,omitempty"`
+}
+
+// Clone returns a deep copy of the placement group, sharing none of its slices
+func (g *PlacementGroup) Clone() *PlacementGroup {
+	if g == nil {
+		return nil
+	}
+
+	c := *g
+	c.InstanceIDs = cloneSlice(g.InstanceIDs)
+	return &c
+}
+
+// PlacementGroupConfig are the settings to create a placement group
+type PlacementGroupConfig struct {
+	Name string `json:"name"`
+	// Policy defaults to PlacementGroupPolicyAntiAffinity
+	Policy string `json:"policy"`
+	Region string `json:"region"`
+}
+
+// Validate checks the config has a name and a known policy.
+// The rejected fields are listed in the ValidationErrors it wraps
+func (config *PlacementGroupConfig) Validate() error {
+	var errs ValidationErrors
+	if config.Name == "" {
+		errs.add("name", config.Name, "the placement group name is empty")
+	}
+	errs.addErr("policy", config.Policy, validateOneOf("policy", config.Policy, PlacementGroupPolicyAntiAffinity))
+	return errs.wrap(PlacementGroupInvalidError)
+}
+
+// CreatePlacementGroup creates a placement group, anti-affinity unless config says otherwise
+func (c *Client) CreatePlacementGroup(config *PlacementGroupConfig) (*PlacementGroup, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Policy == "" {
+		config.Policy = PlacementGroupPolicyAntiAffinity
+	}
+	if config.Region == "" {
+		config.Region = c.Region
+	}
+
+	body, err := c.SendPostRequest("/v2/placement_groups", config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	group := &PlacementGroup{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ListPlacementGroups returns all placement groups owned by the calling API account
+func (c *Client) ListPlacementGroups() ([]PlacementGroup, error) {
+	resp, err := c.SendGetRequest("/v2/placement_groups")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	groups := make([]PlacementGroup, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&groups); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return groups, nil
+}
+
+// GetPlacementGroup returns a placement group
+func (c *Client) GetPlacementGroup(id string) (*PlacementGroup, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/placement_groups/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	group := &PlacementGroup{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(group); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return group, nil
+}
+
+// FindPlacementGroup finds a placement group by either part of the ID or part of the name
+func (c *Client) FindPlacementGroup(search string) (*PlacementGroup, error) {
+	groups, err := c.ListPlacementGroups()
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return findMatch(groups, search, false, func(g PlacementGroup) (string, []string) { return g.ID, []string{g.Name} })
+}
+
+// DeletePlacementGroup deletes a placement group, the API refuses while instances are still in it
+func (c *Client) DeletePlacementGroup(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/placement_groups/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
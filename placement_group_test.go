@@ -0,0 +1,62 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCreatePlacementGroup(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/placement_groups": `{"id": "pg-1", "name": "db-ha", "policy": "anti-affinity", "region": "LON1"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreatePlacementGroup(&PlacementGroupConfig{Name: "db-ha"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "pg-1" || got.Policy != PlacementGroupPolicyAntiAffinity {
+		t.Errorf("Unexpected placement group %+v", got)
+	}
+
+	_, err = client.CreatePlacementGroup(&PlacementGroupConfig{Name: "db-ha", Policy: "spread-ish"})
+	if !errors.Is(err, PlacementGroupInvalidError) {
+		t.Errorf("Expected PlacementGroupInvalidError, got %v", err)
+	}
+	_, err = client.CreatePlacementGroup(&PlacementGroupConfig{})
+	if !errors.Is(err, PlacementGroupInvalidError) {
+		t.Errorf("Expected PlacementGroupInvalidError, got %v", err)
+	}
+
+	err = (&PlacementGroupConfig{Policy: "spread-ish"}).Validate()
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || !reflect.DeepEqual(errs.Fields(), []string{"name", "policy"}) {
+		t.Errorf("Expected name and policy to be rejected, got %v", err)
+	}
+}
+
+func TestFindPlacementGroup(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/placement_groups": `[
+			{"id": "pg-1", "name": "db-ha", "policy": "anti-affinity", "instance_ids": ["i-1", "i-2"]},
+			{"id": "pg-2", "name": "web-ha", "policy": "anti-affinity"}
+		]`,
+	})
+	defer server.Close()
+
+	got, err := client.FindPlacementGroup("db")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "pg-1" || len(got.InstanceIDs) != 2 {
+		t.Errorf("Unexpected placement group %+v", got)
+	}
+
+	_, err = client.FindPlacementGroup("ha")
+	if !errors.Is(err, MultipleMatchesError) {
+		t.Errorf("Expected MultipleMatchesError, got %v", err)
+	}
+}
@@ -18,6 +18,122 @@ type KubernetesClusterPoolUpdateConfig struct {
 	Taints           []corev1.Taint    `json:"taints"`
 	PublicIPNodePool bool              `json:"public_ip_node_pool,omitempty"`
 	Region           string            `json:"region,omitempty"`
+	MinCount         *int              `json:"min_count,omitempty"`
+	MaxCount         *int              `json:"max_count,omitempty"`
+}
+
+// validatePoolCounts checks the node count of a pool sits within its autoscaler bounds,
+// a zero count or max is treated as unset
+func validatePoolCounts(count, minCount, maxCount int) error {
+	switch {
+	case minCount < 0:
+		err := fmt.Errorf("min count must not be negative, got %d", minCount)
+		return KubernetesPoolInvalidError.wrap(err)
+	case maxCount > 0 && minCount > maxCount:
+		err := fmt.Errorf("min count %d is greater than max count %d", minCount, maxCount)
+		return KubernetesPoolInvalidError.wrap(err)
+	case count > 0 && count < minCount:
+		err := fmt.Errorf("count %d is below the min count %d", count, minCount)
+		return KubernetesPoolInvalidError.wrap(err)
+	case count > 0 && maxCount > 0 && count > maxCount:
+		err := fmt.Errorf("count %d is above the max count %d", count, maxCount)
+		return KubernetesPoolInvalidError.wrap(err)
+	}
+
+	return nil
+}
+
+// ValidateKubernetesGPUPools checks the pools against the sizes catalogue and the features of the region of the
+// client: GPU sizes are rejected in a region that doesn't offer GPUs, and the GPUs a pool asks for must be offered
+// by its size. The rejected fields are returned as ValidationErrors wrapped in a KubernetesClusterConfigInvalidError
+func (c *Client) ValidateKubernetesGPUPools(pools []KubernetesClusterPoolConfig) error {
+	region, sizes, err := c.gpuPoolCatalogue()
+	if err != nil {
+		return err
+	}
+
+	return validateGPUPools(pools, region, sizes)
+}
+
+// gpuPoolCatalogue returns the region of the client, the default one when it has none, and the sizes catalogue
+func (c *Client) gpuPoolCatalogue() (*Region, []InstanceSize, error) {
+	regions, err := c.ListRegions()
+	if err != nil {
+		return nil, nil, err
+	}
+	sizes, err := c.ListInstanceSizes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return regionByCode(regions, c.Region), sizes, nil
+}
+
+// gpuPoolCatalogueIfAvailable is gpuPoolCatalogue for the checks made before a create, ok is false when the
+// catalogue can't be fetched so the create goes ahead and the API has the final say
+func (c *Client) gpuPoolCatalogueIfAvailable() (region *Region, sizes []InstanceSize, ok bool) {
+	region, sizes, err := c.gpuPoolCatalogue()
+	if err != nil {
+		c.debugf("request: unable to check the GPU pools, %s", err)
+		return nil, nil, false
+	}
+	return region, sizes, true
+}
+
+// regionByCode returns the region with the code, or the default region when code is empty, nil if there is none
+func regionByCode(regions []Region, code string) *Region {
+	for i := range regions {
+		if (code == "" && regions[i].Default) || (code != "" && strings.EqualFold(regions[i].Code, code)) {
+			return &regions[i]
+		}
+	}
+	return nil
+}
+
+// validateGPUPools checks every pool with validateGPUPool, prefixing the fields with the path of the pool
+func validateGPUPools(pools []KubernetesClusterPoolConfig, region *Region, sizes []InstanceSize) error {
+	var errs ValidationErrors
+	for i := range pools {
+		for _, e := range validateGPUPool(&pools[i], region, sizes) {
+			errs.add(fieldPath("pools", i, e.Field), e.Value, "pool %d: %s", i, e.Reason)
+		}
+	}
+	return errs.wrap(KubernetesClusterConfigInvalidError)
+}
+
+// validateGPUPool checks the size and GPUs of a pool against the region and the sizes catalogue, the fields are
+// the JSON names of the pool config
+func validateGPUPool(pool *KubernetesClusterPoolConfig, region *Region, sizes []InstanceSize) ValidationErrors {
+	var errs ValidationErrors
+	regionName, offersGPU := "unknown", false
+	if region != nil {
+		regionName, offersGPU = region.Code, region.Features.GPU
+	}
+
+	var size *InstanceSize
+	for i := range sizes {
+		if strings.EqualFold(sizes[i].Name, pool.Size) {
+			size = &sizes[i]
+			break
+		}
+	}
+
+	switch {
+	case size != nil && size.HasGPU() && !offersGPU:
+		errs.add("size", pool.Size, "size %s has GPUs but region %s doesn't offer them", pool.Size, regionName)
+	case !pool.RequestsGPU():
+	case !offersGPU:
+		errs.add("gpu_count", pool.GPUCount, "GPUs were asked for but region %s doesn't offer them", regionName)
+	case size == nil:
+		errs.add("size", pool.Size, "size %s is unknown, GPU pools need a GPU size", pool.Size)
+	case !size.HasGPU():
+		errs.add("size", pool.Size, "size %s has no GPUs", pool.Size)
+	case pool.GPUType != "" && !strings.EqualFold(pool.GPUType, size.GPUType):
+		errs.add("gpu_type", pool.GPUType, "size %s has %s GPUs, not %s", pool.Size, size.GPUType, pool.GPUType)
+	case pool.GPUCount > size.GPUCount:
+		errs.add("gpu_count", pool.GPUCount, "size %s has %d GPUs, %d were asked for", pool.Size, size.GPUCount, pool.GPUCount)
+	}
+	return errs
 }
 
 // ListKubernetesClusterPools returns all the pools for a kubernetes cluster
@@ -35,8 +151,20 @@ func (c *Client) ListKubernetesClusterPools(cid string) ([]KubernetesPool, error
 	return pools, nil
 }
 
-// CreateKubernetesClusterPool update a single kubernetes cluster by its full ID
+// CreateKubernetesClusterPool adds a pool to a kubernetes cluster, checking the count against MinCount and MaxCount when set
+// and, for a pool asking for GPUs, its size against the region, see ValidateKubernetesGPUPools
 func (c *Client) CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error) {
+	if err := validatePoolCounts(i.Count, i.MinCount, i.MaxCount); err != nil {
+		return nil, err
+	}
+	if i.RequestsGPU() {
+		if region, sizes, ok := c.gpuPoolCatalogueIfAvailable(); ok {
+			if err := validateGPUPool(i, region, sizes).wrap(KubernetesPoolInvalidError); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	i.Region = c.Region
 	resp, err := c.SendPostRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools", id), i)
 	if err != nil {
@@ -106,6 +234,22 @@ func (c *Client) DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*Simp
 
 // UpdateKubernetesClusterPool updates a pool for a kubernetes cluster
 func (c *Client) UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error) {
+	if config.MinCount != nil || config.MaxCount != nil {
+		count, minCount, maxCount := 0, 0, 0
+		if config.Count != nil {
+			count = *config.Count
+		}
+		if config.MinCount != nil {
+			minCount = *config.MinCount
+		}
+		if config.MaxCount != nil {
+			maxCount = *config.MaxCount
+		}
+		if err := validatePoolCounts(count, minCount, maxCount); err != nil {
+			return nil, err
+		}
+	}
+
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s/pools/%s", cid, pid), config)
 	if err != nil {
 		return nil, decodeError(err)
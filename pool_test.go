@@ -1,8 +1,12 @@
 package civogo
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -274,3 +278,207 @@ func TestDeleteKubernetesPoolInstance(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestCreateKubernetesClusterPoolAutoscaling(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","id":"8a849cc5","count":3,"size":"g4s.kube.small","taints":null,"min_count":1,"max_count":5}`,
+					URL:          "/v2/kubernetes/clusters/e733ea47/pools",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	newPool := &KubernetesPoolConfig{ID: "8a849cc5", Count: 3, Size: "g4s.kube.small", MinCount: 1, MaxCount: 5}
+	got, err := client.CreateKubernetesClusterPool("e733ea47", newPool)
+	EnsureSuccessfulSimpleResponse(t, got, err)
+
+	invalid := []*KubernetesPoolConfig{
+		{Count: 3, MinCount: 4, MaxCount: 5},
+		{Count: 6, MinCount: 1, MaxCount: 5},
+		{Count: 3, MinCount: 5, MaxCount: 1},
+	}
+	for _, pool := range invalid {
+		if _, err := client.CreateKubernetesClusterPool("e733ea47", pool); !errors.Is(err, KubernetesPoolInvalidError) {
+			t.Errorf("Expected KubernetesPoolInvalidError for %+v, got %v", pool, err)
+		}
+	}
+}
+
+func TestEnablePoolAutoscaling(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"taints":null,"region":"TEST","min_count":1,"max_count":5}`,
+					URL:          "/v2/kubernetes/clusters/e733ea47/pools/fad8638d",
+					ResponseBody: `{"id": "fad8638d", "count": 2, "min_count": 1, "max_count": 5}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.EnablePoolAutoscaling("e733ea47", "fad8638d", 1, 5)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &KubernetesPool{ID: "fad8638d", Count: 2, MinCount: 1, MaxCount: 5}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.EnablePoolAutoscaling("e733ea47", "fad8638d", 1, 0); !errors.Is(err, KubernetesPoolInvalidError) {
+		t.Errorf("Expected a KubernetesPoolInvalidError, got %v", err)
+	}
+	if _, err := client.EnablePoolAutoscaling("e733ea47", "fad8638d", 6, 5); !errors.Is(err, KubernetesPoolInvalidError) {
+		t.Errorf("Expected a KubernetesPoolInvalidError for min above max, got %v", err)
+	}
+}
+
+func TestGetClusterScalingEvents(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/e733ea47/scaling_events": `[{
+			"id": "1",
+			"cluster_id": "e733ea47",
+			"pool_id": "fad8638d",
+			"action": "scale_up",
+			"from_count": 2,
+			"to_count": 3,
+			"reason": "pending pods",
+			"created_at": "2024-01-02T03:04:05Z"
+		}]`,
+	})
+	defer server.Close()
+
+	got, err := client.GetClusterScalingEvents("e733ea47")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []KubernetesScalingEvent{{
+		ID:        "1",
+		ClusterID: "e733ea47",
+		PoolID:    "fad8638d",
+		Action:    KubernetesScalingActionScaleUp,
+		FromCount: 2,
+		ToCount:   3,
+		Reason:    "pending pods",
+		CreatedAt: NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestValidateKubernetesGPUPools(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/regions": `[{"code":"LON1","name":"London 1","default":true,"features":{"kubernetes":true,"gpu":false}},{"code":"FRA1","name":"Frankfurt 1","features":{"kubernetes":true,"gpu":true}}]`,
+		"/v2/sizes": `[
+			{"type": "Kubernetes", "name": "g4s.kube.small", "cpu_cores": 1, "selectable": true},
+			{"type": "Kubernetes", "name": "an1.kube.a100.x2", "cpu_cores": 24, "gpu_count": 2, "gpu_type": "A100", "selectable": true}
+		]`,
+	})
+	defer server.Close()
+
+	pools := []KubernetesClusterPoolConfig{
+		{Count: 3, Size: "g4s.kube.small"},
+		{Count: 1, Size: "an1.kube.a100.x2", GPUType: "A100", GPUCount: 2},
+	}
+	if err := client.WithRegion("FRA1").ValidateKubernetesGPUPools(pools); err != nil {
+		t.Errorf("Expected the pools to be valid in FRA1, got %s", err)
+	}
+
+	err := client.WithRegion("LON1").ValidateKubernetesGPUPools(pools)
+	if !errors.Is(err, KubernetesClusterConfigInvalidError) {
+		t.Fatalf("Expected a KubernetesClusterConfigInvalidError, got %v", err)
+	}
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || !reflect.DeepEqual(errs.Fields(), []string{"pools[1].size"}) {
+		t.Errorf("Expected pools[1].size to be rejected, got %v", err)
+	}
+
+	pools = []KubernetesClusterPoolConfig{
+		{Count: 1, Size: "g4s.kube.small", GPUCount: 1},
+		{Count: 1, Size: "an1.kube.a100.x2", GPUType: "L40S"},
+		{Count: 1, Size: "an1.kube.a100.x2", GPUCount: 4},
+	}
+	err = client.WithRegion("FRA1").ValidateKubernetesGPUPools(pools)
+	errs = nil
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ValidationErrors, got %v", err)
+	}
+	expected := []string{"pools[0].size", "pools[1].gpu_type", "pools[2].gpu_count"}
+	if !reflect.DeepEqual(errs.Fields(), expected) {
+		t.Errorf("Expected %v to be rejected, got %v", expected, errs.Fields())
+	}
+}
+
+func TestCreateKubernetesClusterPoolRejectsGPUsInRegionWithoutThem(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/regions": `[{"code":"LON1","name":"London 1","default":true,"features":{"kubernetes":true,"gpu":false}}]`,
+		"/v2/sizes":   `[{"type": "Kubernetes", "name": "an1.kube.a100.x2", "gpu_count": 2, "gpu_type": "A100", "selectable": true}]`,
+		"/v2/kubernetes/clusters/e733ea47-cc80-443b-b3f2-cccfe7a61ef5/pools": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	_, err := client.WithRegion("LON1").CreateKubernetesClusterPool("e733ea47-cc80-443b-b3f2-cccfe7a61ef5", &KubernetesClusterPoolConfig{
+		Count:    1,
+		Size:     "an1.kube.a100.x2",
+		GPUType:  "A100",
+		GPUCount: 2,
+	})
+	if !errors.Is(err, KubernetesPoolInvalidError) {
+		t.Fatalf("Expected a KubernetesPoolInvalidError, got %v", err)
+	}
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || !reflect.DeepEqual(errs.Fields(), []string{"size"}) {
+		t.Errorf("Expected the size to be rejected, got %v", err)
+	}
+}
+
+func TestValidateKubernetesGPUPoolsRejectsGPUSizeByName(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/regions": `[{"code":"LON1","name":"London 1","default":true,"features":{"kubernetes":true,"gpu":false}}]`,
+		"/v2/sizes":   `[{"type": "Kubernetes", "name": "an1.kube.a100.x2", "gpu_count": 2, "gpu_type": "A100", "selectable": true}]`,
+	})
+	defer server.Close()
+
+	err := client.WithRegion("LON1").ValidateKubernetesGPUPools([]KubernetesClusterPoolConfig{{Count: 1, Size: "an1.kube.a100.x2"}})
+	if !errors.Is(err, KubernetesClusterConfigInvalidError) {
+		t.Fatalf("Expected a KubernetesClusterConfigInvalidError, got %v", err)
+	}
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || !reflect.DeepEqual(errs.Fields(), []string{"pools[0].size"}) {
+		t.Errorf("Expected pools[0].size to be rejected, got %v", err)
+	}
+}
+
+func TestCreateKubernetesClusterPoolGPUCatalogueUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.Write([]byte(`{"result": "success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.CreateKubernetesClusterPool("e733ea47", &KubernetesClusterPoolConfig{Count: 1, Size: "g4g.kube.small", GPUCount: 1})
+	if err != nil {
+		t.Fatalf("Expected the pool to be created without the catalogue, got %v", err)
+	}
+	if got.Result != "success" {
+		t.Errorf("Expected success, got %+v", got)
+	}
+}
@@ -0,0 +1,166 @@
+// Package prometheus exposes the API usage of civogo clients as Prometheus metrics, without depending
+// on the Prometheus client library:
+//
+//	collector := prometheus.NewCollector()
+//	client, err := civogo.NewClient(apiKey, region, civogo.WithMetricsCollector(collector))
+//	http.Handle("/metrics", collector)
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/civo/civogo"
+)
+
+// The names of the metrics the collector exposes
+const (
+	RequestsTotalName   = "civo_api_requests_total"
+	ErrorsTotalName     = "civo_api_request_errors_total"
+	RequestDurationName = "civo_api_request_duration_seconds"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the request duration histogram
+var DefaultBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// Collector counts the requests of the clients it is given to, by method, endpoint and status, and
+// serves them in the Prometheus text format
+type Collector struct {
+	buckets []float64
+
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	errors    map[requestKey]uint64
+	durations map[endpointKey]*histogram
+}
+
+// requestKey are the labels of the request and error counters
+type requestKey struct {
+	method, endpoint, status string
+}
+
+// endpointKey are the labels of the duration histogram
+type endpointKey struct {
+	method, endpoint string
+}
+
+// histogram is a cumulative histogram of request durations
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewCollector returns a Collector whose duration histogram uses buckets, DefaultBuckets if none are given
+func NewCollector(buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+
+	return &Collector{
+		buckets:   sorted,
+		requests:  map[requestKey]uint64{},
+		errors:    map[requestKey]uint64{},
+		durations: map[endpointKey]*histogram{},
+	}
+}
+
+// ObserveRequest records a request, it implements civogo.MetricsCollector
+func (c *Collector) ObserveRequest(m civogo.RequestMetrics) {
+	status := "error"
+	if m.StatusCode != 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	key := requestKey{method: m.Method, endpoint: m.Endpoint, status: status}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests[key]++
+	if m.Failed() {
+		c.errors[key]++
+	}
+
+	h, ok := c.durations[endpointKey{method: m.Method, endpoint: m.Endpoint}]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(c.buckets))}
+		c.durations[endpointKey{method: m.Method, endpoint: m.Endpoint}] = h
+	}
+	seconds := m.Duration.Seconds()
+	for i, bound := range c.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WriteTo(w)
+}
+
+// WriteTo writes the metrics to w in the Prometheus text format
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var b strings.Builder
+
+	writeCounter(&b, RequestsTotalName, "Requests sent to the Civo API.", c.requests)
+	writeCounter(&b, ErrorsTotalName, "Requests to the Civo API which failed or got an error status.", c.errors)
+
+	fmt.Fprintf(&b, "# HELP %s Time taken by the requests to the Civo API.\n", RequestDurationName)
+	fmt.Fprintf(&b, "# TYPE %s histogram\n", RequestDurationName)
+	keys := make([]endpointKey, 0, len(c.durations))
+	for key := range c.durations {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].endpoint+" "+keys[i].method < keys[j].endpoint+" "+keys[j].method
+	})
+	for _, key := range keys {
+		h := c.durations[key]
+		labels := fmt.Sprintf(`method="%s",endpoint="%s"`, escape(key.method), escape(key.endpoint))
+		for i, bound := range c.buckets {
+			fmt.Fprintf(&b, "%s_bucket{%s,le=\"%s\"} %d\n", RequestDurationName, labels, strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+		}
+		fmt.Fprintf(&b, "%s_bucket{%s,le=\"+Inf\"} %d\n", RequestDurationName, labels, h.count)
+		fmt.Fprintf(&b, "%s_sum{%s} %s\n", RequestDurationName, labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "%s_count{%s} %d\n", RequestDurationName, labels, h.count)
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// writeCounter writes a counter with one series per key, in a stable order
+func writeCounter(b *strings.Builder, name, help string, values map[requestKey]uint64) {
+	fmt.Fprintf(b, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(b, "# TYPE %s counter\n", name)
+
+	keys := make([]requestKey, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].endpoint+" "+keys[i].method+" "+keys[i].status < keys[j].endpoint+" "+keys[j].method+" "+keys[j].status
+	})
+	for _, key := range keys {
+		fmt.Fprintf(b, "%s{method=\"%s\",endpoint=\"%s\",status=\"%s\"} %d\n", name, escape(key.method), escape(key.endpoint), escape(key.status), values[key])
+	}
+}
+
+// escape escapes a label value as the text format requires
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,41 @@
+package prometheus
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+)
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector(0.1, 1)
+	collector.ObserveRequest(civogo.RequestMetrics{Method: "GET", Endpoint: "/v2/instances", StatusCode: 200, Duration: 50 * time.Millisecond})
+	collector.ObserveRequest(civogo.RequestMetrics{Method: "GET", Endpoint: "/v2/instances", StatusCode: 500, Duration: 500 * time.Millisecond})
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body := recorder.Body.String()
+
+	expected := []string{
+		`# TYPE civo_api_requests_total counter`,
+		`civo_api_requests_total{method="GET",endpoint="/v2/instances",status="200"} 1`,
+		`civo_api_requests_total{method="GET",endpoint="/v2/instances",status="500"} 1`,
+		`civo_api_request_errors_total{method="GET",endpoint="/v2/instances",status="500"} 1`,
+		`# TYPE civo_api_request_duration_seconds histogram`,
+		`civo_api_request_duration_seconds_bucket{method="GET",endpoint="/v2/instances",le="0.1"} 1`,
+		`civo_api_request_duration_seconds_bucket{method="GET",endpoint="/v2/instances",le="1"} 2`,
+		`civo_api_request_duration_seconds_bucket{method="GET",endpoint="/v2/instances",le="+Inf"} 2`,
+		`civo_api_request_duration_seconds_sum{method="GET",endpoint="/v2/instances"} 0.55`,
+		`civo_api_request_duration_seconds_count{method="GET",endpoint="/v2/instances"} 2`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("Expected the metrics to contain %q, got:\n%s", line, body)
+		}
+	}
+	if strings.Contains(body, `civo_api_request_errors_total{method="GET",endpoint="/v2/instances",status="200"}`) {
+		t.Errorf("Expected no error for the successful request, got:\n%s", body)
+	}
+}
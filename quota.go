@@ -3,6 +3,8 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 )
 
 // Quota represents the available limits and usage for an account's Civo quota
@@ -64,3 +66,99 @@ func (c *Client) GetQuota() (*Quota, error) {
 
 	return &quota, nil
 }
+
+// InstanceHeadroom returns how many more instances can be created
+func (q *Quota) InstanceHeadroom() int {
+	return headroom(q.InstanceCountLimit, q.InstanceCountUsage)
+}
+
+// CPUCoreHeadroom returns how many more CPU cores can be used by instances
+func (q *Quota) CPUCoreHeadroom() int {
+	return headroom(q.CPUCoreLimit, q.CPUCoreUsage)
+}
+
+// RAMMegabytesHeadroom returns how much more RAM, in megabytes, can be used by instances
+func (q *Quota) RAMMegabytesHeadroom() int {
+	return headroom(q.RAMMegabytesLimit, q.RAMMegabytesUsage)
+}
+
+// DiskGigabytesHeadroom returns how much more disk space, in gigabytes, can be used
+func (q *Quota) DiskGigabytesHeadroom() int {
+	return headroom(q.DiskGigabytesLimit, q.DiskGigabytesUsage)
+}
+
+// DiskVolumeHeadroom returns how many more volumes can be created
+func (q *Quota) DiskVolumeHeadroom() int {
+	return headroom(q.DiskVolumeCountLimit, q.DiskVolumeCountUsage)
+}
+
+// PublicIPAddressHeadroom returns how many more public IP addresses can be used
+func (q *Quota) PublicIPAddressHeadroom() int {
+	return headroom(q.PublicIPAddressLimit, q.PublicIPAddressUsage)
+}
+
+// NetworkHeadroom returns how many more networks can be created
+func (q *Quota) NetworkHeadroom() int {
+	return headroom(q.NetworkCountLimit, q.NetworkCountUsage)
+}
+
+// LoadBalancerHeadroom returns how many more load balancers can be created
+func (q *Quota) LoadBalancerHeadroom() int {
+	return headroom(q.LoadBalancerCountLimit, q.LoadBalancerCountUsage)
+}
+
+// CheckQuotaFor returns a QuotaLimitReachedError listing every limit that creating the
+// instances described by config would exceed, or nil if they fit in the current quota
+func (c *Client) CheckQuotaFor(config *InstanceConfig) error {
+	quota, err := c.GetQuota()
+	if err != nil {
+		return err
+	}
+
+	size, err := c.FindInstanceSizes(config.Size)
+	if err != nil {
+		return err
+	}
+
+	return quota.checkInstances(size, config)
+}
+
+// checkInstances returns a QuotaLimitReachedError if the instances described by config don't fit in the quota
+func (q *Quota) checkInstances(size *InstanceSize, config *InstanceConfig) error {
+	count := config.Count
+	if count < 1 {
+		count = 1
+	}
+
+	publicIPs := 0
+	if config.PublicIPRequired != "none" && !strings.HasPrefix(config.PublicIPRequired, "move_ip_from") {
+		publicIPs = count
+	}
+
+	exceeded := []string{}
+	check := func(resource string, needed, available int) {
+		if needed > available {
+			exceeded = append(exceeded, fmt.Sprintf("%s (needs %d, %d left)", resource, needed, available))
+		}
+	}
+	check("instances", count, q.InstanceHeadroom())
+	check("CPU cores", count*size.CPUCores, q.CPUCoreHeadroom())
+	check("RAM megabytes", count*size.RAMMegabytes, q.RAMMegabytesHeadroom())
+	check("disk gigabytes", count*size.DiskGigabytes, q.DiskGigabytesHeadroom())
+	check("public IP addresses", publicIPs, q.PublicIPAddressHeadroom())
+
+	if len(exceeded) > 0 {
+		err := fmt.Errorf("creating %d %s instance(s) would exceed the quota for %s", count, size.Name, strings.Join(exceeded, ", "))
+		return QuotaLimitReachedError.wrap(err)
+	}
+
+	return nil
+}
+
+// headroom returns what is left of limit after usage, never less than zero
+func headroom(limit, usage int) int {
+	if usage >= limit {
+		return 0
+	}
+	return limit - usage
+}
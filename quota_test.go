@@ -1,6 +1,8 @@
 package civogo
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -181,3 +183,57 @@ func TestGetQuota(t *testing.T) {
 		t.Errorf("Expected %d, got %d", 0, got.DatabaseDiskGigabytesUsage)
 	}
 }
+
+func TestQuotaHeadroom(t *testing.T) {
+	quota := Quota{
+		InstanceCountLimit: 16,
+		InstanceCountUsage: 6,
+		CPUCoreLimit:       10,
+		CPUCoreUsage:       12,
+		RAMMegabytesLimit:  5120,
+		RAMMegabytesUsage:  1536,
+	}
+
+	if got := quota.InstanceHeadroom(); got != 10 {
+		t.Errorf("Expected 10 instances of headroom, got %d", got)
+	}
+	if got := quota.CPUCoreHeadroom(); got != 0 {
+		t.Errorf("Expected no CPU headroom when usage is over the limit, got %d", got)
+	}
+	if got := quota.RAMMegabytesHeadroom(); got != 3584 {
+		t.Errorf("Expected 3584MB of RAM headroom, got %d", got)
+	}
+}
+
+func TestCheckQuotaFor(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/quota": `{
+			"instance_count_limit": 16,
+			"instance_count_usage": 6,
+			"cpu_core_limit": 10,
+			"cpu_core_usage": 3,
+			"ram_mb_limit": 5120,
+			"ram_mb_usage": 1536,
+			"disk_gb_limit": 250,
+			"disk_gb_usage": 75,
+			"public_ip_address_limit": 16,
+			"public_ip_address_usage": 15
+		}`,
+		"/v2/sizes": `[{"name": "g3.small", "cpu_cores": 1, "ram_mb": 2048, "disk_gb": 25}]`,
+	})
+	defer server.Close()
+
+	if err := client.CheckQuotaFor(&InstanceConfig{Size: "g3.small", Count: 1}); err != nil {
+		t.Errorf("Expected one instance to fit in the quota, got %s", err)
+	}
+
+	if err := client.CheckQuotaFor(&InstanceConfig{Size: "g3.small", Count: 2, PublicIPRequired: "none"}); err == nil {
+		t.Errorf("Expected two instances to exceed the RAM quota")
+	} else if !errors.Is(err, QuotaLimitReachedError) || !strings.Contains(err.Error(), "RAM megabytes") || strings.Contains(err.Error(), "public IP") {
+		t.Errorf("Unexpected error %s", err)
+	}
+
+	if err := client.CheckQuotaFor(&InstanceConfig{Size: "g3.small", Count: 2, PublicIPRequired: "create"}); err == nil || !strings.Contains(err.Error(), "public IP addresses (needs 2, 1 left)") {
+		t.Errorf("Expected the public IP quota to be exceeded, got %v", err)
+	}
+}
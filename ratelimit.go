@@ -0,0 +1,69 @@
+package civogo
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimit describes the API rate limit as reported by the X-RateLimit-* headers of the last response
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int `json:"limit"`
+
+	// Remaining is the number of requests left in the current window
+	Remaining int `json:"remaining"`
+
+	// Reset is when the current window ends and Remaining goes back to Limit
+	Reset time.Time `json:"reset"`
+}
+
+// rateLimitState holds the last seen RateLimit, shared by every copy of a Client
+type rateLimitState struct {
+	mu   sync.RWMutex
+	last RateLimit
+}
+
+// LastRateLimit returns the rate limit reported by the most recent API response, or a
+// zero RateLimit if the API hasn't reported one yet
+func (c *Client) LastRateLimit() RateLimit {
+	if c.rateLimit == nil {
+		return RateLimit{}
+	}
+
+	c.rateLimit.mu.RLock()
+	defer c.rateLimit.mu.RUnlock()
+	return c.rateLimit.last
+}
+
+// recordRateLimit stores the rate limit headers of resp, if there are any
+func (c *Client) recordRateLimit(resp *http.Response) {
+	rl, ok := parseRateLimit(resp.Header)
+	if !ok || c.rateLimit == nil {
+		return
+	}
+
+	c.rateLimit.mu.Lock()
+	c.rateLimit.last = rl
+	c.rateLimit.mu.Unlock()
+}
+
+// parseRateLimit reads the X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset
+// headers, the latter being a Unix timestamp in seconds
+func parseRateLimit(h http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	rl := RateLimit{Limit: limit}
+	if remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining")); err == nil {
+		rl.Remaining = remaining
+	}
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+
+	return rl, true
+}
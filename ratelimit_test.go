@@ -0,0 +1,46 @@
+package civogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLastRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("X-RateLimit-Limit", "100")
+		rw.Header().Set("X-RateLimit-Remaining", "42")
+		rw.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		rw.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	g.Expect(client.LastRateLimit()).To(Equal(RateLimit{}))
+
+	_, err := client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+
+	rl := client.LastRateLimit()
+	g.Expect(rl.Limit).To(Equal(100))
+	g.Expect(rl.Remaining).To(Equal(42))
+	g.Expect(rl.Reset.Equal(reset)).To(BeTrue())
+
+	// copies of the client share what they learn about the rate limit
+	g.Expect(client.WithContext(context.Background()).LastRateLimit()).To(Equal(rl))
+}
+
+func TestParseRateLimitWithoutHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, ok := parseRateLimit(http.Header{})
+	g.Expect(ok).To(BeFalse())
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // Region represents a geographical/DC region for Civo resources
@@ -20,6 +21,16 @@ type Region struct {
 	Default       bool    `json:"default"`
 }
 
+// Clone returns a copy of the region
+func (r *Region) Clone() *Region {
+	if r == nil {
+		return nil
+	}
+
+	c := *r
+	return &c
+}
+
 // Feature represent a all feature inside a region
 type Feature struct {
 	Iaas              bool `json:"iaas"`
@@ -59,7 +70,7 @@ type ConnectRegionRequest struct {
 
 // ListRegions returns all load balancers owned by the calling API account
 func (c *Client) ListRegions() ([]Region, error) {
-	resp, err := c.SendGetRequest("/v2/regions")
+	resp, err := c.sendCachedGetRequest(CacheRegions, "/v2/regions")
 	if err != nil {
 		return nil, decodeError(err)
 	}
@@ -117,9 +128,9 @@ func (c *Client) GetDefaultRegion() (*Region, error) {
 		return nil, decodeError(err)
 	}
 
-	for _, region := range allregion {
-		if region.Default {
-			return &region, nil
+	for i := range allregion {
+		if allregion[i].Default {
+			return allregion[i].Clone(), nil
 		}
 	}
 
@@ -150,6 +161,31 @@ func (c *Client) ConnectRegion(r *ConnectRegionRequest) error {
 	return nil
 }
 
+// ForEachRegion calls fn concurrently for every region of the account, with a copy of the client
+// set to that region. Every region is attempted even if some fail, the returned error joins the
+// errors of the failed regions
+func (c *Client) ForEachRegion(fn func(regionClient *Client) error) error {
+	regions, err := c.ListRegions()
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, code string) {
+			defer wg.Done()
+			if err := fn(c.WithRegion(code)); err != nil {
+				errs[i] = fmt.Errorf("region %s: %w", code, err)
+			}
+		}(i, region.Code)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
 // DisconnectRegion disconnects a region to CivoAPI
 func (c *Client) DisconnectRegion(r *DisconnectRegionRequest) error {
 	_, err := c.SendPostRequest("/v2/regions/disconnect", r)
@@ -1,7 +1,12 @@
 package civogo
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+
+	. "github.com/onsi/gomega"
 )
 
 func TestListRegions(t *testing.T) {
@@ -129,3 +134,54 @@ func TestDisconnectRegion(t *testing.T) {
 		t.Errorf("Request returned an error: %s", err)
 	}
 }
+
+func TestListInstancesAllRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/regions":
+			rw.Write([]byte(`[{"code": "NYC1"}, {"code": "LON1"}, {"code": "FRA1"}]`))
+		case "/v2/instances":
+			switch region := req.URL.Query().Get("region"); region {
+			case "FRA1":
+				rw.WriteHeader(http.StatusInternalServerError)
+				rw.Write([]byte(`{"code": "unknown_error", "reason": "something went wrong"}`))
+			default:
+				rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [{"id": "` + region + `-1", "hostname": "web"}]}`))
+			}
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	instances, err := client.ListInstancesAllRegions()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("region FRA1"))
+	g.Expect(instances).To(HaveLen(2))
+	g.Expect(instances[0].ID).To(Equal("LON1-1"))
+	g.Expect(instances[0].Region).To(Equal("LON1"))
+	g.Expect(instances[1].Region).To(Equal("NYC1"))
+}
+
+func TestForEachRegion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/regions": `[{"code": "NYC1"}, {"code": "LON1"}]`,
+	})
+	defer server.Close()
+
+	var mu sync.Mutex
+	seen := []string{}
+	err := client.ForEachRegion(func(regionClient *Client) error {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, regionClient.Region)
+		return nil
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(seen).To(ConsistOf("NYC1", "LON1"))
+	g.Expect(client.Region).To(Equal("TEST"))
+}
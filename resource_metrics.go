@@ -0,0 +1,111 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// MetricsPeriod is how far back resource metrics go, the API picks the resolution of the points from it
+type MetricsPeriod string
+
+// Periods resource metrics can be fetched for
+const (
+	MetricsPeriodHour  MetricsPeriod = "1h"
+	MetricsPeriodDay   MetricsPeriod = "24h"
+	MetricsPeriodWeek  MetricsPeriod = "7d"
+	MetricsPeriodMonth MetricsPeriod = "30d"
+)
+
+// Valid reports whether p is one of the MetricsPeriod constants
+func (p MetricsPeriod) Valid() bool {
+	switch p {
+	case MetricsPeriodHour, MetricsPeriodDay, MetricsPeriodWeek, MetricsPeriodMonth:
+		return true
+	}
+	return false
+}
+
+// validate returns an MetricsPeriodInvalidError if p is not Valid
+func (p MetricsPeriod) validate() error {
+	if !p.Valid() {
+		err := fmt.Errorf("metrics period must be one of 1h, 24h, 7d or 30d, got %q", p)
+		return MetricsPeriodInvalidError.wrap(err)
+	}
+	return nil
+}
+
+// MetricPoint is one sample of a metric time series
+type MetricPoint struct {
+	Time  Time    `json:"time"`
+	Value float64 `json:"value"`
+}
+
+// MetricSeries is a metric time series, oldest point first
+type MetricSeries []MetricPoint
+
+// Latest returns the most recent point of the series, and false when it's empty
+func (s MetricSeries) Latest() (MetricPoint, bool) {
+	if len(s) == 0 {
+		return MetricPoint{}, false
+	}
+	return s[len(s)-1], true
+}
+
+// Since returns the points of the series taken at or after t
+func (s MetricSeries) Since(t time.Time) MetricSeries {
+	for i, point := range s {
+		if !point.Time.Before(t) {
+			return s[i:]
+		}
+	}
+	return MetricSeries{}
+}
+
+// ResourceMetrics are the usage time series of an instance or a Kubernetes cluster.
+// For a cluster they are the totals of its nodes, except CPU which is their average
+type ResourceMetrics struct {
+	ResourceID string        `json:"resource_id"`
+	Period     MetricsPeriod `json:"period"`
+	// CPU is the CPU usage in percent
+	CPU MetricSeries `json:"cpu"`
+	// Memory is the memory used in bytes
+	Memory MetricSeries `json:"memory"`
+	// DiskRead and DiskWrite are the disk throughput in bytes per second
+	DiskRead  MetricSeries `json:"disk_read"`
+	DiskWrite MetricSeries `json:"disk_write"`
+	// NetworkIn and NetworkOut are the public network throughput in bytes per second
+	NetworkIn  MetricSeries `json:"network_in"`
+	NetworkOut MetricSeries `json:"network_out"`
+}
+
+// GetInstanceMetrics returns the CPU, memory, disk and network usage of an instance over period
+func (c *Client) GetInstanceMetrics(instanceID string, period MetricsPeriod) (*ResourceMetrics, error) {
+	return c.getResourceMetrics(fmt.Sprintf("/v2/instances/%s/metrics", instanceID), period)
+}
+
+// GetKubernetesClusterMetrics returns the CPU, memory, disk and network usage of the nodes of a cluster over period
+func (c *Client) GetKubernetesClusterMetrics(clusterID string, period MetricsPeriod) (*ResourceMetrics, error) {
+	return c.getResourceMetrics(fmt.Sprintf("/v2/kubernetes/clusters/%s/metrics", clusterID), period)
+}
+
+// getResourceMetrics fetches the metrics at path for period
+func (c *Client) getResourceMetrics(path string, period MetricsPeriod) (*ResourceMetrics, error) {
+	if err := period.validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.SendGetRequest(path + "?" + url.Values{"period": {string(period)}}.Encode())
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	metrics := &ResourceMetrics{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(metrics); err != nil {
+		return nil, decodeError(err)
+	}
+
+	return metrics, nil
+}
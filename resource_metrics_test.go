@@ -0,0 +1,71 @@
+package civogo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetInstanceMetrics(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345/metrics": `{
+			"resource_id": "12345",
+			"period": "1h",
+			"cpu": [{"time": "2026-03-01T10:00:00Z", "value": 12.5}, {"time": "2026-03-01T10:05:00Z", "value": 80}],
+			"memory": [{"time": "2026-03-01T10:00:00Z", "value": 1073741824}],
+			"network_in": [{"time": "2026-03-01T10:00:00Z", "value": 2048}]
+		}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetInstanceMetrics("12345", MetricsPeriodHour)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &ResourceMetrics{
+		ResourceID: "12345",
+		Period:     MetricsPeriodHour,
+		CPU: MetricSeries{
+			{Time: NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)), Value: 12.5},
+			{Time: NewTime(time.Date(2026, 3, 1, 10, 5, 0, 0, time.UTC)), Value: 80},
+		},
+		Memory:    MetricSeries{{Time: NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)), Value: 1073741824}},
+		NetworkIn: MetricSeries{{Time: NewTime(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)), Value: 2048}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if latest, ok := got.CPU.Latest(); !ok || latest.Value != 80 {
+		t.Errorf("Expected the latest CPU point to be 80, got %+v", latest)
+	}
+	if since := got.CPU.Since(time.Date(2026, 3, 1, 10, 1, 0, 0, time.UTC)); len(since) != 1 || since[0].Value != 80 {
+		t.Errorf("Expected one CPU point after 10:01, got %+v", since)
+	}
+	if _, ok := got.DiskRead.Latest(); ok {
+		t.Errorf("Expected no disk read point")
+	}
+}
+
+func TestGetKubernetesClusterMetrics(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/69a23478/metrics": `{"resource_id": "69a23478", "period": "7d", "cpu": [{"time": 1772359200, "value": 40}]}`,
+	})
+	defer server.Close()
+
+	got, err := client.GetKubernetesClusterMetrics("69a23478", MetricsPeriodWeek)
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if len(got.CPU) != 1 || !got.CPU[0].Time.Equal(time.Unix(1772359200, 0)) {
+		t.Errorf("Expected one CPU point, got %+v", got.CPU)
+	}
+
+	if _, err := client.GetKubernetesClusterMetrics("69a23478", "2h"); !errors.Is(err, MetricsPeriodInvalidError) {
+		t.Errorf("Expected an MetricsPeriodInvalidError, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package civogo
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryDelay caps the backoff between two attempts, whatever the attempt number
+const maxRetryDelay = 30 * time.Second
+
+// WithRetries makes the client retry requests that fail with a 429 or a 5xx status up
+// to max times, waiting an exponentially growing, jittered delay starting at base
+// between attempts. A Retry-After header sent by the API takes precedence over the backoff.
+func WithRetries(max int, base time.Duration) ClientOption {
+	return func(c *Client) {
+		if max < 0 {
+			max = 0
+		}
+		c.maxRetries = max
+		c.retryBaseDelay = base
+	}
+}
+
+// isRetryableStatus reports whether a response with this status code is worth retrying
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// retryDelay works out how long to wait before the next attempt
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return d
+	}
+
+	// when we've been rate limited, waiting for the window to reset is the best guess
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if rl, ok := parseRateLimit(resp.Header); ok && !rl.Reset.IsZero() {
+			if d := time.Until(rl.Reset); d > 0 && d <= maxRetryDelay {
+				return d
+			}
+		}
+	}
+
+	if c.retryBaseDelay <= 0 {
+		return 0
+	}
+
+	delay := c.retryBaseDelay << uint(attempt)
+	if delay <= 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	// wait somewhere between half and all of the delay, so that clients failing
+	// together don't retry together
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: delay-seconds and an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// waitForRetry sleeps for d, returning early with the context's error if it's cancelled
+func waitForRetry(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewindRequest returns a copy of req with a fresh body, ready to be sent again
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
@@ -0,0 +1,120 @@
+package civogo
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRetriesTransientErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			rw.Write([]byte(`{"code": "unavailable"}`))
+		case 2:
+			rw.Header().Set("Retry-After", "0")
+			rw.WriteHeader(http.StatusTooManyRequests)
+			rw.Write([]byte(`{"code": "too_many_requests"}`))
+		default:
+			rw.Write([]byte(`[{"id": "12345", "account_id": "1", "name": "example.com"}]`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(3, time.Millisecond)(client)
+
+	domains, err := client.ListDNSDomains()
+	g.Expect(err).To(BeNil())
+	g.Expect(len(domains)).To(Equal(1))
+	g.Expect(attempts).To(Equal(3))
+}
+
+func TestRetriesGiveUp(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		rw.WriteHeader(http.StatusInternalServerError)
+		rw.Write([]byte(`{"status": 500}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(2, time.Millisecond)(client)
+
+	_, err := client.ListDNSDomains()
+	g.Expect(err).To(MatchError(InternalServerError))
+	g.Expect(attempts).To(Equal(3))
+}
+
+func TestRetriesDisabledByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		attempts++
+		rw.WriteHeader(http.StatusBadGateway)
+		rw.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.ListDNSDomains()
+	g.Expect(err).ToNot(BeNil())
+	g.Expect(attempts).To(Equal(1))
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		buf, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(buf))
+		if len(bodies) == 1 {
+			rw.WriteHeader(http.StatusBadGateway)
+			rw.Write([]byte(`{}`))
+			return
+		}
+		rw.Write([]byte(`{"id": "12345", "account_id": "1", "name": "example.com"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(1, time.Millisecond)(client)
+
+	_, err := client.CreateDNSDomain("example.com")
+	g.Expect(err).To(BeNil())
+	g.Expect(bodies).To(HaveLen(2))
+	g.Expect(bodies[1]).To(Equal(bodies[0]))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	d, ok := parseRetryAfter("5")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(5 * time.Second))
+
+	_, ok = parseRetryAfter("")
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = parseRetryAfter("soon")
+	g.Expect(ok).To(BeFalse())
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(Equal(time.Duration(0)))
+}
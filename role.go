@@ -3,17 +3,18 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
-	"time"
+	"fmt"
+	"strings"
 )
 
 // Role represents a set of permissions
 type Role struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name,omitempty"`
-	Permissions string    `json:"permissions,omitempty"`
-	BuiltIn     bool      `json:"built_in,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Permissions string `json:"permissions,omitempty"`
+	BuiltIn     bool   `json:"built_in,omitempty"`
+	CreatedAt   Time   `json:"created_at,omitempty"`
+	UpdatedAt   Time   `json:"updated_at,omitempty"`
 }
 
 // ListRoles returns all roles (built-in and user defined)
@@ -47,6 +48,41 @@ func (c *Client) CreateRole(name, permissions string) (*Role, error) {
 	return role, nil
 }
 
+// CreateRoleWithPermissions creates a new role granting the permission codes given, e.g.
+// PermissionInstanceRead or PermissionKubernetesAll
+func (c *Client) CreateRoleWithPermissions(name string, permissions []string) (*Role, error) {
+	if err := validateRole(name, permissions); err != nil {
+		return nil, err
+	}
+
+	return c.CreateRole(name, strings.Join(permissions, ","))
+}
+
+// PermissionList returns the permission codes granted by the role
+func (r *Role) PermissionList() []string {
+	return splitCommaList(r.Permissions)
+}
+
+// validateRole checks a role has a name and well formed permission codes
+func validateRole(name string, permissions []string) error {
+	if strings.TrimSpace(name) == "" {
+		return RoleInvalidError.wrap(fmt.Errorf("the name of the role is required"))
+	}
+	if len(permissions) == 0 {
+		return RoleInvalidError.wrap(fmt.Errorf("role %s needs at least one permission", name))
+	}
+
+	for _, permission := range permissions {
+		parts := strings.Split(permission, ".")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.ContainsAny(permission, ", ") {
+			err := fmt.Errorf("permission %q is not of the form resource.action", permission)
+			return RoleInvalidError.wrap(err)
+		}
+	}
+
+	return nil
+}
+
 // DeleteRole removes a non-built-in role from an organisation
 func (c *Client) DeleteRole(id string) (*SimpleResponse, error) {
 	resp, err := c.SendDeleteRequest("/v2/roles/" + id)
@@ -1,6 +1,8 @@
 package civogo
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -69,3 +71,37 @@ func TestDeleteRole(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+func TestCreateRoleWithPermissions(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "POST",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"name":"readers","permissions":"instance.read,kubernetes.read"}`,
+					URL:          "/v2/roles",
+					ResponseBody: `{"id":"12345","name":"readers","permissions":"instance.read,kubernetes.read"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.CreateRoleWithPermissions("readers", []string{PermissionInstanceRead, PermissionKubernetesRead})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(got.PermissionList(), []string{PermissionInstanceRead, PermissionKubernetesRead}) {
+		t.Errorf("Unexpected permissions %v", got.PermissionList())
+	}
+
+	for _, permissions := range [][]string{nil, {"instance"}, {"instance.read,dns.*"}, {".read"}} {
+		if _, err := client.CreateRoleWithPermissions("readers", permissions); !errors.Is(err, RoleInvalidError) {
+			t.Errorf("Expected RoleInvalidError for %v, got %v", permissions, err)
+		}
+	}
+	if _, err := client.CreateRoleWithPermissions(" ", []string{PermissionAll}); !errors.Is(err, RoleInvalidError) {
+		t.Errorf("Expected RoleInvalidError for a blank name, got %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package civogo
+
+import "io"
+
+// DNSClienter is the DNS part of Clienter, returned by Client.DNS so the DNS methods can be
+// found, and mocked, on their own
+type DNSClienter interface {
+	ListDNSDomains() ([]DNSDomain, error)
+	FindDNSDomain(search string) (*DNSDomain, error)
+	CreateDNSDomain(name string) (*DNSDomain, error)
+	GetDNSDomain(name string) (*DNSDomain, error)
+	UpdateDNSDomain(d *DNSDomain, name string) (*DNSDomain, error)
+	DeleteDNSDomain(d *DNSDomain) (*SimpleResponse, error)
+	SetDomainDefaultTTL(domainID string, ttl int) (*DNSDomain, error)
+	CreateDNSRecord(domainID string, r *DNSRecordConfig) (*DNSRecord, error)
+	ListDNSRecords(dnsDomainID string) ([]DNSRecord, error)
+	GetDNSRecord(domainID, domainRecordID string) (*DNSRecord, error)
+	ListDNSRecordsByType(domainID string, t DNSRecordType) ([]DNSRecord, error)
+	FindDNSRecordByTypeAndName(domainID string, t DNSRecordType, name string) (*DNSRecord, error)
+	UpdateDNSRecord(r *DNSRecord, rc *DNSRecordConfig) (*DNSRecord, error)
+	DeleteDNSRecord(r *DNSRecord) (*SimpleResponse, error)
+	CreateDNSRecords(domainID string, records []DNSRecordConfig) ([]DNSRecordResult, error)
+	DeleteDNSRecords(domainID string, ids []string) ([]DNSRecordResult, error)
+	UpdateAllRecordsTTL(domainID string, ttl int) ([]DNSRecordResult, error)
+	SetDNSRecordValues(domainID, name string, t DNSRecordType, values []string) (*DNSRecordSyncResult, error)
+	ExportDNSZone(domainID string) (string, error)
+	ImportDNSZone(domainID string, zonefile io.Reader) ([]DNSRecordResult, error)
+}
+
+// InstanceClienter is the instance part of Clienter, returned by Client.Instances
+type InstanceClienter interface {
+	ListInstances(page int, perPage int) (*PaginatedInstanceList, error)
+	ListInstancesWithOptions(opts ListOptions) (*PaginatedInstanceList, error)
+	ListAllInstances(opts ...ListOptions) ([]Instance, error)
+	ListInstancesAllRegions() ([]Instance, error)
+	ListInstancesByTag(tags ...string) ([]Instance, error)
+	FindInstance(search string) (*Instance, error)
+	GetInstance(id string) (*Instance, error)
+	NewInstanceConfig() (*InstanceConfig, error)
+	CreateInstance(config *InstanceConfig) (*Instance, error)
+	CreateInstances(template InstanceConfig, count int, namePattern string) ([]InstanceResult, error)
+	SetInstanceTags(i *Instance, tags string) (*SimpleResponse, error)
+	TagInstance(id string, tags []string) (*SimpleResponse, error)
+	UntagInstance(id string, tags []string) (*SimpleResponse, error)
+	SetInstanceUserData(instanceID string, script string) (*SimpleResponse, error)
+	GetInstanceUserData(instanceID string) (string, error)
+	UpdateInstance(i *Instance) (*SimpleResponse, error)
+	DeleteInstance(id string) (*SimpleResponse, error)
+	RebootInstance(id string) (*SimpleResponse, error)
+	HardRebootInstance(id string) (*SimpleResponse, error)
+	SoftRebootInstance(id string) (*SimpleResponse, error)
+	StopInstance(id string) (*SimpleResponse, error)
+	StartInstance(id string) (*SimpleResponse, error)
+	StopInstances(ids []string) ([]InstanceResult, error)
+	StartInstances(ids []string) ([]InstanceResult, error)
+	StopInstancesByTag(tag string) ([]InstanceResult, error)
+	GetInstanceConsoleURL(id string) (string, error)
+	GetInstanceInitialPassword(id string) (*InstanceCredentials, error)
+	ResetInstancePassword(id string) (*InstanceCredentials, error)
+	EnableRecoveryMode(id string) (*SimpleResponse, error)
+	DisableRecoveryMode(id string) (*SimpleResponse, error)
+	UpgradeInstance(id, newSize string) (*SimpleResponse, error)
+	ResizeInstance(id, newSize string) (*SimpleResponse, error)
+	MovePublicIPToInstance(id, ipAddress string) (*SimpleResponse, error)
+	SetInstanceFirewall(id, firewallID string) (*SimpleResponse, error)
+	GetInstanceMetrics(instanceID string, period MetricsPeriod) (*ResourceMetrics, error)
+}
+
+// KubernetesClienter is the Kubernetes clusters and node pools part of Clienter, returned by Client.Kubernetes
+type KubernetesClienter interface {
+	ListKubernetesClusters(opts ...ListOptions) (*PaginatedKubernetesClusters, error)
+	ListKubernetesClustersByTag(tags ...string) ([]KubernetesCluster, error)
+	FindKubernetesCluster(search string) (*KubernetesCluster, error)
+	NewKubernetesClusters(kc *KubernetesClusterConfig) (*KubernetesCluster, error)
+	GetKubernetesCluster(id string) (*KubernetesCluster, error)
+	UpdateKubernetesCluster(id string, i *KubernetesClusterConfig) (*KubernetesCluster, error)
+	ListKubernetesMarketplaceApplications() ([]KubernetesMarketplaceApplication, error)
+	InstallKubernetesApplication(clusterID, appName, plan string, config map[string]string) (*KubernetesCluster, error)
+	RemoveKubernetesApplication(clusterID, appName string) (*KubernetesCluster, error)
+	DeleteKubernetesCluster(id string) (*SimpleResponse, error)
+	RecycleKubernetesCluster(id string, hostname string) (*SimpleResponse, error)
+	RecycleKubernetesNode(clusterID, search string) (*Instance, error)
+	ListAvailableKubernetesVersions() ([]KubernetesVersion, error)
+	UpgradeKubernetesCluster(clusterID, version string) (*KubernetesCluster, error)
+	ListKubernetesClusterInstances(id string) ([]Instance, error)
+	FindKubernetesClusterInstance(clusterID, search string) (*Instance, error)
+
+	// Pools
+	GetKubernetesKubeconfig(clusterID string) (string, error)
+	MergeKubeconfig(clusterID, path string) (string, error)
+	ListKubernetesClusterPools(cid string) ([]KubernetesPool, error)
+	GetKubernetesClusterPool(cid, pid string) (*KubernetesPool, error)
+	FindKubernetesClusterPool(cid, search string) (*KubernetesPool, error)
+	DeleteKubernetesClusterPoolInstance(cid, pid, id string) (*SimpleResponse, error)
+	UpdateKubernetesClusterPool(cid, pid string, config *KubernetesClusterPoolUpdateConfig) (*KubernetesPool, error)
+	CreateKubernetesClusterPool(id string, i *KubernetesClusterPoolConfig) (*SimpleResponse, error)
+	ValidateKubernetesGPUPools(pools []KubernetesClusterPoolConfig) error
+	DeleteKubernetesClusterPool(id, poolID string) (*SimpleResponse, error)
+	EnablePoolAutoscaling(clusterID, poolID string, min, max int) (*KubernetesPool, error)
+	DisablePoolAutoscaling(clusterID, poolID string) (*KubernetesPool, error)
+	GetClusterScalingEvents(clusterID string) ([]KubernetesScalingEvent, error)
+	GetKubernetesClusterMetrics(clusterID string, period MetricsPeriod) (*ResourceMetrics, error)
+}
+
+// The sub-clients returned by DNS, Instances and Kubernetes are views of the same client restricted to the
+// methods of one service, not separate clients: they share its region, context and settings, and calling a
+// method on them is the same as calling it on the client. Depend on the view a piece of code needs so it can
+// be given either a Client or a FakeClient.
+
+// DNS returns the DNS methods of the client, e.g. client.DNS().ListDNSDomains()
+func (c *Client) DNS() DNSClienter {
+	return c
+}
+
+// Instances returns the instance methods of the client, e.g. client.Instances().GetInstance(id)
+func (c *Client) Instances() InstanceClienter {
+	return c
+}
+
+// Kubernetes returns the Kubernetes methods of the client, e.g. client.Kubernetes().ListKubernetesClusters()
+func (c *Client) Kubernetes() KubernetesClienter {
+	return c
+}
+
+// DNS returns the DNS methods of the fake client
+func (c *FakeClient) DNS() DNSClienter {
+	return c
+}
+
+// Instances returns the instance methods of the fake client, its instances are in InstanceList
+func (c *FakeClient) Instances() InstanceClienter {
+	return c
+}
+
+// Kubernetes returns the Kubernetes methods of the fake client
+func (c *FakeClient) Kubernetes() KubernetesClienter {
+	return c
+}
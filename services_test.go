@@ -0,0 +1,35 @@
+package civogo
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceClients(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/dns": `[{"id": "12345", "account_id": "1", "name": "example.com"}]`,
+	})
+	defer server.Close()
+
+	domains, err := client.DNS().ListDNSDomains()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(domains).To(HaveLen(1))
+
+	fake, _ := NewFakeClient()
+	var dns DNSClienter = fake.DNS()
+	_, err = dns.CreateDNSDomain("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fake.Domains).To(HaveLen(1))
+
+	clients := []Clienter{client, fake}
+	for _, c := range clients {
+		g.Expect(c.Instances()).ToNot(BeNil())
+	}
+	fake.InstanceList = []Instance{{ID: "12345", Hostname: "web"}}
+	instance, err := fake.Instances().GetInstance("12345")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(instance.Hostname).To(Equal("web"))
+}
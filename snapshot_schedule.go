@@ -0,0 +1,178 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SnapshotSchedule takes snapshots of volumes on a cron schedule, keeping the most recent ones
+type SnapshotSchedule struct {
+	ID              string                          `json:"id"`
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description,omitempty"`
+	CronExpression  string                          `json:"cron_expression"`
+	Paused          bool                            `json:"paused"`
+	RetentionPolicy SnapshotScheduleRetentionPolicy `json:"retention_policy"`
+	VolumeIDs       []string                        `json:"volume_ids"`
+	Status          string                          `json:"status"`
+	CreatedAt       Time                            `json:"created_at,omitempty"`
+}
+
+// SnapshotScheduleRetentionPolicy says how many snapshots a schedule keeps
+type SnapshotScheduleRetentionPolicy struct {
+	MaxSnapshots int `json:"max_snapshots"`
+}
+
+// SnapshotScheduleConfig are the settings to create a snapshot schedule
+type SnapshotScheduleConfig struct {
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description,omitempty"`
+	CronExpression  string                          `json:"cron_expression"`
+	RetentionPolicy SnapshotScheduleRetentionPolicy `json:"retention_policy"`
+	VolumeIDs       []string                        `json:"volume_ids"`
+	Region          string                          `json:"region"`
+}
+
+// cronFieldBounds are the allowed values of the minute, hour, day of month, month and day of week fields
+var cronFieldBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// cronMacros are the shorthands accepted instead of the five fields
+var cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// CreateSnapshotSchedule creates a schedule snapshotting the volumes of config, after checking
+// the cron expression and that at least one snapshot is kept
+func (c *Client) CreateSnapshotSchedule(config *SnapshotScheduleConfig) (*SnapshotSchedule, error) {
+	if err := validateSnapshotScheduleConfig(config); err != nil {
+		return nil, err
+	}
+
+	if config.Region == "" {
+		config.Region = c.Region
+	}
+
+	body, err := c.SendPostRequest("/v2/snapshots/schedules", config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result = &SnapshotSchedule{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateSnapshotScheduleConfig checks the cron expression of a new schedule, that it keeps at least
+// one snapshot and that it has volumes to snapshot
+func validateSnapshotScheduleConfig(config *SnapshotScheduleConfig) error {
+	if err := validateCronExpression(config.CronExpression); err != nil {
+		return SnapshotScheduleInvalidError.wrap(err)
+	}
+	if config.RetentionPolicy.MaxSnapshots < 1 {
+		err := fmt.Errorf("a schedule must keep at least one snapshot, got %d", config.RetentionPolicy.MaxSnapshots)
+		return SnapshotScheduleInvalidError.wrap(err)
+	}
+	if len(config.VolumeIDs) == 0 {
+		err := fmt.Errorf("a schedule needs at least one volume")
+		return SnapshotScheduleInvalidError.wrap(err)
+	}
+	return nil
+}
+
+// ListSnapshotSchedules returns all snapshot schedules owned by the calling API account
+func (c *Client) ListSnapshotSchedules() ([]SnapshotSchedule, error) {
+	resp, err := c.SendGetRequest("/v2/snapshots/schedules")
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	schedules := make([]SnapshotSchedule, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&schedules); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetSnapshotSchedule returns a snapshot schedule
+func (c *Client) GetSnapshotSchedule(id string) (*SnapshotSchedule, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/snapshots/schedules/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var schedule = &SnapshotSchedule{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// DeleteSnapshotSchedule deletes a snapshot schedule, the snapshots it took are kept
+func (c *Client) DeleteSnapshotSchedule(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/snapshots/schedules/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
+
+// validateCronExpression checks a standard five field cron expression, or one of the @ macros
+func validateCronExpression(expression string) error {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "@") {
+		for _, macro := range cronMacros {
+			if expression == macro {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown cron macro %q", expression)
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldBounds) {
+		return fmt.Errorf("cron expression %q must have %d fields, got %d", expression, len(cronFieldBounds), len(fields))
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if err := validateCronPart(part, cronFieldBounds[i][0], cronFieldBounds[i][1]); err != nil {
+				return fmt.Errorf("cron expression %q: %v", expression, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCronPart checks one of *, n, n-m, optionally followed by /step, within min and max
+func validateCronPart(part string, min, max int) error {
+	values, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		if n, err := strconv.Atoi(step); err != nil || n < 1 {
+			return fmt.Errorf("invalid step %q", step)
+		}
+	}
+	if values == "*" {
+		return nil
+	}
+
+	first, last, isRange := strings.Cut(values, "-")
+	bounds := []string{first}
+	if isRange {
+		bounds = append(bounds, last)
+	}
+	for _, b := range bounds {
+		if n, err := strconv.Atoi(b); err != nil || n < min || n > max {
+			return fmt.Errorf("%q is not between %d and %d", b, min, max)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package civogo
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCreateSnapshotSchedule(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/snapshots/schedules": `{"id": "sched-1", "name": "nightly", "cron_expression": "0 2 * * *", "retention_policy": {"max_snapshots": 7}, "volume_ids": ["12345"], "status": "active"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateSnapshotSchedule(&SnapshotScheduleConfig{
+		Name:            "nightly",
+		CronExpression:  "0 2 * * *",
+		RetentionPolicy: SnapshotScheduleRetentionPolicy{MaxSnapshots: 7},
+		VolumeIDs:       []string{"12345"},
+	})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "sched-1" || got.RetentionPolicy.MaxSnapshots != 7 {
+		t.Errorf("Unexpected schedule %+v", got)
+	}
+
+	_, err = client.CreateSnapshotSchedule(&SnapshotScheduleConfig{
+		Name:           "nightly",
+		CronExpression: "0 2 * * *",
+		VolumeIDs:      []string{"12345"},
+	})
+	if !errors.Is(err, SnapshotScheduleInvalidError) {
+		t.Errorf("Expected SnapshotScheduleInvalidError, got %v", err)
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	valid := []string{"0 2 * * *", "*/15 * * * *", "0 0-6/2 1,15 * 1-5", "@daily", "30 4 * 12 7"}
+	for _, expression := range valid {
+		if err := validateCronExpression(expression); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", expression, err)
+		}
+	}
+
+	invalid := []string{"", "0 2 * *", "60 * * * *", "0 24 * * *", "* * 0 * *", "*/0 * * * *", "@sometimes", "a b c d e"}
+	for _, expression := range invalid {
+		if err := validateCronExpression(expression); err == nil {
+			t.Errorf("Expected %q to be invalid", expression)
+		}
+	}
+}
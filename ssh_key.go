@@ -2,19 +2,32 @@ package civogo
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 )
 
 // SSHKey represents an SSH public key, uploaded to access instances
 type SSHKey struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Fingerprint string    `json:"fingerprint"`
-	PublicKey   string    `json:"public_key"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	PublicKey   string `json:"public_key"`
+	CreatedAt   Time   `json:"created_at"`
+}
+
+// Clone returns a copy of the SSH key
+func (k *SSHKey) Clone() *SSHKey {
+	if k == nil {
+		return nil
+	}
+
+	c := *k
+	return &c
 }
 
 // ListSSHKeys list all SSH key for an account
@@ -45,6 +58,22 @@ func (c *Client) NewSSHKey(name string, publicKey string) (*SimpleResponse, erro
 	return c.DecodeSimpleResponse(resp)
 }
 
+// CreateSSHKey checks publicKey is an OpenSSH public key before uploading it, and returns the new key
+// with its SHA256 fingerprint
+func (c *Client) CreateSSHKey(name string, publicKey string) (*SSHKey, error) {
+	fingerprint, err := SSHKeyFingerprintSHA256(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.NewSSHKey(name, strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSHKey{ID: resp.ID, Name: name, Fingerprint: fingerprint, PublicKey: strings.TrimSpace(publicKey)}, nil
+}
+
 // UpdateSSHKey update a SSH key record
 func (c *Client) UpdateSSHKey(name string, sshKeyID string) (*SSHKey, error) {
 	resp, err := c.SendPutRequest(fmt.Sprintf("/v2/sshkeys/%s", sshKeyID), map[string]string{
@@ -105,3 +134,58 @@ func (c *Client) DeleteSSHKey(id string) (*SimpleResponse, error) {
 
 	return c.DecodeSimpleResponse(resp)
 }
+
+// SSHKeyFingerprintSHA256 returns the fingerprint of an OpenSSH public key as ssh-keygen -l shows it,
+// e.g. SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s
+func SSHKeyFingerprintSHA256(publicKey string) (string, error) {
+	blob, err := parseSSHPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// SSHKeyFingerprintMD5 returns the legacy MD5 fingerprint of an OpenSSH public key,
+// e.g. 16:27:ac:a5:76:28:2d:36:63:1b:56:4d:eb:df:a6:48
+func SSHKeyFingerprintMD5(publicKey string) (string, error) {
+	blob, err := parseSSHPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(blob)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// parseSSHPublicKey returns the binary key of an authorized_keys style line, "type base64 [comment]",
+// checking the type inside the key matches the one in front of it
+func parseSSHPublicKey(publicKey string) ([]byte, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		err := fmt.Errorf("the public key must be in the form \"type key [comment]\"")
+		return nil, SSHKeyInvalidError.wrap(err)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		err := fmt.Errorf("the public key is not valid base64: %v", err)
+		return nil, SSHKeyInvalidError.wrap(err)
+	}
+
+	if len(blob) < 4 {
+		return nil, SSHKeyInvalidError.wrap(fmt.Errorf("the public key is too short"))
+	}
+	length := binary.BigEndian.Uint32(blob)
+	if uint32(len(blob)-4) < length || string(blob[4:4+length]) != fields[0] {
+		err := fmt.Errorf("the public key does not contain a %s key", fields[0])
+		return nil, SSHKeyInvalidError.wrap(err)
+	}
+
+	return blob, nil
+}
@@ -1,10 +1,13 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
 
+const testSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIL4XoXjIT/dBlac8XMEj57oJL6EMiUxRZ/KrjP6zjnF4 test@example"
+
 func TestNewSSHKey(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/sshkeys": `{
@@ -74,3 +77,64 @@ func TestFindSSHKey(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "unable to find missing, zero matches", err.Error())
 	}
 }
+
+func TestCreateSSHKey(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/sshkeys": `{
+		  "result": "success",
+		  "id": "730c960f-a51f-44e5-9c21-bd135d015d12"
+		}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateSSHKey("test", testSSHPublicKey+"\n")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SSHKey{
+		ID:          "730c960f-a51f-44e5-9c21-bd135d015d12",
+		Name:        "test",
+		Fingerprint: "SHA256:WawjXBQbxb6QQ/YhFdOSGwbgEZjG8Iua986c+i8MjLg",
+		PublicKey:   testSSHPublicKey,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	if _, err := client.CreateSSHKey("test", "not a key"); !errors.Is(err, SSHKeyInvalidError) {
+		t.Errorf("Expected SSHKeyInvalidError, got %v", err)
+	}
+}
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	sha, err := SSHKeyFingerprintSHA256(testSSHPublicKey)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if sha != "SHA256:WawjXBQbxb6QQ/YhFdOSGwbgEZjG8Iua986c+i8MjLg" {
+		t.Errorf("Unexpected SHA256 fingerprint %s", sha)
+	}
+
+	md5, err := SSHKeyFingerprintMD5(testSSHPublicKey)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if md5 != "62:06:9a:d1:8b:00:57:07:da:d7:94:ee:3c:ef:dd:b6" {
+		t.Errorf("Unexpected MD5 fingerprint %s", md5)
+	}
+
+	invalid := []string{
+		"",
+		"ssh-ed25519",
+		"ssh-ed25519 not-base64!",
+		"ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIL4XoXjIT/dBlac8XMEj57oJL6EMiUxRZ/KrjP6zjnF4",
+		"ssh-ed25519 AAAA",
+	}
+	for _, key := range invalid {
+		if _, err := SSHKeyFingerprintSHA256(key); !errors.Is(err, SSHKeyInvalidError) {
+			t.Errorf("Expected SSHKeyInvalidError for %q, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package civogo
+
+import "strings"
+
+// InstanceStatus is the Status of an Instance, compare it with Is or convert it, e.g.
+// civogo.InstanceStatus(instance.Status).IsTransient()
+type InstanceStatus string
+
+// Statuses an instance goes through
+const (
+	InstanceStatusBuilding  InstanceStatus = "BUILDING"
+	InstanceStatusActive    InstanceStatus = "ACTIVE"
+	InstanceStatusRebooting InstanceStatus = "REBOOTING"
+	InstanceStatusStopping  InstanceStatus = "STOPPING"
+	InstanceStatusShutoff   InstanceStatus = "SHUTOFF"
+	InstanceStatusStarting  InstanceStatus = "STARTING"
+	InstanceStatusResizing  InstanceStatus = "RESIZING"
+	InstanceStatusUpgrading InstanceStatus = "UPGRADING"
+	InstanceStatusMigrating InstanceStatus = "MIGRATING"
+	InstanceStatusRecovery  InstanceStatus = "RECOVERY"
+	InstanceStatusDeleting  InstanceStatus = "DELETING"
+	InstanceStatusError     InstanceStatus = "ERROR"
+)
+
+// Is reports whether status, as reported by the API, is s, ignoring case
+func (s InstanceStatus) Is(status string) bool {
+	return strings.EqualFold(string(s), status)
+}
+
+// IsTransient reports whether the instance is changing state and will leave s by itself
+func (s InstanceStatus) IsTransient() bool {
+	return oneOfStatus(string(s), InstanceStatusBuilding, InstanceStatusRebooting, InstanceStatusStopping, InstanceStatusStarting,
+		InstanceStatusResizing, InstanceStatusUpgrading, InstanceStatusMigrating, InstanceStatusDeleting)
+}
+
+// IsTerminal reports whether the instance has settled in s and only leaves it when asked to
+func (s InstanceStatus) IsTerminal() bool {
+	return oneOfStatus(string(s), InstanceStatusActive, InstanceStatusShutoff, InstanceStatusRecovery, InstanceStatusError)
+}
+
+// IsFailed reports whether s is an error status
+func (s InstanceStatus) IsFailed() bool {
+	return InstanceStatusError.Is(string(s))
+}
+
+// ClusterStatus is the Status of a KubernetesCluster, compare it with Is or convert it, e.g.
+// civogo.ClusterStatus(cluster.Status).IsTransient()
+type ClusterStatus string
+
+// Statuses a Kubernetes cluster goes through
+const (
+	ClusterStatusBuilding  ClusterStatus = "BUILDING"
+	ClusterStatusActive    ClusterStatus = "ACTIVE"
+	ClusterStatusUpgrading ClusterStatus = "UPGRADING"
+	ClusterStatusScaling   ClusterStatus = "SCALING"
+	ClusterStatusDeleting  ClusterStatus = "DELETING"
+	ClusterStatusError     ClusterStatus = "ERROR"
+)
+
+// Is reports whether status, as reported by the API, is s, ignoring case
+func (s ClusterStatus) Is(status string) bool {
+	return strings.EqualFold(string(s), status)
+}
+
+// IsTransient reports whether the cluster is changing state and will leave s by itself
+func (s ClusterStatus) IsTransient() bool {
+	return oneOfStatus(string(s), ClusterStatusBuilding, ClusterStatusUpgrading, ClusterStatusScaling, ClusterStatusDeleting)
+}
+
+// IsTerminal reports whether the cluster has settled in s and only leaves it when asked to
+func (s ClusterStatus) IsTerminal() bool {
+	return oneOfStatus(string(s), ClusterStatusActive, ClusterStatusError)
+}
+
+// IsFailed reports whether s is an error status
+func (s ClusterStatus) IsFailed() bool {
+	return ClusterStatusError.Is(string(s))
+}
+
+// VolumeStatus is the Status of a Volume, one of the VolumeStatus constants, which are left untyped
+// so they can still be used as strings, e.g. civogo.VolumeStatus(volume.Status).IsTransient()
+type VolumeStatus string
+
+// Is reports whether status, as reported by the API, is s, ignoring case
+func (s VolumeStatus) Is(status string) bool {
+	return strings.EqualFold(string(s), status)
+}
+
+// IsTransient reports whether the volume is changing state and will leave s by itself
+func (s VolumeStatus) IsTransient() bool {
+	return oneOfStatus(string(s), VolumeStatusCreating, VolumeStatusAttaching, VolumeStatusDetaching, VolumeStatusResizing, VolumeStatusDeleting)
+}
+
+// IsTerminal reports whether the volume has settled in s and only leaves it when asked to
+func (s VolumeStatus) IsTerminal() bool {
+	return oneOfStatus(string(s), VolumeStatusAvailable, VolumeStatusAttached)
+}
+
+// oneOfStatus reports whether status is one of statuses, ignoring case
+func oneOfStatus[S ~string](status string, statuses ...S) bool {
+	for _, s := range statuses {
+		if strings.EqualFold(status, string(s)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package civogo
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestStatuses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(InstanceStatusActive.Is("active")).To(BeTrue())
+	g.Expect(InstanceStatus("BUILDING").IsTransient()).To(BeTrue())
+	g.Expect(InstanceStatus("building").IsTerminal()).To(BeFalse())
+	g.Expect(InstanceStatus("SHUTOFF").IsTerminal()).To(BeTrue())
+	g.Expect(InstanceStatus("ERROR").IsFailed()).To(BeTrue())
+	g.Expect(InstanceStatus("SOMETHING_NEW").IsTransient()).To(BeFalse())
+	g.Expect(InstanceStatus("SOMETHING_NEW").IsTerminal()).To(BeFalse())
+
+	g.Expect(ClusterStatus("UPGRADING").IsTransient()).To(BeTrue())
+	g.Expect(ClusterStatusActive.IsTerminal()).To(BeTrue())
+
+	g.Expect(VolumeStatus(VolumeStatusAttaching).IsTransient()).To(BeTrue())
+	g.Expect(VolumeStatus("AVAILABLE").IsTerminal()).To(BeTrue())
+}
@@ -0,0 +1,233 @@
+package civogo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ResourceType is a type of resource, e.g. one SweepResources can delete
+type ResourceType string
+
+// Resource types
+const (
+	ResourceKubernetesCluster ResourceType = "kubernetes_cluster"
+	ResourceLoadBalancer      ResourceType = "load_balancer"
+	ResourceDatabase          ResourceType = "database"
+	ResourceInstance          ResourceType = "instance"
+	ResourceVolume            ResourceType = "volume"
+	ResourceObjectStore       ResourceType = "object_store"
+	ResourceDNSDomain         ResourceType = "dns_domain"
+	ResourceSSHKey            ResourceType = "ssh_key"
+	ResourceFirewall          ResourceType = "firewall"
+	ResourceNetwork           ResourceType = "network"
+	ResourceReservedIP        ResourceType = "reserved_ip"
+)
+
+// sweepOrder is the order resources are swept in, so resources go before what they depend on,
+// e.g. instances before their volumes, firewalls and networks
+var sweepOrder = []ResourceType{
+	ResourceKubernetesCluster,
+	ResourceLoadBalancer,
+	ResourceDatabase,
+	ResourceInstance,
+	ResourceVolume,
+	ResourceObjectStore,
+	ResourceDNSDomain,
+	ResourceSSHKey,
+	ResourceFirewall,
+	ResourceNetwork,
+}
+
+// SweptResource is a resource deleted by SweepResources
+type SweptResource struct {
+	Type ResourceType
+	ID   string
+	Name string
+}
+
+// sweepCandidate is a resource SweepResources may delete
+type sweepCandidate struct {
+	SweptResource
+	createdAt time.Time
+	delete    func() error
+}
+
+// SweepResources deletes the resources of the given types in the region of the client whose name starts
+// with prefix and which were created more than olderThan ago, e.g. the leftovers of acceptance test runs.
+// Resources the API gives no creation time for, such as networks and firewalls, are only swept when
+// olderThan is zero, and the default network never is. Resources are deleted before those they may
+// depend on, whichever the order of types. Every match is attempted even if some fail, the returned
+// error joins the failures, which can be retried once the deletions they waited for have completed
+func (c *Client) SweepResources(prefix string, types []ResourceType, olderThan time.Duration) ([]SweptResource, error) {
+	if prefix == "" {
+		return nil, SweepInvalidError.wrap(errors.New("refusing to sweep resources without a name prefix"))
+	}
+
+	wanted := map[ResourceType]bool{}
+	for _, t := range types {
+		if _, ok := sweepListers[t]; !ok {
+			return nil, SweepInvalidError.wrap(fmt.Errorf("unable to sweep resources of type %q", t))
+		}
+		wanted[t] = true
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	swept := []SweptResource{}
+	var errs []error
+	for _, t := range sweepOrder {
+		if !wanted[t] {
+			continue
+		}
+
+		candidates, err := sweepListers[t](c)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to list the resources of type %s: %w", t, err))
+			continue
+		}
+
+		for _, candidate := range candidates {
+			if !strings.HasPrefix(candidate.Name, prefix) {
+				continue
+			}
+			if olderThan > 0 && (candidate.createdAt.IsZero() || candidate.createdAt.After(cutoff)) {
+				continue
+			}
+
+			if err := candidate.delete(); err != nil {
+				errs = append(errs, fmt.Errorf("unable to delete %s %s (%s): %w", t, candidate.Name, candidate.ID, err))
+				continue
+			}
+			c.debugf("sweep: deleted %s %s (%s)", t, candidate.Name, candidate.ID)
+			swept = append(swept, candidate.SweptResource)
+		}
+	}
+
+	return swept, errors.Join(errs...)
+}
+
+// sweepListers list the resources of each type SweepResources can delete
+var sweepListers = map[ResourceType]func(c *Client) ([]sweepCandidate, error){
+	ResourceKubernetesCluster: func(c *Client) ([]sweepCandidate, error) {
+		clusters, err := c.ListAllKubernetesClusters()
+		return sweepCandidates(clusters, err, func(k KubernetesCluster) sweepCandidate {
+			return newSweepCandidate(ResourceKubernetesCluster, k.ID, k.Name, k.CreatedAt, func() error {
+				_, err := c.DeleteKubernetesCluster(k.ID)
+				return err
+			})
+		})
+	},
+	ResourceLoadBalancer: func(c *Client) ([]sweepCandidate, error) {
+		lbs, err := c.ListLoadBalancers()
+		return sweepCandidates(lbs, err, func(lb LoadBalancer) sweepCandidate {
+			return newSweepCandidate(ResourceLoadBalancer, lb.ID, lb.Name, Time{}, func() error {
+				_, err := c.DeleteLoadBalancer(lb.ID)
+				return err
+			})
+		})
+	},
+	ResourceDatabase: func(c *Client) ([]sweepCandidate, error) {
+		databases, err := c.ListAllDatabases()
+		return sweepCandidates(databases, err, func(d Database) sweepCandidate {
+			return newSweepCandidate(ResourceDatabase, d.ID, d.Name, Time{}, func() error {
+				_, err := c.DeleteDatabase(d.ID)
+				return err
+			})
+		})
+	},
+	ResourceInstance: func(c *Client) ([]sweepCandidate, error) {
+		instances, err := c.ListAllInstances()
+		return sweepCandidates(instances, err, func(i Instance) sweepCandidate {
+			return newSweepCandidate(ResourceInstance, i.ID, i.Hostname, i.CreatedAt, func() error {
+				_, err := c.DeleteInstance(i.ID)
+				return err
+			})
+		})
+	},
+	ResourceVolume: func(c *Client) ([]sweepCandidate, error) {
+		volumes, err := c.ListVolumes()
+		return sweepCandidates(volumes, err, func(v Volume) sweepCandidate {
+			return newSweepCandidate(ResourceVolume, v.ID, v.Name, v.CreatedAt, func() error {
+				_, err := c.DeleteVolume(v.ID)
+				return err
+			})
+		})
+	},
+	ResourceObjectStore: func(c *Client) ([]sweepCandidate, error) {
+		stores, err := newPathPaginator[ObjectStore](c, "/v2/objectstores", defaultPerPage).All()
+		return sweepCandidates(stores, err, func(s ObjectStore) sweepCandidate {
+			return newSweepCandidate(ResourceObjectStore, s.ID, s.Name, Time{}, func() error {
+				_, err := c.DeleteObjectStore(s.ID)
+				return err
+			})
+		})
+	},
+	ResourceDNSDomain: func(c *Client) ([]sweepCandidate, error) {
+		domains, err := c.ListDNSDomains()
+		return sweepCandidates(domains, err, func(d DNSDomain) sweepCandidate {
+			return newSweepCandidate(ResourceDNSDomain, d.ID, d.Name, Time{}, func() error {
+				_, err := c.DeleteDNSDomain(&d)
+				return err
+			})
+		})
+	},
+	ResourceSSHKey: func(c *Client) ([]sweepCandidate, error) {
+		keys, err := c.ListSSHKeys()
+		return sweepCandidates(keys, err, func(k SSHKey) sweepCandidate {
+			return newSweepCandidate(ResourceSSHKey, k.ID, k.Name, k.CreatedAt, func() error {
+				_, err := c.DeleteSSHKey(k.ID)
+				return err
+			})
+		})
+	},
+	ResourceFirewall: func(c *Client) ([]sweepCandidate, error) {
+		firewalls, err := c.ListFirewalls()
+		return sweepCandidates(firewalls, err, func(f Firewall) sweepCandidate {
+			return newSweepCandidate(ResourceFirewall, f.ID, f.Name, Time{}, func() error {
+				_, err := c.DeleteFirewall(f.ID)
+				return err
+			})
+		})
+	},
+	ResourceNetwork: func(c *Client) ([]sweepCandidate, error) {
+		networks, err := c.ListNetworks()
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := []sweepCandidate{}
+		for _, n := range networks {
+			if n.Default {
+				continue
+			}
+			n := n
+			candidates = append(candidates, newSweepCandidate(ResourceNetwork, n.ID, n.Label, Time{}, func() error {
+				_, err := c.DeleteNetwork(n.ID)
+				return err
+			}))
+		}
+		return candidates, nil
+	},
+}
+
+func newSweepCandidate(t ResourceType, id, name string, createdAt Time, deleteFn func() error) sweepCandidate {
+	return sweepCandidate{
+		SweptResource: SweptResource{Type: t, ID: id, Name: name},
+		createdAt:     createdAt.Time,
+		delete:        deleteFn,
+	}
+}
+
+// sweepCandidates maps the resources listed to sweep candidates, unless listing them failed
+func sweepCandidates[T any](resources []T, err error, fn func(T) sweepCandidate) ([]sweepCandidate, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]sweepCandidate, 0, len(resources))
+	for _, resource := range resources {
+		candidates = append(candidates, fn(resource))
+	}
+	return candidates, nil
+}
@@ -0,0 +1,60 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSweepResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	old := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().UTC().Format(time.RFC3339)
+
+	deleted := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			w.Write([]byte(`{"result": "success"}`))
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/instances":
+			w.Write([]byte(`{"page": 1, "pages": 1, "items": [
+				{"id": "i1", "hostname": "test-old", "created_at": "` + old + `"},
+				{"id": "i2", "hostname": "test-recent", "created_at": "` + recent + `"},
+				{"id": "i3", "hostname": "prod-old", "created_at": "` + old + `"}
+			]}`))
+		case "/v2/volumes":
+			w.Write([]byte(`[{"id": "v1", "name": "test-data", "created_at": "` + old + `"}]`))
+		case "/v2/networks":
+			w.Write([]byte(`[{"id": "n1", "label": "test-net"}, {"id": "n2", "label": "test-default", "default": true}]`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	swept, err := client.SweepResources("test-", []ResourceType{ResourceNetwork, ResourceVolume, ResourceInstance}, time.Hour)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(swept).To(Equal([]SweptResource{
+		{Type: ResourceInstance, ID: "i1", Name: "test-old"},
+		{Type: ResourceVolume, ID: "v1", Name: "test-data"},
+	}))
+	g.Expect(deleted).To(Equal([]string{"/v2/instances/i1", "/v2/volumes/v1"}))
+
+	swept, err = client.SweepResources("test-", []ResourceType{ResourceNetwork}, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(swept).To(Equal([]SweptResource{{Type: ResourceNetwork, ID: "n1", Name: "test-net"}}))
+
+	_, err = client.SweepResources("", []ResourceType{ResourceInstance}, 0)
+	g.Expect(errors.Is(err, SweepInvalidError)).To(BeTrue())
+	_, err = client.SweepResources("test-", []ResourceType{"unknown"}, 0)
+	g.Expect(errors.Is(err, SweepInvalidError)).To(BeTrue())
+}
@@ -0,0 +1,143 @@
+package civogo
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TagFilter selects the resources carrying every one of its tags. A tag is either a plain word
+// such as "prod" or a key:value pair such as "env:prod"
+type TagFilter []string
+
+// Matches reports whether tags contains every tag of the filter, an empty filter matches everything
+func (f TagFilter) Matches(tags []string) bool {
+	for _, wanted := range f {
+		if !hasTag(tags, wanted) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// apply adds the filter to the query string of path, for the endpoints filtering by tag server side
+func (f TagFilter) apply(path string) string {
+	if len(f) == 0 {
+		return path
+	}
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	return path + separator + "tags=" + url.QueryEscape(strings.Join(f, ","))
+}
+
+// ListInstancesByTag returns the instances carrying every one of tags, e.g. ListInstancesByTag("env:prod").
+// The API filters the instances, they are checked again here in case it ignored the filter
+func (c *Client) ListInstancesByTag(tags ...string) ([]Instance, error) {
+	filter := TagFilter(tags)
+	instances, err := newPathPaginator[Instance](c, filter.apply("/v2/instances"), defaultPerPage).All()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterTagged(instances, filter, func(i Instance) []string { return i.Tags }), nil
+}
+
+// ListKubernetesClustersByTag returns the Kubernetes clusters carrying every one of tags. The API filters
+// the clusters, they are checked again here in case it ignored the filter
+func (c *Client) ListKubernetesClustersByTag(tags ...string) ([]KubernetesCluster, error) {
+	filter := TagFilter(tags)
+	clusters, err := newPathPaginator[KubernetesCluster](c, filter.apply("/v2/kubernetes/clusters"), defaultPerPage).All()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterTagged(clusters, filter, func(k KubernetesCluster) []string { return k.Tags }), nil
+}
+
+// TagInstance adds tags to those the instance already has
+func (c *Client) TagInstance(id string, tags []string) (*SimpleResponse, error) {
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(addTags(instance.Tags, tags), " "))
+}
+
+// UntagInstance removes tags from the instance, tags it doesn't have are ignored
+func (c *Client) UntagInstance(id string, tags []string) (*SimpleResponse, error) {
+	instance, err := c.GetInstance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetInstanceTags(instance, strings.Join(removeTags(instance.Tags, tags), " "))
+}
+
+// filterTagged returns the items whose tags match filter
+func filterTagged[T any](items []T, filter TagFilter, tagsOf func(T) []string) []T {
+	matching := make([]T, 0, len(items))
+	for _, item := range items {
+		if filter.Matches(tagsOf(item)) {
+			matching = append(matching, item)
+		}
+	}
+
+	return matching
+}
+
+// addTags returns current with the tags it doesn't have yet appended, in order
+func addTags(current, tags []string) []string {
+	result := removeTags(current, nil)
+	for _, tag := range tags {
+		if !hasTag(result, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// removeTags returns current without tags, dropping any empty tag along the way
+func removeTags(current, tags []string) []string {
+	result := []string{}
+	for _, tag := range current {
+		if tag != "" && !hasTag(tags, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// hasTag reports whether tags contains tag
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateTags checks the tags can be sent, the API separates tags with spaces
+func validateTags(tags []string) error {
+	for _, tag := range tags {
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			err := fmt.Errorf("tag %q must be a single non-empty word", tag)
+			return TagInvalidError.wrap(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package civogo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTagFilterMatches(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(TagFilter{}.Matches(nil)).To(BeTrue())
+	g.Expect(TagFilter{"env:prod"}.Matches([]string{"web", "env:prod"})).To(BeTrue())
+	g.Expect(TagFilter{"env:prod", "web"}.Matches([]string{"env:prod"})).To(BeFalse())
+	g.Expect(TagFilter{"env:prod"}.Matches([]string{"env:production"})).To(BeFalse())
+}
+
+func TestListInstancesByTag(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.URL.Path).To(Equal("/v2/instances"))
+		g.Expect(r.URL.Query().Get("tags")).To(Equal("env:prod"))
+
+		// an API ignoring the filter returns everything, only the tagged instance must come back
+		fmt.Fprint(w, `{"page": 1, "per_page": 100, "pages": 1, "items": [
+			{"id": "1", "hostname": "web", "tags": ["web", "env:prod"]},
+			{"id": "2", "hostname": "staging", "tags": ["env:staging"]}
+		]}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	got, err := client.ListInstancesByTag("env:prod")
+	g.Expect(err).To(BeNil())
+	g.Expect(got).To(HaveLen(1))
+	g.Expect(got[0].ID).To(Equal("1"))
+}
+
+func TestTagInstance(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/instances/12345",
+					ResponseBody: `{"id": "12345", "hostname": "web", "tags": ["web", "env:prod"]}`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","tags":"web env:prod team:ops"}`,
+					URL:          "/v2/instances/12345/tags",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.TagInstance("12345", []string{"env:prod", "team:ops"})
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
+
+func TestTagInstanceInvalid(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+	_, err := client.TagInstance("12345", []string{"two words"})
+	g.Expect(err).To(MatchError(TagInvalidError))
+}
+
+func TestUntagInstance(t *testing.T) {
+	client, server, _ := NewAdvancedClientForTesting([]ConfigAdvanceClientForTesting{
+		{
+			Method: "GET",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					URL:          "/v2/instances/12345",
+					ResponseBody: `{"id": "12345", "hostname": "web", "tags": ["web", "env:prod"]}`,
+				},
+			},
+		},
+		{
+			Method: "PUT",
+			Value: []ValueAdvanceClientForTesting{
+				{
+					RequestBody:  `{"region":"TEST","tags":"web"}`,
+					URL:          "/v2/instances/12345/tags",
+					ResponseBody: `{"result": "success"}`,
+				},
+			},
+		},
+	})
+	defer server.Close()
+
+	got, err := client.UntagInstance("12345", []string{"env:prod", "missing"})
+	EnsureSuccessfulSimpleResponse(t, got, err)
+}
@@ -5,26 +5,58 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 )
 
 // Team is a named group of users (has many members)
 type Team struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	ID        string `json:"id"`
+	Name      string `json:"name,omitempty"`
+	CreatedAt Time   `json:"created_at,omitempty"`
+	UpdatedAt Time   `json:"updated_at,omitempty"`
 }
 
 // TeamMember is a link record between User and Team.
 type TeamMember struct {
-	ID          string    `json:"id"`
-	TeamID      string    `json:"team_id,omitempty"`
-	UserID      string    `json:"user_id,omitempty"`
-	Permissions string    `json:"permissions,omitempty"`
-	Roles       string    `json:"roles,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          string `json:"id"`
+	TeamID      string `json:"team_id,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	Permissions string `json:"permissions,omitempty"`
+	Roles       string `json:"roles,omitempty"`
+	CreatedAt   Time   `json:"created_at,omitempty"`
+	UpdatedAt   Time   `json:"updated_at,omitempty"`
+}
+
+// PermissionList returns the permission codes granted directly to the team member
+func (m *TeamMember) PermissionList() []string {
+	return splitCommaList(m.Permissions)
+}
+
+// RoleList returns the IDs of the roles given to the team member
+func (m *TeamMember) RoleList() []string {
+	return splitCommaList(m.Roles)
+}
+
+// HasPermission reports whether the permission code is granted directly to the team member,
+// permissions coming from its roles are not taken into account
+func (m *TeamMember) HasPermission(code string) bool {
+	for _, p := range m.PermissionList() {
+		if p == code || p == "*.*" || (strings.HasSuffix(p, ".*") && strings.HasPrefix(code, strings.TrimSuffix(p, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCommaList splits a comma separated list as used by the API for permissions and roles,
+// dropping blank entries
+func splitCommaList(list string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // ListTeams returns all teams for the current account
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -146,3 +147,30 @@ func TestRemoveTeamMember(t *testing.T) {
 		t.Errorf("Expected %s, got %s", "success", got.Result)
 	}
 }
+
+func TestTeamMemberPermissions(t *testing.T) {
+	member := TeamMember{Permissions: "instance.create, kubernetes.*,,", Roles: "role-1,role-2"}
+
+	if got := member.PermissionList(); !reflect.DeepEqual(got, []string{"instance.create", "kubernetes.*"}) {
+		t.Errorf("Unexpected permissions %v", got)
+	}
+	if got := member.RoleList(); !reflect.DeepEqual(got, []string{"role-1", "role-2"}) {
+		t.Errorf("Unexpected roles %v", got)
+	}
+
+	for code, expected := range map[string]bool{
+		"instance.create":   true,
+		"instance.delete":   false,
+		"kubernetes.delete": true,
+		"kubernetesx":       false,
+	} {
+		if got := member.HasPermission(code); got != expected {
+			t.Errorf("Expected HasPermission(%q) to be %v", code, expected)
+		}
+	}
+
+	admin := TeamMember{Permissions: "*.*"}
+	if !admin.HasPermission("dns.update") {
+		t.Errorf("Expected *.* to grant every permission")
+	}
+}
@@ -0,0 +1,230 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ResourceDependency is a resource using another one, which must be deleted first
+type ResourceDependency struct {
+	Type ResourceType
+	ID   string
+	Name string
+}
+
+// String returns the type, name and ID of the resource
+func (d ResourceDependency) String() string {
+	return fmt.Sprintf("%s %s (%s)", d.Type, d.Name, d.ID)
+}
+
+// NetworkDependencyError is returned by TeardownNetwork when resources still use the network and
+// cascade is false, errors.Is(err, NetworkInUseError) holds for it
+type NetworkDependencyError struct {
+	NetworkID    string
+	Dependencies []ResourceDependency
+}
+
+func (e *NetworkDependencyError) Error() string {
+	names := make([]string, 0, len(e.Dependencies))
+	for _, d := range e.Dependencies {
+		names = append(names, d.String())
+	}
+	return fmt.Sprintf("%s: network %s is used by %s", NetworkInUseError, e.NetworkID, strings.Join(names, ", "))
+}
+
+// Unwrap returns NetworkInUseError
+func (e *NetworkDependencyError) Unwrap() error {
+	return NetworkInUseError
+}
+
+// teardownStages are the types of the dependencies of a network, in the order TeardownNetwork deletes
+// them, waiting for each stage to be gone before the next one
+var teardownStages = [][]ResourceType{
+	{ResourceReservedIP},
+	{ResourceKubernetesCluster, ResourceLoadBalancer, ResourceDatabase, ResourceInstance},
+	{ResourceVolume},
+}
+
+// ListNetworkDependencies returns the resources using a network: its kubernetes clusters, load balancers
+// and instances other than those of the clusters, databases, volumes and the reserved IPs assigned to any of them.
+// They are ordered the way TeardownNetwork deletes them
+func (c *Client) ListNetworkDependencies(networkID string) ([]ResourceDependency, error) {
+	byType := map[ResourceType][]ResourceDependency{}
+	assigned := map[string]bool{}
+	add := func(t ResourceType, id, name string) {
+		byType[t] = append(byType[t], ResourceDependency{Type: t, ID: id, Name: name})
+		assigned[id] = true
+	}
+
+	clusters, err := c.ListAllKubernetesClusters()
+	if err != nil {
+		return nil, err
+	}
+	nodes, networkClusters := map[string]bool{}, map[string]bool{}
+	for _, cluster := range clusters {
+		if cluster.NetworkID != networkID {
+			continue
+		}
+		add(ResourceKubernetesCluster, cluster.ID, cluster.Name)
+		networkClusters[cluster.ID] = true
+		for _, node := range cluster.Instances {
+			nodes[node.ID] = true
+		}
+	}
+
+	lbs, err := c.ListLoadBalancers()
+	if err != nil {
+		return nil, err
+	}
+	for _, lb := range lbs {
+		if lb.NetworkID == networkID && !networkClusters[lb.ClusterID] {
+			add(ResourceLoadBalancer, lb.ID, lb.Name)
+		}
+	}
+
+	databases, err := c.ListAllDatabases()
+	if err != nil {
+		return nil, err
+	}
+	for _, database := range databases {
+		if database.NetworkID == networkID {
+			add(ResourceDatabase, database.ID, database.Name)
+		}
+	}
+
+	instances, err := c.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+	for _, instance := range instances {
+		if instance.NetworkID == networkID && !nodes[instance.ID] {
+			add(ResourceInstance, instance.ID, instance.Hostname)
+		}
+	}
+
+	volumes, err := c.ListVolumes()
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if volume.NetworkID == networkID {
+			add(ResourceVolume, volume.ID, volume.Name)
+		}
+	}
+
+	ips, err := c.ListAllIPs()
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range ips {
+		if ip.AssignedTo.ID != "" && (assigned[ip.AssignedTo.ID] || nodes[ip.AssignedTo.ID]) {
+			add(ResourceReservedIP, ip.ID, ip.Name)
+		}
+	}
+
+	dependencies := []ResourceDependency{}
+	for _, stage := range teardownStages {
+		for _, t := range stage {
+			dependencies = append(dependencies, byType[t]...)
+		}
+	}
+
+	return dependencies, nil
+}
+
+// TeardownNetwork deletes a network. When resources still use it, see ListNetworkDependencies, it fails
+// with a *NetworkDependencyError listing them unless cascade is true, in which case they are deleted
+// first, reserved IPs included, waiting as set by opts for each stage of deletions to complete before
+// the next. The dependencies deleted are returned
+func (c *Client) TeardownNetwork(networkID string, cascade bool, opts ...WaitOption) ([]ResourceDependency, error) {
+	dependencies, err := c.ListNetworkDependencies(networkID)
+	if err != nil {
+		return nil, err
+	}
+	if len(dependencies) > 0 && !cascade {
+		return nil, &NetworkDependencyError{NetworkID: networkID, Dependencies: dependencies}
+	}
+
+	deleted := []ResourceDependency{}
+	for _, stage := range teardownStages {
+		pending := map[string]bool{}
+		var errs []error
+		for _, d := range dependencies {
+			if !containsResourceType(stage, d.Type) {
+				continue
+			}
+			if err := c.deleteDependency(d); err != nil {
+				errs = append(errs, fmt.Errorf("unable to delete %s: %w", d, err))
+				continue
+			}
+			pending[d.ID] = true
+			deleted = append(deleted, d)
+		}
+		if err := errors.Join(errs...); err != nil {
+			return deleted, err
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		err := c.WaitFor(c.requestContext(), func(ctx context.Context) (bool, string, error) {
+			remaining, err := c.ListNetworkDependencies(networkID)
+			if err != nil {
+				return false, "", err
+			}
+
+			left := 0
+			for _, d := range remaining {
+				if pending[d.ID] {
+					left++
+				}
+			}
+			return left == 0, fmt.Sprintf("%d resources left", left), nil
+		}, opts...)
+		if err != nil {
+			return deleted, err
+		}
+	}
+
+	if _, err := c.DeleteNetwork(networkID); err != nil {
+		return deleted, err
+	}
+
+	return deleted, nil
+}
+
+// deleteDependency deletes a dependency of a network, unassigning reserved IPs first
+func (c *Client) deleteDependency(d ResourceDependency) error {
+	var err error
+	switch d.Type {
+	case ResourceReservedIP:
+		if _, err = c.UnassignIP(d.ID, c.Region); err == nil {
+			_, err = c.DeleteIP(d.ID)
+		}
+	case ResourceKubernetesCluster:
+		_, err = c.DeleteKubernetesCluster(d.ID)
+	case ResourceLoadBalancer:
+		_, err = c.DeleteLoadBalancer(d.ID)
+	case ResourceDatabase:
+		_, err = c.DeleteDatabase(d.ID)
+	case ResourceInstance:
+		_, err = c.DeleteInstance(d.ID)
+	case ResourceVolume:
+		_, err = c.DeleteVolume(d.ID)
+	default:
+		err = fmt.Errorf("unable to delete resources of type %q", d.Type)
+	}
+
+	return err
+}
+
+func containsResourceType(types []ResourceType, t ResourceType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
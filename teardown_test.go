@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTeardownNetwork(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var mu sync.Mutex
+	deleted := []string{}
+	gone := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+			gone[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = true
+			w.Write([]byte(`{"result": "success"}`))
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"result": "success"}`))
+			return
+		}
+
+		list := func(items ...string) string {
+			kept := []string{}
+			for _, item := range items {
+				id := strings.Split(strings.Split(item, `"id": "`)[1], `"`)[0]
+				if !gone[id] {
+					kept = append(kept, item)
+				}
+			}
+			return strings.Join(kept, ",")
+		}
+
+		switch r.URL.Path {
+		case "/v2/kubernetes/clusters":
+			w.Write([]byte(`{"page": 1, "pages": 1, "items": [` + list(`{"id": "k1", "name": "kube", "network_id": "net-1", "instances": [{"id": "node-1"}]}`) + `]}`))
+		case "/v2/loadbalancers":
+			w.Write([]byte(`[` + list(`{"id": "lb1", "name": "kube-lb", "network_id": "net-1", "cluster_id": "k1"}`, `{"id": "lb2", "name": "web-lb", "network_id": "net-1"}`) + `]`))
+		case "/v2/databases":
+			w.Write([]byte(`{"page": 1, "pages": 1, "items": []}`))
+		case "/v2/instances":
+			w.Write([]byte(`{"page": 1, "pages": 1, "items": [` + list(`{"id": "node-1", "hostname": "node", "network_id": "net-1"}`, `{"id": "i1", "hostname": "web", "network_id": "net-1"}`, `{"id": "i2", "hostname": "other", "network_id": "net-2"}`) + `]}`))
+		case "/v2/volumes":
+			w.Write([]byte(`[` + list(`{"id": "v1", "name": "data", "network_id": "net-1"}`) + `]`))
+		case "/v2/ips":
+			w.Write([]byte(`{"page": 1, "pages": 1, "items": [` + list(`{"id": "ip1", "name": "web-ip", "assigned_to": {"id": "i1", "type": "instance"}}`) + `]}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.TeardownNetwork("net-1", false)
+	var dependencyErr *NetworkDependencyError
+	g.Expect(errors.As(err, &dependencyErr)).To(BeTrue())
+	g.Expect(errors.Is(err, NetworkInUseError)).To(BeTrue())
+	g.Expect(dependencyErr.Dependencies).To(Equal([]ResourceDependency{
+		{Type: ResourceReservedIP, ID: "ip1", Name: "web-ip"},
+		{Type: ResourceKubernetesCluster, ID: "k1", Name: "kube"},
+		{Type: ResourceLoadBalancer, ID: "lb2", Name: "web-lb"},
+		{Type: ResourceInstance, ID: "i1", Name: "web"},
+		{Type: ResourceVolume, ID: "v1", Name: "data"},
+	}))
+	g.Expect(deleted).To(BeEmpty())
+
+	removed, err := client.TeardownNetwork("net-1", true, WithWaitInterval(time.Millisecond))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(removed).To(HaveLen(5))
+	g.Expect(deleted).To(Equal([]string{
+		"/v2/ips/ip1",
+		"/v2/kubernetes/clusters/k1",
+		"/v2/loadbalancers/lb2",
+		"/v2/instances/i1",
+		"/v2/volumes/v1",
+		"/v2/networks/net-1",
+	}))
+}
@@ -0,0 +1,84 @@
+package civogo
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Time is a timestamp returned by the API. It decodes forgivingly, an empty string or null gives the
+// zero time, a number is taken as Unix seconds and strings are tried against every layout of timeLayouts,
+// as some endpoints return timestamps in other formats than RFC 3339. It is encoded like a time.Time
+type Time struct {
+	time.Time
+}
+
+// timeLayouts are the layouts the API has been seen to return timestamps in, most common first
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05",
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02",
+}
+
+// NewTime returns t as a Time
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// UnmarshalJSON decodes a timestamp in any of the supported formats
+func (t *Time) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if data[0] != '"' {
+		seconds, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse the timestamp %s", data)
+		}
+		t.Time = time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+		return nil
+	}
+
+	value, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("unable to parse the timestamp %s: %w", data, err)
+	}
+
+	parsed, err := parseTime(value)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+
+	return nil
+}
+
+// MarshalJSON encodes the timestamp as RFC 3339, like a time.Time
+func (t Time) MarshalJSON() ([]byte, error) {
+	return t.Time.MarshalJSON()
+}
+
+// parseTime parses value with the first layout of timeLayouts it matches
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse the timestamp %q", value)
+}
@@ -0,0 +1,50 @@
+package civogo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, value := range []string{
+		`"2024-01-02T03:04:05Z"`,
+		`"2024-01-02T03:04:05"`,
+		`"2024-01-02 03:04:05 +0000"`,
+		`"2024-01-02 03:04:05 UTC"`,
+		`"2024-01-02 03:04:05"`,
+		`1704164645`,
+	} {
+		var parsed Time
+		g.Expect(json.Unmarshal([]byte(value), &parsed)).To(Succeed(), value)
+		g.Expect(parsed.Equal(expected)).To(BeTrue(), value)
+	}
+
+	for _, value := range []string{`""`, `null`} {
+		var parsed Time
+		g.Expect(json.Unmarshal([]byte(value), &parsed)).To(Succeed(), value)
+		g.Expect(parsed.IsZero()).To(BeTrue(), value)
+	}
+
+	var parsed Time
+	g.Expect(json.Unmarshal([]byte(`"yesterday"`), &parsed)).ToNot(Succeed())
+}
+
+func TestTimeInStructs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	record := DNSRecord{}
+	err := json.Unmarshal([]byte(`{"id": "1", "created_at": "", "updated_at": "2024-01-02 03:04:05 UTC"}`), &record)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(record.CreatedAt.IsZero()).To(BeTrue())
+	g.Expect(record.UpdatedAt.Year()).To(Equal(2024))
+
+	encoded, err := json.Marshal(NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(encoded)).To(Equal(`"2024-01-02T03:04:05Z"`))
+}
@@ -0,0 +1,153 @@
+package civogo
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// TracerProvider starts a span for every API call of a client configured with WithTracerProvider.
+// The package doesn't depend on OpenTelemetry, bridging it takes a few lines:
+//
+//	type otelProvider struct{ tracer trace.Tracer }
+//
+//	func (p otelProvider) Start(ctx context.Context, name string) (context.Context, civogo.Span) {
+//		ctx, span := p.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		return ctx, otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ trace.Span }
+//
+//	func (s otelSpan) SetAttributes(attributes map[string]interface{}) { ... attribute.KeyValue conversion ... }
+//	func (s otelSpan) RecordError(err error) { s.Span.RecordError(err); s.Span.SetStatus(codes.Error, err.Error()) }
+//	func (s otelSpan) End() { s.Span.End() }
+type TracerProvider interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is an API call being traced
+type Span interface {
+	SetAttributes(attributes map[string]interface{})
+	RecordError(err error)
+	End()
+}
+
+// The attributes set on the span of an API call
+const (
+	TraceAttributeRegion     = "civo.region"
+	TraceAttributeResourceID = "civo.resource_id"
+	TraceAttributeRetryCount = "civo.retry_count"
+	TraceAttributeMethod     = "http.method"
+	TraceAttributePath       = "http.path"
+	TraceAttributeStatusCode = "http.status_code"
+)
+
+// WithTracerProvider makes the client trace every API call with a span named after the operation,
+// e.g. civo.dns.CreateDNSRecord, carrying the region, the resource ID, the status code and the retry count
+func WithTracerProvider(provider TracerProvider) ClientOption {
+	return func(c *Client) {
+		c.tracerProvider = provider
+	}
+}
+
+// apiSpan is the span of an API call, a nil one is a call not being traced
+type apiSpan struct {
+	ctx  context.Context
+	span Span
+}
+
+// startSpan starts the span of req if the client is traced
+func (c *Client) startSpan(req *http.Request) *apiSpan {
+	if c.tracerProvider == nil {
+		return nil
+	}
+
+	resource, resourceID := traceResource(req.URL.Path)
+	ctx, span := c.tracerProvider.Start(req.Context(), "civo."+resource+"."+traceOperation(req.Method))
+
+	attributes := map[string]interface{}{
+		TraceAttributeRegion: c.Region,
+		TraceAttributeMethod: req.Method,
+		TraceAttributePath:   req.URL.Path,
+	}
+	if resourceID != "" {
+		attributes[TraceAttributeResourceID] = resourceID
+	}
+	span.SetAttributes(attributes)
+
+	return &apiSpan{ctx: ctx, span: span}
+}
+
+// end records the outcome of the call and ends the span
+func (s *apiSpan) end(status, retries int, err error) {
+	if s == nil {
+		return
+	}
+
+	attributes := map[string]interface{}{TraceAttributeRetryCount: retries}
+	if status != 0 {
+		attributes[TraceAttributeStatusCode] = status
+	}
+	s.span.SetAttributes(attributes)
+	if err != nil {
+		s.span.RecordError(err)
+	}
+	s.span.End()
+}
+
+// traceResource returns the kind of resource of an API path and the ID of the resource, if any,
+// e.g. "dns" and "1234" for /v2/dns/1234/records
+func traceResource(path string) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 0 && segments[0] == "v2" {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 || segments[0] == "" {
+		return "api", ""
+	}
+
+	for _, segment := range segments[1:] {
+		if strings.IndexFunc(segment, unicode.IsDigit) >= 0 {
+			return segments[0], segment
+		}
+	}
+
+	return segments[0], ""
+}
+
+// traceOperation returns the name of the exported Client method making the request, found on the stack,
+// or the HTTP method when there is none
+func traceOperation(method string) string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name, ok := clientMethodName(frame.Function); ok {
+			return name
+		}
+		if !more {
+			return method
+		}
+	}
+}
+
+// clientMethodName returns the name of the exported Client method of function, leaving out the
+// Send*Request helpers every call goes through
+func clientMethodName(function string) (string, bool) {
+	const prefix = "github.com/civo/civogo.(*Client)."
+	if !strings.HasPrefix(function, prefix) {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(function, prefix)
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" || !unicode.IsUpper(rune(name[0])) || strings.HasPrefix(name, "Send") {
+		return "", false
+	}
+
+	return name, true
+}
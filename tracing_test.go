@@ -0,0 +1,90 @@
+package civogo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type recordedSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	span := &recordedSpan{name: name, attributes: map[string]interface{}{}}
+	r.spans = append(r.spans, span)
+	return ctx, span
+}
+
+func (s *recordedSpan) SetAttributes(attributes map[string]interface{}) {
+	for k, v := range attributes {
+		s.attributes[k] = v
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) { s.err = err }
+func (s *recordedSpan) End()                  { s.ended = true }
+
+func TestTracerProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": "76cc107f", "domain_id": "edc5dacf-1111", "name": "www", "type": "A", "value": "10.0.0.1", "ttl": 600}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client, _ := NewClientForTestingWithServer(server)
+	WithRetries(1, time.Millisecond)(client)
+	WithTracerProvider(tracer)(client)
+
+	_, err := client.CreateDNSRecord("edc5dacf-1111", &DNSRecordConfig{Type: DNSRecordTypeA, Name: "www", Value: "10.0.0.1", TTL: 600})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(tracer.spans).To(HaveLen(1))
+	span := tracer.spans[0]
+	g.Expect(span.name).To(Equal("civo.dns.CreateDNSRecord"))
+	g.Expect(span.ended).To(BeTrue())
+	g.Expect(span.err).To(BeNil())
+	g.Expect(span.attributes).To(HaveKeyWithValue(TraceAttributeRegion, "TEST"))
+	g.Expect(span.attributes).To(HaveKeyWithValue(TraceAttributeResourceID, "edc5dacf-1111"))
+	g.Expect(span.attributes).To(HaveKeyWithValue(TraceAttributeStatusCode, http.StatusOK))
+	g.Expect(span.attributes).To(HaveKeyWithValue(TraceAttributeRetryCount, 1))
+}
+
+func TestTraceResource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := map[string][2]string{
+		"/v2/dns":                             {"dns", ""},
+		"/v2/dns/1234/records":                {"dns", "1234"},
+		"/v2/kubernetes/clusters/ab-12/pools": {"kubernetes", "ab-12"},
+		"/v2/regions":                         {"regions", ""},
+		"/":                                   {"api", ""},
+	}
+	for path, expected := range tests {
+		resource, id := traceResource(path)
+		g.Expect([2]string{resource, id}).To(Equal(expected), path)
+	}
+}
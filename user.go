@@ -3,23 +3,22 @@ package civogo
 import (
 	"bytes"
 	"encoding/json"
-	"time"
 )
 
 // User is the user struct
 type User struct {
-	ID               string    `json:"id"`
-	FirstName        string    `json:"first_name"`
-	LastName         string    `json:"last_name"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
-	CompanyName      string    `json:"company_name"`
-	EmailAddress     string    `json:"email_address"`
-	Status           string    `json:"status"`
-	Flags            string    `json:"flags"`
-	Token            string    `json:"token"`
-	MarketingAllowed int       `json:"marketing_allowed"`
-	DefaultAccountID string    `json:"default_account_id"`
+	ID               string `json:"id"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	CreatedAt        Time   `json:"created_at"`
+	UpdatedAt        Time   `json:"updated_at"`
+	CompanyName      string `json:"company_name"`
+	EmailAddress     string `json:"email_address"`
+	Status           string `json:"status"`
+	Flags            string `json:"flags"`
+	Token            string `json:"token"`
+	MarketingAllowed int    `json:"marketing_allowed"`
+	DefaultAccountID string `json:"default_account_id"`
 	// DefaultAccountID string      `json:"account_id"`
 	PasswordDigest   string `json:"password_digest"`
 	Partner          string `json:"partner"`
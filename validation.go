@@ -0,0 +1,137 @@
+package civogo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidationError says why one field of a config was rejected, either by the Validate method of the
+// config or by the API in a 422 response
+type ValidationError struct {
+	// Field is the path of the field, using the JSON names, e.g. "pools[0].size"
+	Field string `json:"field"`
+	// Value is the rejected value, nil when the API didn't say
+	Value interface{} `json:"value,omitempty"`
+	// Reason is the human readable reason the value was rejected
+	Reason string `json:"reason"`
+	// Err is the error the value was rejected with, if any, so errors.Is sees through the ValidationErrors
+	Err error `json:"-"`
+}
+
+func (e ValidationError) Error() string {
+	return e.Reason
+}
+
+// Unwrap returns the error the value was rejected with
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is every field rejected in a config, get it from the error of a Validate method or
+// of a request with errors.As, e.g. to highlight the offending fields in a form
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	reasons := make([]string, len(e))
+	for i, err := range e {
+		reasons[i] = err.Reason
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// Unwrap returns every rejected field, for errors.Is and errors.As
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Fields returns the paths of the rejected fields
+func (e ValidationErrors) Fields() []string {
+	fields := make([]string, len(e))
+	for i, err := range e {
+		fields[i] = err.Field
+	}
+	return fields
+}
+
+// add records that field was rejected with value, reason is formatted like fmt.Sprintf
+func (e *ValidationErrors) add(field string, value interface{}, reason string, args ...interface{}) {
+	if len(args) > 0 {
+		reason = fmt.Sprintf(reason, args...)
+	}
+	*e = append(*e, ValidationError{Field: field, Value: value, Reason: reason})
+}
+
+// addErr records that field was rejected with value because of err, if err isn't nil
+func (e *ValidationErrors) addErr(field string, value interface{}, err error) {
+	if err != nil {
+		*e = append(*e, ValidationError{Field: field, Value: value, Reason: err.Error(), Err: err})
+	}
+}
+
+// wrap returns the errors wrapped in kind, or nil if no field was rejected
+func (e ValidationErrors) wrap(kind constError) error {
+	if len(e) == 0 {
+		return nil
+	}
+	return kind.wrap(e)
+}
+
+// fieldPath returns the path of a field of an element of a list, e.g. fieldPath("pools", 0, "size")
+func fieldPath(list string, index int, field string) string {
+	return fmt.Sprintf("%s[%d].%s", list, index, field)
+}
+
+// parseValidationErrors reads the rejected fields of a 422 response, the API lists them either as
+// {"errors": {"field": ["reason", ...]}} or as {"errors": [{"field": "...", "value": ..., "reason": "..."}]}
+func parseValidationErrors(raw json.RawMessage) ValidationErrors {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var list []struct {
+		ValidationError
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &list); err == nil {
+		errs := make(ValidationErrors, 0, len(list))
+		for _, item := range list {
+			if item.Reason == "" {
+				item.Reason = item.Message
+			}
+			errs = append(errs, item.ValidationError)
+		}
+		return errs
+	}
+
+	var byField map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &byField); err != nil {
+		return nil
+	}
+	fields := make([]string, 0, len(byField))
+	for field := range byField {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	errs := make(ValidationErrors, 0, len(fields))
+	for _, field := range fields {
+		var reasons []string
+		if err := json.Unmarshal(byField[field], &reasons); err != nil {
+			var reason string
+			if err := json.Unmarshal(byField[field], &reason); err != nil {
+				continue
+			}
+			reasons = []string{reason}
+		}
+		for _, reason := range reasons {
+			errs = append(errs, ValidationError{Field: field, Reason: reason})
+		}
+	}
+	return errs
+}
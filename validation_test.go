@@ -0,0 +1,88 @@
+package civogo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestValidationErrorsFromValidate(t *testing.T) {
+	config := &NetworkConfig{CIDRv4: "10.0.0.0/33", NameserversV4: []string{"8.8.8.8", "dns.google"}}
+
+	err := config.Validate()
+	if !errors.Is(err, NetworkConfigInvalidError) {
+		t.Errorf("Expected a NetworkConfigInvalidError, got %v", err)
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Errorf("Expected ValidationErrors in %v", err)
+		return
+	}
+	expected := []string{"label", "cidr_v4", "nameservers_v4[1]"}
+	if !reflect.DeepEqual(errs.Fields(), expected) {
+		t.Errorf("Expected the fields %v, got %v", expected, errs.Fields())
+	}
+	if errs[2].Value != "dns.google" {
+		t.Errorf("Expected the rejected value to be kept, got %v", errs[2].Value)
+	}
+}
+
+func TestValidationErrorsMessage(t *testing.T) {
+	err := (&KubernetesClusterConfig{Name: "production", Pools: []KubernetesClusterPoolConfig{{Count: 1}}}).Validate()
+	if err == nil || err.Error() != "KubernetesClusterConfigInvalid: pool 0 has no size" {
+		t.Errorf("Expected a single reason in the message, got %v", err)
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || errs[0].Field != "pools[0].size" {
+		t.Errorf("Expected the pool size to be rejected, got %v", errs)
+	}
+}
+
+func TestValidationErrorsFromAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected ValidationErrors
+	}{
+		{
+			"list",
+			`{"code": "validation_failed", "reason": "Invalid", "errors": [{"field": "hostname", "value": "web_1", "reason": "is not a valid hostname"}, {"field": "size", "message": "is unknown"}]}`,
+			ValidationErrors{{Field: "hostname", Value: "web_1", Reason: "is not a valid hostname"}, {Field: "size", Reason: "is unknown"}},
+		},
+		{
+			"map",
+			`{"code": "validation_failed", "reason": "Invalid", "errors": {"size": ["is unknown"], "hostname": ["is too long", "is not a valid hostname"]}}`,
+			ValidationErrors{{Field: "hostname", Reason: "is too long"}, {Field: "hostname", Reason: "is not a valid hostname"}, {Field: "size", Reason: "is unknown"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(http.StatusUnprocessableEntity)
+				rw.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			client, _ := NewClientForTestingWithServer(server)
+
+			_, err := client.CreateInstance(&InstanceConfig{Hostname: "web_1"})
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Errorf("Expected ValidationErrors in %v", err)
+				return
+			}
+			if !reflect.DeepEqual(errs, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, errs)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.Code != "validation_failed" {
+				t.Errorf("Expected the APIError to be kept, got %v", err)
+			}
+		})
+	}
+}
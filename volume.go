@@ -4,26 +4,48 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"strings"
-	"time"
 )
 
 // Volume is a block of attachable storage for our IAAS products
 // https://www.civo.com/api/volumes
 type Volume struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	InstanceID    string    `json:"instance_id"`
-	ClusterID     string    `json:"cluster_id"`
-	NetworkID     string    `json:"network_id"`
-	MountPoint    string    `json:"mountpoint"`
-	Status        string    `json:"status"`
-	VolumeType    string    `json:"volume_type"`
-	SizeGigabytes int       `json:"size_gb"`
-	Bootable      bool      `json:"bootable"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	InstanceID    string `json:"instance_id"`
+	ClusterID     string `json:"cluster_id"`
+	NetworkID     string `json:"network_id"`
+	MountPoint    string `json:"mountpoint"`
+	Status        string `json:"status"`
+	VolumeType    string `json:"volume_type"`
+	SizeGigabytes int    `json:"size_gb"`
+	Bootable      bool   `json:"bootable"`
+	CreatedAt     Time   `json:"created_at"`
+	// Labels are key/value pairs attached to the volume, see MergeLabels
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// Clone returns a deep copy of the volume, sharing none of its slices and maps
+func (v *Volume) Clone() *Volume {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+	c.Labels = cloneMap(v.Labels)
+	return &c
+}
+
+// Statuses a volume goes through
+const (
+	VolumeStatusCreating  = "creating"
+	VolumeStatusAvailable = "available"
+	VolumeStatusAttaching = "attaching"
+	VolumeStatusAttached  = "attached"
+	VolumeStatusDetaching = "detaching"
+	VolumeStatusResizing  = "resizing"
+	VolumeStatusDeleting  = "deleting"
+)
+
 // VolumeResult is the response from one of our simple API calls
 type VolumeResult struct {
 	ID     string `json:"id"`
@@ -41,6 +63,10 @@ type VolumeConfig struct {
 	SizeGigabytes int    `json:"size_gb"`
 	Bootable      bool   `json:"bootable"`
 	VolumeType    string `json:"volume_type"`
+	// SnapshotID creates the volume from an existing snapshot instead of empty
+	SnapshotID string `json:"snapshot_id,omitempty"`
+	// Labels are key/value pairs to attach to the volume
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // VolumeAttachConfig is the configuration used to attach volume
@@ -141,38 +167,15 @@ func (c *Client) GetVolume(id string) (*Volume, error) {
 	return &volume, nil
 }
 
-// FindVolume finds a volume by either part of the ID or part of the name
+// FindVolume finds a volume by its ID or name, exactly, by prefix or by any part of them in that order.
+// A search matching several volumes returns an *ErrMultipleMatchesFound
 func (c *Client) FindVolume(search string) (*Volume, error) {
 	volumes, err := c.ListVolumes()
 	if err != nil {
 		return nil, decodeError(err)
 	}
 
-	exactMatch := false
-	partialMatchesCount := 0
-	result := Volume{}
-
-	for _, value := range volumes {
-		if value.Name == search || value.ID == search {
-			exactMatch = true
-			result = value
-		} else if strings.Contains(value.Name, search) || strings.Contains(value.ID, search) {
-			if !exactMatch {
-				result = value
-				partialMatchesCount++
-			}
-		}
-	}
-
-	if exactMatch || partialMatchesCount == 1 {
-		return &result, nil
-	} else if partialMatchesCount > 1 {
-		err := fmt.Errorf("unable to find %s because there were multiple matches", search)
-		return nil, MultipleMatchesError.wrap(err)
-	} else {
-		err := fmt.Errorf("unable to find %s, zero matches", search)
-		return nil, ZeroMatchesError.wrap(err)
-	}
+	return findMatch(volumes, search, false, func(v Volume) (string, []string) { return v.ID, []string{v.Name} })
 }
 
 // NewVolume creates a new volume
@@ -191,6 +194,21 @@ func (c *Client) NewVolume(v *VolumeConfig) (*VolumeResult, error) {
 	return result, nil
 }
 
+// CreateVolume creates a new volume in the client's region, unless another region is given.
+// The volume is empty, or a copy of the snapshot SnapshotID when set
+func (c *Client) CreateVolume(v *VolumeConfig) (*VolumeResult, error) {
+	if v.SizeGigabytes <= 0 && v.SnapshotID == "" {
+		err := fmt.Errorf("the size of the volume must be positive, got %dGB", v.SizeGigabytes)
+		return nil, VolumeInvalidSizeError.wrap(err)
+	}
+
+	if v.Region == "" {
+		v.Region = c.Region
+	}
+
+	return c.NewVolume(v)
+}
+
 // ResizeVolume resizes a volume
 // https://www.civo.com/api/volumes#resizing-a-volume
 func (c *Client) ResizeVolume(id string, size int) (*SimpleResponse, error) {
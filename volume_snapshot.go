@@ -0,0 +1,95 @@
+package civogo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// VolumeSnapshot is a point in time copy of a volume
+type VolumeSnapshot struct {
+	ID            string `json:"snapshot_id"`
+	Name          string `json:"name"`
+	Description   string `json:"snapshot_description,omitempty"`
+	VolumeID      string `json:"volume_id"`
+	SourceVolume  string `json:"source_volume_name,omitempty"`
+	SizeGigabytes int    `json:"restore_size,omitempty"`
+	State         string `json:"state"`
+	CreatedAt     Time   `json:"creation_time,omitempty"`
+}
+
+// VolumeSnapshotConfig are the settings to take a snapshot of a volume
+type VolumeSnapshotConfig struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Region      string `json:"region"`
+}
+
+// CreateVolumeSnapshot takes a snapshot of a volume, see VolumeConfig.SnapshotID to restore it
+func (c *Client) CreateVolumeSnapshot(volumeID string, config *VolumeSnapshotConfig) (*VolumeSnapshot, error) {
+	if config.Region == "" {
+		config.Region = c.Region
+	}
+
+	body, err := c.SendPostRequest(fmt.Sprintf("/v2/volumes/%s/snapshots", volumeID), config)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result = &VolumeSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListVolumeSnapshots returns all volume snapshots owned by the calling API account
+func (c *Client) ListVolumeSnapshots() ([]VolumeSnapshot, error) {
+	return c.listVolumeSnapshots("/v2/volumesnapshots")
+}
+
+// ListVolumeSnapshotsByVolumeID returns the snapshots of a volume
+func (c *Client) ListVolumeSnapshotsByVolumeID(volumeID string) ([]VolumeSnapshot, error) {
+	return c.listVolumeSnapshots(fmt.Sprintf("/v2/volumes/%s/snapshots", volumeID))
+}
+
+// listVolumeSnapshots returns the snapshots listed at path
+func (c *Client) listVolumeSnapshots(path string) ([]VolumeSnapshot, error) {
+	resp, err := c.SendGetRequest(path)
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	snapshots := make([]VolumeSnapshot, 0)
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(&snapshots); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// GetVolumeSnapshot returns a volume snapshot
+func (c *Client) GetVolumeSnapshot(id string) (*VolumeSnapshot, error) {
+	resp, err := c.SendGetRequest(fmt.Sprintf("/v2/volumesnapshots/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	var snapshot = &VolumeSnapshot{}
+	if err := json.NewDecoder(bytes.NewReader(resp)).Decode(snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// DeleteVolumeSnapshot deletes a volume snapshot
+func (c *Client) DeleteVolumeSnapshot(id string) (*SimpleResponse, error) {
+	resp, err := c.SendDeleteRequest(fmt.Sprintf("/v2/volumesnapshots/%s", id))
+	if err != nil {
+		return nil, decodeError(err)
+	}
+
+	return c.DecodeSimpleResponse(resp)
+}
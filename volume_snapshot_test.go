@@ -0,0 +1,60 @@
+package civogo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateVolumeSnapshot(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumes/12345/snapshots": `{"snapshot_id": "snap-1", "name": "nightly", "volume_id": "12345", "state": "Pending"}`,
+	})
+	defer server.Close()
+
+	got, err := client.CreateVolumeSnapshot("12345", &VolumeSnapshotConfig{Name: "nightly"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &VolumeSnapshot{ID: "snap-1", Name: "nightly", VolumeID: "12345", State: "Pending"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestListVolumeSnapshots(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumesnapshots": `[{"snapshot_id": "snap-1", "name": "nightly", "volume_id": "12345", "state": "Ready"}]`,
+	})
+	defer server.Close()
+
+	got, err := client.ListVolumeSnapshots()
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := []VolumeSnapshot{{ID: "snap-1", Name: "nightly", VolumeID: "12345", State: "Ready"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestDeleteVolumeSnapshot(t *testing.T) {
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/volumesnapshots/snap-1": `{"result": "success"}`,
+	})
+	defer server.Close()
+
+	got, err := client.DeleteVolumeSnapshot("snap-1")
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+
+	expected := &SimpleResponse{Result: "success"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
@@ -1,6 +1,10 @@
 package civogo
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -173,6 +177,35 @@ func TestNewVolume(t *testing.T) {
 	}
 }
 
+func TestCreateVolumeFromSnapshot(t *testing.T) {
+	var sent VolumeConfig
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		rw.Write([]byte(`{"id": "12345", "name": "restored", "result": "success"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	got, err := client.CreateVolume(&VolumeConfig{Name: "restored", SnapshotID: "snap-1"})
+	if err != nil {
+		t.Errorf("Request returned an error: %s", err)
+		return
+	}
+	if got.ID != "12345" {
+		t.Errorf("Expected %s, got %s", "12345", got.ID)
+	}
+	if sent.SnapshotID != "snap-1" || sent.Region != client.Region {
+		t.Errorf("Expected the snapshot and region to be sent, got %+v", sent)
+	}
+
+	if _, err := client.CreateVolume(&VolumeConfig{Name: "empty"}); !errors.Is(err, VolumeInvalidSizeError) {
+		t.Errorf("Expected VolumeInvalidSizeError, got %v", err)
+	}
+}
+
 func TestResizeVolumes(t *testing.T) {
 	client, server, _ := NewClientForTesting(map[string]string{
 		"/v2/volumes/12346/resize": `{"result": "success"}`,
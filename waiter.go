@@ -0,0 +1,314 @@
+package civogo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultWaitInterval is how often waiters poll the API unless told otherwise
+const DefaultWaitInterval = 5 * time.Second
+
+// WaitProgress is passed to the progress callback of a waiter after every poll
+type WaitProgress struct {
+	// Attempt is the number of polls made so far, starting at 1
+	Attempt int
+
+	// Elapsed is the time since the wait started
+	Elapsed time.Duration
+
+	// Status is the last status reported for the resource
+	Status string
+}
+
+// WaitOptions configures how a waiter polls the API
+type WaitOptions struct {
+	// Interval between two polls, defaults to DefaultWaitInterval
+	Interval time.Duration
+
+	// Timeout for the whole wait, zero means the wait only ends with the context
+	Timeout time.Duration
+
+	// OnProgress, if set, is called after every poll
+	OnProgress func(WaitProgress)
+}
+
+// WaitOption changes one of the WaitOptions
+type WaitOption func(*WaitOptions)
+
+// WithWaitInterval sets how often the waiter polls the API
+func WithWaitInterval(d time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Interval = d
+	}
+}
+
+// WithWaitTimeout sets an upper bound for how long the waiter polls the API
+func WithWaitTimeout(d time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Timeout = d
+	}
+}
+
+// WithWaitProgress registers a callback invoked after every poll, useful for progress reporting
+func WithWaitProgress(fn func(WaitProgress)) WaitOption {
+	return func(o *WaitOptions) {
+		o.OnProgress = fn
+	}
+}
+
+// WaitCondition is polled by WaitFor, it returns true once the wait is over along with the
+// current status of the resource. Returning an error ends the wait straight away.
+type WaitCondition func(ctx context.Context) (done bool, status string, err error)
+
+// WaitFor polls condition until it reports done, returns an error, the context is
+// cancelled or the timeout from the options expires (in which case a TimeoutError is returned)
+func (c *Client) WaitFor(ctx context.Context, condition WaitCondition, opts ...WaitOption) error {
+	options := WaitOptions{Interval: DefaultWaitInterval}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Interval <= 0 {
+		options.Interval = DefaultWaitInterval
+	}
+
+	waitCtx := ctx
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	ticker := time.NewTicker(options.Interval)
+	defer ticker.Stop()
+
+	for attempt := 1; ; attempt++ {
+		done, status, err := condition(waitCtx)
+		if err != nil && waitCtx.Err() == nil {
+			return err
+		}
+
+		if options.OnProgress != nil {
+			options.OnProgress(WaitProgress{Attempt: attempt, Elapsed: time.Since(start), Status: status})
+		}
+
+		if done && err == nil {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			err := fmt.Errorf("gave up waiting after %s, last status was %q", options.Timeout, status)
+			return TimeoutError.wrap(err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForInstanceState waits until the instance reaches the given status (e.g. InstanceStatusActive) and returns it,
+// an instance going into an error status stops the wait with an error
+func (c *Client) WaitForInstanceState(ctx context.Context, id, state string, opts ...WaitOption) (*Instance, error) {
+	var instance *Instance
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		i, err := c.WithContext(ctx).GetInstance(id)
+		if err != nil {
+			return false, "", err
+		}
+		instance = i
+		if InstanceStatus(i.Status).IsFailed() && !strings.EqualFold(i.Status, state) {
+			return false, i.Status, fmt.Errorf("instance %s failed with status %s", id, i.Status)
+		}
+		return strings.EqualFold(i.Status, state), i.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// WaitForKubernetesClusterReady waits until the cluster reports itself as ready and returns it,
+// a cluster going into an error status stops the wait with an error
+func (c *Client) WaitForKubernetesClusterReady(ctx context.Context, id string, opts ...WaitOption) (*KubernetesCluster, error) {
+	var cluster *KubernetesCluster
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		kc, err := c.WithContext(ctx).GetKubernetesCluster(id)
+		if err != nil {
+			return false, "", err
+		}
+		if ClusterStatus(kc.Status).IsFailed() {
+			return false, kc.Status, fmt.Errorf("kubernetes cluster %s failed with status %s", id, kc.Status)
+		}
+		cluster = kc
+		return kc.Ready, kc.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// WaitForKubernetesClusterUpgrade waits until the cluster runs version and is ready again, and returns it
+func (c *Client) WaitForKubernetesClusterUpgrade(ctx context.Context, id, version string, opts ...WaitOption) (*KubernetesCluster, error) {
+	var cluster *KubernetesCluster
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		kc, err := c.WithContext(ctx).GetKubernetesCluster(id)
+		if err != nil {
+			return false, "", err
+		}
+		cluster = kc
+		return kc.Ready && (kc.Version == version || kc.KubernetesVersion == version), kc.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// WaitForKubernetesNodeRecycled waits until the node recycled by RecycleKubernetesNode is gone from the cluster
+// and an active instance created after it has taken its place in its pool, and returns that instance
+func (c *Client) WaitForKubernetesNodeRecycled(ctx context.Context, clusterID string, recycled *Instance, opts ...WaitOption) (*Instance, error) {
+	var replacement *Instance
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		instances, err := c.WithContext(ctx).ListKubernetesClusterInstances(clusterID)
+		if err != nil {
+			return false, "", err
+		}
+
+		var candidate *Instance
+		for i := range instances {
+			instance := &instances[i]
+			if instance.ID == recycled.ID {
+				return false, "recycling " + instance.Status, nil
+			}
+			if instance.PoolID == recycled.PoolID && instance.CreatedAt.After(recycled.CreatedAt.Time) {
+				candidate = instance
+			}
+		}
+		if candidate == nil {
+			return false, "awaiting replacement", nil
+		}
+
+		replacement = candidate
+		return InstanceStatusActive.Is(candidate.Status), candidate.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return replacement, nil
+}
+
+// WaitForVolumeState waits until the volume reaches the given status (e.g. "available") and returns it
+func (c *Client) WaitForVolumeState(ctx context.Context, id, state string, opts ...WaitOption) (*Volume, error) {
+	var volume *Volume
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		v, err := c.WithContext(ctx).GetVolume(id)
+		if err != nil {
+			return false, "", err
+		}
+		volume = v
+		return strings.EqualFold(v.Status, state), v.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return volume, nil
+}
+
+// WaitForVolumeAttached waits until the volume is attached to an instance and returns it
+func (c *Client) WaitForVolumeAttached(ctx context.Context, id string, opts ...WaitOption) (*Volume, error) {
+	return c.WaitForVolumeState(ctx, id, VolumeStatusAttached, opts...)
+}
+
+// WaitForInstanceNetworkAttached waits until the instance is attached to the network and returns the attachment,
+// a failed attachment stops the wait with an error
+func (c *Client) WaitForInstanceNetworkAttached(ctx context.Context, instanceID, networkID string, opts ...WaitOption) (*NetworkAttachment, error) {
+	var attachment *NetworkAttachment
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		a, err := c.WithContext(ctx).GetInstanceNetworkAttachment(instanceID, networkID)
+		if err != nil {
+			return false, "", err
+		}
+		if strings.EqualFold(a.Status, NetworkAttachmentStatusFailed) {
+			return false, a.Status, fmt.Errorf("attaching instance %s to network %s failed", instanceID, networkID)
+		}
+		attachment = a
+		return strings.EqualFold(a.Status, NetworkAttachmentStatusAttached), a.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// WaitForDatabaseReady waits until the database is ready, e.g. after creating or restoring it, and returns it
+func (c *Client) WaitForDatabaseReady(ctx context.Context, id string, opts ...WaitOption) (*Database, error) {
+	var database *Database
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		db, err := c.WithContext(ctx).GetDatabase(id)
+		if err != nil {
+			return false, "", err
+		}
+		database = db
+		return strings.EqualFold(db.Status, DatabaseStatusReady), db.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return database, nil
+}
+
+// WaitForInstanceSnapshotAvailable waits until an instance snapshot is complete and returns it,
+// a failed snapshot stops the wait with an error
+func (c *Client) WaitForInstanceSnapshotAvailable(ctx context.Context, id string, opts ...WaitOption) (*InstanceSnapshot, error) {
+	var snapshot *InstanceSnapshot
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		s, err := c.WithContext(ctx).GetInstanceSnapshot(id)
+		if err != nil {
+			return false, "", err
+		}
+		if strings.EqualFold(s.Status, InstanceSnapshotStatusFailed) {
+			return false, s.Status, fmt.Errorf("snapshot %s of instance %s failed", s.Name, s.InstanceID)
+		}
+		snapshot = s
+		return strings.EqualFold(s.Status, InstanceSnapshotStatusAvailable), s.Status, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// WaitForDiskImageAvailable waits until a custom disk image has been processed after its upload and returns it,
+// a failed image stops the wait with an error
+func (c *Client) WaitForDiskImageAvailable(ctx context.Context, id string, opts ...WaitOption) (*DiskImage, error) {
+	var image *DiskImage
+	err := c.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		i, err := c.WithContext(ctx).GetDiskImage(id)
+		if err != nil {
+			return false, "", err
+		}
+		if strings.EqualFold(i.State, DiskImageStateFailed) {
+			return false, i.State, DiskImageUploadError.wrap(fmt.Errorf("disk image %s failed to be processed", i.Name))
+		}
+		image = i
+		return strings.EqualFold(i.State, DiskImageStateAvailable), i.State, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return image, nil
+}
@@ -0,0 +1,173 @@
+package civogo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWaitForInstanceState(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		polls++
+		if polls < 3 {
+			rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "BUILDING"}`))
+			return
+		}
+		rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	progress := []WaitProgress{}
+	instance, err := client.WaitForInstanceState(context.Background(), "12345", "ACTIVE",
+		WithWaitInterval(time.Millisecond),
+		WithWaitProgress(func(p WaitProgress) { progress = append(progress, p) }),
+	)
+	g.Expect(err).To(BeNil())
+	g.Expect(instance.Status).To(Equal("ACTIVE"))
+	g.Expect(progress).To(HaveLen(3))
+	g.Expect(progress[0].Status).To(Equal("BUILDING"))
+	g.Expect(progress[2].Attempt).To(Equal(3))
+}
+
+func TestWaitForVolumeAttached(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		polls++
+		if polls < 2 {
+			rw.Write([]byte(`{"id": "12345", "name": "data", "status": "attaching"}`))
+			return
+		}
+		rw.Write([]byte(`{"id": "12345", "name": "data", "instance_id": "inst", "status": "attached"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	volume, err := client.WaitForVolumeAttached(context.Background(), "12345", WithWaitInterval(time.Millisecond))
+	g.Expect(err).To(BeNil())
+	g.Expect(volume.InstanceID).To(Equal("inst"))
+	g.Expect(polls).To(Equal(2))
+}
+
+func TestWaitForInstanceNetworkAttachedFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`[{"id": "att-1", "instance_id": "inst-1", "network_id": "net-2", "status": "failed"}]`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	_, err := client.WaitForInstanceNetworkAttached(context.Background(), "inst-1", "net-2", WithWaitInterval(time.Millisecond))
+	g.Expect(err).To(MatchError(ContainSubstring("failed")))
+}
+
+func TestWaitForKubernetesClusterReadyTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/kubernetes/clusters/69a23478": `{"id": "69a23478", "name": "your-cluster-name", "status": "BUILDING", "ready": false}`,
+	})
+	defer server.Close()
+
+	_, err := client.WaitForKubernetesClusterReady(context.Background(), "69a23478",
+		WithWaitInterval(time.Millisecond),
+		WithWaitTimeout(20*time.Millisecond),
+	)
+	g.Expect(errors.Is(err, TimeoutError)).To(BeTrue())
+}
+
+func TestWaitForStopsOnError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	failure := errors.New("boom")
+	err := client.WaitFor(context.Background(), func(ctx context.Context) (bool, string, error) {
+		return false, "", failure
+	}, WithWaitInterval(time.Millisecond))
+	g.Expect(err).To(MatchError(failure))
+}
+
+func TestWaitForCancelled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := client.WaitFor(ctx, func(ctx context.Context) (bool, string, error) {
+		cancel()
+		return false, "BUILDING", nil
+	}, WithWaitInterval(time.Millisecond))
+	g.Expect(err).To(MatchError(context.Canceled))
+}
+
+func TestRecycleKubernetesNodeAndWait(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recycled := false
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/kubernetes/clusters/69a23478/instances":
+			switch {
+			case !recycled:
+				rw.Write([]byte(`[{"id": "node-1", "hostname": "k3s-node-1", "status": "ACTIVE", "created_at": "2024-01-01T10:00:00Z"}]`))
+			case polls == 0:
+				polls++
+				rw.Write([]byte(`[{"id": "node-1", "hostname": "k3s-node-1", "status": "SHUTOFF", "created_at": "2024-01-01T10:00:00Z"}]`))
+			case polls == 1:
+				polls++
+				rw.Write([]byte(`[{"id": "node-2", "hostname": "k3s-node-2", "status": "BUILDING", "created_at": "2024-01-02T10:00:00Z"}]`))
+			default:
+				rw.Write([]byte(`[{"id": "node-2", "hostname": "k3s-node-2", "status": "ACTIVE", "created_at": "2024-01-02T10:00:00Z"}]`))
+			}
+		case "/v2/kubernetes/clusters/69a23478/pools":
+			rw.Write([]byte(`[{"id": "workers", "instance_names": ["k3s-node-1", "k3s-node-2"]}]`))
+		case "/v2/kubernetes/clusters/69a23478/recycle":
+			recycled = true
+			rw.Write([]byte(`{"result": "success"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClientForTestingWithServer(server)
+
+	instance, err := client.RecycleKubernetesNode("69a23478", "k3s-node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(instance.ID).To(Equal("node-1"))
+	g.Expect(instance.PoolID).To(Equal("workers"))
+
+	replacement, err := client.WaitForKubernetesNodeRecycled(context.Background(), "69a23478", instance, WithWaitInterval(time.Millisecond))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(replacement.ID).To(Equal("node-2"))
+	g.Expect(replacement.PoolID).To(Equal("workers"))
+	g.Expect(polls).To(Equal(2))
+}
+
+func TestWaitForInstanceStateFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client, server, _ := NewClientForTesting(map[string]string{
+		"/v2/instances/12345": `{"id": "12345", "hostname": "web", "status": "ERROR"}`,
+	})
+	defer server.Close()
+
+	_, err := client.WaitForInstanceState(context.Background(), "12345", string(InstanceStatusActive), WithWaitInterval(time.Millisecond))
+	g.Expect(err).To(MatchError(ContainSubstring("failed with status ERROR")))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -18,6 +19,17 @@ type Webhook struct {
 	LasrFailureReason string   `json:"last_failure_reason"`
 }
 
+// Clone returns a deep copy of the webhook, sharing none of its slices and maps
+func (w *Webhook) Clone() *Webhook {
+	if w == nil {
+		return nil
+	}
+
+	c := *w
+	c.Events = cloneSlice(w.Events)
+	return &c
+}
+
 // WebhookConfig represents the options required for creating a new webhook
 type WebhookConfig struct {
 	Events []string `json:"events"`
@@ -25,8 +37,60 @@ type WebhookConfig struct {
 	Secret string   `json:"secret"`
 }
 
+// Events a webhook can subscribe to
+const (
+	WebhookEventAll = "*"
+
+	WebhookEventInstanceCreated  = "instance.created"
+	WebhookEventInstanceDeleted  = "instance.deleted"
+	WebhookEventInstanceStarted  = "instance.started"
+	WebhookEventInstanceStopped  = "instance.stopped"
+	WebhookEventInstanceRebooted = "instance.rebooted"
+
+	WebhookEventKubernetesClusterCreated  = "kubernetes_cluster.created"
+	WebhookEventKubernetesClusterDeleted  = "kubernetes_cluster.deleted"
+	WebhookEventKubernetesClusterUpgraded = "kubernetes_cluster.upgraded"
+
+	WebhookEventVolumeCreated  = "volume.created"
+	WebhookEventVolumeDeleted  = "volume.deleted"
+	WebhookEventVolumeAttached = "volume.attached"
+	WebhookEventVolumeDetached = "volume.detached"
+)
+
+// Validate checks the webhook has an absolute http(s) URL and subscribes to at least one event
+func (r *WebhookConfig) Validate() error {
+	return r.validate(false)
+}
+
+// validate checks the settings of a webhook, when partial is set (for updates) only the settings
+// given are checked. The rejected fields are listed in the ValidationErrors it wraps
+func (r *WebhookConfig) validate(partial bool) error {
+	var errs ValidationErrors
+	if !partial || r.URL != "" {
+		u, err := url.Parse(r.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs.add("url", r.URL, "the webhook URL must be an absolute http or https URL, got %q", r.URL)
+		}
+	}
+
+	if !partial && len(r.Events) == 0 {
+		errs.add("events", r.Events, "the webhook must subscribe to at least one event, use %q for all of them", WebhookEventAll)
+	}
+	for i, event := range r.Events {
+		if strings.TrimSpace(event) == "" {
+			errs.add(fmt.Sprintf("events[%d]", i), event, "the webhook events can't be blank")
+		}
+	}
+
+	return errs.wrap(WebhookInvalidError)
+}
+
 // CreateWebhook creates a new webhook
 func (c *Client) CreateWebhook(r *WebhookConfig) (*Webhook, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPostRequest("/v2/webhooks", r)
 	if err != nil {
 		return nil, decodeError(err)
@@ -91,6 +155,10 @@ func (c *Client) FindWebhook(search string) (*Webhook, error) {
 
 // UpdateWebhook updates a webhook
 func (c *Client) UpdateWebhook(id string, r *WebhookConfig) (*Webhook, error) {
+	if err := r.validate(true); err != nil {
+		return nil, err
+	}
+
 	body, err := c.SendPutRequest(fmt.Sprintf("/v2/webhooks/%s", id), r)
 	if err != nil {
 		return nil, decodeError(err)
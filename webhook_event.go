@@ -0,0 +1,148 @@
+package civogo
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WebhookSignatureHeader is the header carrying the signature of a webhook delivery, the hex encoded
+// HMAC-SHA256 of the body keyed with the secret of the webhook
+const WebhookSignatureHeader = "X-Civo-Signature"
+
+// webhookMaxPayloadSize is the largest webhook body ParseWebhookEvent reads
+const webhookMaxPayloadSize = 1 << 20
+
+// WebhookEvent is an event delivered to a webhook, switch on its concrete type to handle it, e.g.
+// *InstanceCreatedEvent. Events without a dedicated type are returned as *GenericWebhookEvent
+type WebhookEvent interface {
+	// EventType returns the event the delivery is for, e.g. WebhookEventInstanceCreated
+	EventType() string
+}
+
+// WebhookEventHeader holds what every webhook delivery has in common
+type WebhookEventHeader struct {
+	ID        string `json:"id"`
+	Type      string `json:"event"`
+	Region    string `json:"region,omitempty"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+// EventType returns the event the delivery is for
+func (h WebhookEventHeader) EventType() string {
+	return h.Type
+}
+
+// InstanceCreatedEvent is delivered when an instance is created
+type InstanceCreatedEvent struct {
+	WebhookEventHeader
+	Instance Instance `json:"payload"`
+}
+
+// InstanceDeletedEvent is delivered when an instance is deleted
+type InstanceDeletedEvent struct {
+	WebhookEventHeader
+	Instance Instance `json:"payload"`
+}
+
+// ClusterCreatedEvent is delivered when a kubernetes cluster is created
+type ClusterCreatedEvent struct {
+	WebhookEventHeader
+	Cluster KubernetesCluster `json:"payload"`
+}
+
+// ClusterDeletedEvent is delivered when a kubernetes cluster is deleted
+type ClusterDeletedEvent struct {
+	WebhookEventHeader
+	Cluster KubernetesCluster `json:"payload"`
+}
+
+// VolumeCreatedEvent is delivered when a volume is created
+type VolumeCreatedEvent struct {
+	WebhookEventHeader
+	Volume Volume `json:"payload"`
+}
+
+// VolumeDeletedEvent is delivered when a volume is deleted
+type VolumeDeletedEvent struct {
+	WebhookEventHeader
+	Volume Volume `json:"payload"`
+}
+
+// GenericWebhookEvent is any other event, its payload is left for the caller to decode
+type GenericWebhookEvent struct {
+	WebhookEventHeader
+	Payload json.RawMessage `json:"payload"`
+}
+
+// webhookEventTypes maps the events with a dedicated type to a constructor of that type
+var webhookEventTypes = map[string]func() WebhookEvent{
+	WebhookEventInstanceCreated:          func() WebhookEvent { return &InstanceCreatedEvent{} },
+	WebhookEventInstanceDeleted:          func() WebhookEvent { return &InstanceDeletedEvent{} },
+	WebhookEventKubernetesClusterCreated: func() WebhookEvent { return &ClusterCreatedEvent{} },
+	WebhookEventKubernetesClusterDeleted: func() WebhookEvent { return &ClusterDeletedEvent{} },
+	WebhookEventVolumeCreated:            func() WebhookEvent { return &VolumeCreatedEvent{} },
+	WebhookEventVolumeDeleted:            func() WebhookEvent { return &VolumeDeletedEvent{} },
+}
+
+// ParseWebhookEvent checks the signature of a webhook delivery against the secret of the webhook
+// and decodes its payload. A missing or wrong signature returns a WebhookSignatureInvalidError.
+// The body of r is read, then replaced so the handler can read it again
+func ParseWebhookEvent(r *http.Request, secret string) (WebhookEvent, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, webhookMaxPayloadSize+1))
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > webhookMaxPayloadSize {
+		return nil, fmt.Errorf("the webhook payload is larger than %d bytes", webhookMaxPayloadSize)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if !VerifyWebhookSignature(body, r.Header.Get(WebhookSignatureHeader), secret) {
+		err := fmt.Errorf("the %s header does not match the payload", WebhookSignatureHeader)
+		return nil, WebhookSignatureInvalidError.wrap(err)
+	}
+
+	return DecodeWebhookEvent(body)
+}
+
+// DecodeWebhookEvent decodes a webhook payload whose signature has already been checked
+func DecodeWebhookEvent(body []byte) (WebhookEvent, error) {
+	header := WebhookEventHeader{}
+	if err := json.Unmarshal(body, &header); err != nil {
+		return nil, fmt.Errorf("unable to parse the webhook payload: %v", err)
+	}
+
+	var event WebhookEvent = &GenericWebhookEvent{}
+	if newEvent, ok := webhookEventTypes[header.Type]; ok {
+		event = newEvent()
+	}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("unable to parse the %s webhook payload: %v", header.Type, err)
+	}
+
+	return event, nil
+}
+
+// SignWebhookPayload returns the signature of body for secret, as sent in the WebhookSignatureHeader
+func SignWebhookPayload(body []byte, secret string) string {
+	return hex.EncodeToString(hmacSHA256([]byte(secret), string(body)))
+}
+
+// VerifyWebhookSignature reports whether signature, with or without a "sha256=" prefix,
+// is the signature of body for secret
+func VerifyWebhookSignature(body []byte, signature, secret string) bool {
+	given, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "sha256="))
+	if err != nil || len(given) != sha256.Size {
+		return false
+	}
+
+	return hmac.Equal(given, hmacSHA256([]byte(secret), string(body)))
+}
@@ -0,0 +1,64 @@
+package civogo
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	body := `{"id": "evt-1", "event": "instance.created", "region": "LON1", "created_at": "2024-01-02T03:04:05Z", "payload": {"id": "12345", "hostname": "web.example.com"}}`
+	req := httptest.NewRequest("POST", "/hooks/civo", strings.NewReader(body))
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload([]byte(body), "secret"))
+
+	event, err := ParseWebhookEvent(req, "secret")
+	g.Expect(err).To(BeNil())
+	g.Expect(event.EventType()).To(Equal(WebhookEventInstanceCreated))
+
+	created, ok := event.(*InstanceCreatedEvent)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(created.ID).To(Equal("evt-1"))
+	g.Expect(created.Region).To(Equal("LON1"))
+	g.Expect(created.Instance.Hostname).To(Equal("web.example.com"))
+
+	// the body is still there for the handler
+	replay, _ := io.ReadAll(req.Body)
+	g.Expect(string(replay)).To(Equal(body))
+}
+
+func TestParseWebhookEventBadSignature(t *testing.T) {
+	body := `{"id": "evt-1", "event": "instance.created", "payload": {}}`
+
+	for _, signature := range []string{"", "not-hex", SignWebhookPayload([]byte(body), "other-secret")} {
+		req := httptest.NewRequest("POST", "/hooks/civo", strings.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, signature)
+
+		if _, err := ParseWebhookEvent(req, "secret"); !errors.Is(err, WebhookSignatureInvalidError) {
+			t.Errorf("Expected WebhookSignatureInvalidError for signature %q, got %v", signature, err)
+		}
+	}
+}
+
+func TestDecodeWebhookEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	event, err := DecodeWebhookEvent([]byte(`{"id": "evt-2", "event": "kubernetes_cluster.deleted", "payload": {"id": "69a23478", "name": "prod"}}`))
+	g.Expect(err).To(BeNil())
+	g.Expect(event.(*ClusterDeletedEvent).Cluster.Name).To(Equal("prod"))
+
+	event, err = DecodeWebhookEvent([]byte(`{"id": "evt-3", "event": "instance.rebooted", "payload": {"id": "12345"}}`))
+	g.Expect(err).To(BeNil())
+	generic, ok := event.(*GenericWebhookEvent)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(generic.EventType()).To(Equal(WebhookEventInstanceRebooted))
+	g.Expect(string(generic.Payload)).To(Equal(`{"id": "12345"}`))
+
+	_, err = DecodeWebhookEvent([]byte(`not json`))
+	g.Expect(err).To(HaveOccurred())
+}
@@ -1,6 +1,7 @@
 package civogo
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -190,3 +191,27 @@ func TestDeleteWebhook(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v", expected, got)
 	}
 }
+
+func TestWebhookConfigValidate(t *testing.T) {
+	valid := WebhookConfig{URL: "https://api.example.com/webhook", Events: []string{WebhookEventInstanceCreated}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected %+v to be valid, got %s", valid, err)
+	}
+
+	invalid := []WebhookConfig{
+		{URL: "api.example.com/webhook", Events: []string{WebhookEventAll}},
+		{URL: "ftp://api.example.com/webhook", Events: []string{WebhookEventAll}},
+		{URL: "https://api.example.com/webhook"},
+		{URL: "https://api.example.com/webhook", Events: []string{" "}},
+	}
+	for _, config := range invalid {
+		if err := config.Validate(); !errors.Is(err, WebhookInvalidError) {
+			t.Errorf("Expected WebhookInvalidError for %+v, got %v", config, err)
+		}
+	}
+
+	client, _ := NewFakeClient()
+	if _, err := client.CreateWebhook(&WebhookConfig{URL: "not a url", Events: []string{WebhookEventAll}}); !errors.Is(err, WebhookInvalidError) {
+		t.Errorf("Expected WebhookInvalidError, got %v", err)
+	}
+}